@@ -96,6 +96,7 @@ const (
 	MyNameInternational       Identifier = "my_name_intl"
 	MyPostalCode              Identifier = "my_postal_code"
 	MyPostalCodeInternational Identifier = "my_postal_code_intl"
+	MyPOTARef                 Identifier = "my_pota_ref"
 	MyRig                     Identifier = "my_rig"
 	MyRigInternational        Identifier = "my_rig_intl"
 	MySIG                     Identifier = "my_sig"
@@ -117,6 +118,7 @@ const (
 	Operator                  Identifier = "operator"
 	OwnerCallsign             Identifier = "owner_callsign"
 	PFX                       Identifier = "pfx"
+	POTARef                   Identifier = "pota_ref"
 	Precedence                Identifier = "precedence"
 	PropMode                  Identifier = "prop_mode"
 	PublicKey                 Identifier = "public_key"
@@ -1,5 +1,7 @@
 package adif
 
+import "strings"
+
 type Mode struct {
 	Name string
 	Min  float64
@@ -33,3 +35,34 @@ var Modes = []Mode{
 		Max:  3.885,
 	},
 }
+
+// Mode categories, as used by awards such as DXCC
+const (
+	CategoryCW    = "CW"
+	CategoryPhone = "PHONE"
+	CategoryData  = "DATA"
+)
+
+// phoneModes are the modes and submodes in the phone category.  Image modes
+// count as phone, as they do for DXCC.
+var phoneModes = map[string]bool{
+	"AM": true, "FM": true, "SSB": true, "USB": true, "LSB": true, "DIGITALVOICE": true,
+	"C4FM": true, "DSTAR": true, "FREEDV": true, "VOI": true, "ATV": true, "FAX": true,
+	"SSTV": true, CategoryPhone: true,
+}
+
+// ModeCategory returns the category (CW, PHONE or DATA) of a mode or submode.
+// The categories themselves are also accepted, and it returns the empty string
+// for an empty mode.
+func ModeCategory(mode string) string {
+	mode = strings.ToUpper(strings.TrimSpace(mode))
+	switch {
+	case mode == "":
+		return ""
+	case mode == CategoryCW:
+		return CategoryCW
+	case phoneModes[mode]:
+		return CategoryPhone
+	}
+	return CategoryData
+}
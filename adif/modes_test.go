@@ -0,0 +1,29 @@
+package adif_test
+
+import (
+	"testing"
+
+	"github.com/tzneal/ham-go/adif"
+)
+
+func TestModeCategory(t *testing.T) {
+	for mode, exp := range map[string]string{
+		"cw":     adif.CategoryCW,
+		"SSB":    adif.CategoryPhone,
+		"FM":     adif.CategoryPhone,
+		"SSTV":   adif.CategoryPhone,
+		"PHONE":  adif.CategoryPhone,
+		"FT8":    adif.CategoryData,
+		"MFSK":   adif.CategoryData,
+		"RTTY":   adif.CategoryData,
+		"DATA":   adif.CategoryData,
+		"":       "",
+		" ft4 ":  adif.CategoryData,
+		"DSTAR":  adif.CategoryPhone,
+		"OLIVIA": adif.CategoryData,
+	} {
+		if got := adif.ModeCategory(mode); got != exp {
+			t.Errorf("%q: expected %q, got %q", mode, exp, got)
+		}
+	}
+}
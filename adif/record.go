@@ -5,6 +5,7 @@ import (
 	"io"
 	"math"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -42,6 +43,18 @@ func (r Record) GetFloat(id Identifier) float64 {
 	return val
 }
 
+// GetBand returns the band of the QSO, determining it from the frequency if the
+// band field isn't present.
+func (r Record) GetBand() string {
+	if band := r.Get(ABand); band != "" {
+		return strings.ToLower(band)
+	}
+	if band, ok := DetermineBand(r.GetFloat(Frequency)); ok {
+		return band.Name
+	}
+	return ""
+}
+
 func (r Record) Copy() Record {
 	ret := Record{}
 	for _, v := range r {
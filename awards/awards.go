@@ -0,0 +1,47 @@
+// Package awards tracks progress towards operating awards using the QSOs
+// stored in ADIF logs.
+package awards
+
+import (
+	"strings"
+
+	"github.com/tzneal/ham-go/adif"
+	"github.com/tzneal/ham-go/dxcc"
+)
+
+// DXCC entity codes that make up the United States for WAS and USA-CA
+const (
+	dxccAlaska = 6
+	dxccHawaii = 110
+	dxccUSA    = 291
+)
+
+// LOTWConfirmed returns true if the QSO has been confirmed via LoTW.
+func LOTWConfirmed(rec adif.Record) bool {
+	switch strings.ToUpper(rec.Get(adif.LOTWReceived)) {
+	case "Y", "V":
+		return true
+	}
+	return false
+}
+
+// recordDXCC returns the DXCC entity code for a QSO, falling back to a lookup
+// of the call sign on the QSO date if the QSO doesn't specify one.
+func recordDXCC(rec adif.Record) int {
+	if code := rec.GetInt(adif.DXCC); code != 0 {
+		return int(code)
+	}
+	ent, ok := dxcc.LookupRecord(rec)
+	if !ok {
+		return 0
+	}
+	return ent.DXCC
+}
+
+func isUSA(rec adif.Record) bool {
+	switch recordDXCC(rec) {
+	case dxccAlaska, dxccHawaii, dxccUSA:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,341 @@
+package awards_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tzneal/ham-go/adif"
+	"github.com/tzneal/ham-go/awards"
+)
+
+func TestCountyList(t *testing.T) {
+	total := 0
+	for _, c := range awards.USACounties {
+		total += len(c)
+	}
+	if awards.TotalCounties() != total {
+		t.Errorf("expected %d unique counties, got %d", total, awards.TotalCounties())
+	}
+	if len(awards.USACounties) != 50 {
+		t.Errorf("expected 50 states, got %d", len(awards.USACounties))
+	}
+}
+
+func TestLookupCounty(t *testing.T) {
+	td := []struct {
+		Input    string
+		Expected string
+		Valid    bool
+	}{
+		{"MA,Franklin", "MA,Franklin", true},
+		{"ma,franklin county", "MA,Franklin", true},
+		{"MO,Saint Louis", "MO,St. Louis", true},
+		{"MO,St Louis City", "MO,St. Louis City", true},
+		{"MO,Sainte Genevieve", "MO,Ste. Genevieve", true},
+		{"IL,La Salle", "IL,LaSalle", true},
+		{"LA,East Baton Rouge Parish", "LA,East Baton Rouge", true},
+		{"VA,Alexandria", "VA,Alexandria City", true},
+		{"VA,Fairfax", "VA,Fairfax", true},
+		{"MA,Nowhere", "", false},
+		{"Franklin", "", false},
+	}
+	for _, tc := range td {
+		got, ok := awards.LookupCounty(tc.Input)
+		if ok != tc.Valid {
+			t.Errorf("expected valid = %v for %s, got %v", tc.Valid, tc.Input, ok)
+		}
+		if got != tc.Expected {
+			t.Errorf("expected %s for %s, got %s", tc.Expected, tc.Input, got)
+		}
+	}
+}
+
+func TestWAS(t *testing.T) {
+	was := awards.NewWAS()
+	was.Add(adif.Record{
+		{Name: adif.Call, Value: "W1AW"},
+		{Name: adif.State, Value: "CT"},
+		{Name: adif.ABand, Value: "20m"},
+		{Name: adif.AMode, Value: "CW"},
+		{Name: adif.LOTWReceived, Value: "Y"},
+	})
+	was.Add(adif.Record{
+		{Name: adif.Call, Value: "KN4LHY"},
+		{Name: adif.State, Value: "al"},
+		{Name: adif.Frequency, Value: "7.030"},
+		{Name: adif.AMode, Value: "CW"},
+	})
+	was.Add(adif.Record{
+		{Name: adif.Call, Value: "K7ABC"},
+		{Name: adif.State, Value: "WA"},
+		{Name: adif.ABand, Value: "20M"},
+		{Name: adif.AMode, Value: "SSB"},
+	})
+	// Western Australia isn't Washington
+	was.Add(adif.Record{
+		{Name: adif.Call, Value: "VK6ABC"},
+		{Name: adif.State, Value: "WA"},
+		{Name: adif.ABand, Value: "40m"},
+		{Name: adif.AMode, Value: "SSB"},
+	})
+	// DC doesn't count
+	was.Add(adif.Record{
+		{Name: adif.Call, Value: "W3ABC"},
+		{Name: adif.State, Value: "DC"},
+		{Name: adif.ABand, Value: "40m"},
+		{Name: adif.AMode, Value: "SSB"},
+	})
+
+	mixed := awards.BandMode{}
+	if got := len(was.Worked(mixed)); got != 3 {
+		t.Errorf("expected 3 states worked, got %d", got)
+	}
+	if got := len(was.Needed(mixed)); got != 47 {
+		t.Errorf("expected 47 states needed, got %d", got)
+	}
+	if got := was.Confirmed(mixed); len(got) != 1 || got[0] != "CT" {
+		t.Errorf("expected [CT] confirmed, got %v", got)
+	}
+	if got := was.Unconfirmed(mixed); len(got) != 2 || got[0] != "AL" || got[1] != "WA" {
+		t.Errorf("expected [AL WA] unconfirmed, got %v", got)
+	}
+	if got := was.Worked(awards.BandMode{Band: "20m"}); len(got) != 2 || got[0] != "CT" || got[1] != "WA" {
+		t.Errorf("expected [CT WA] on 20m, got %v", got)
+	}
+	if got := was.Worked(awards.BandMode{Band: "40m", Mode: "CW"}); len(got) != 1 || got[0] != "AL" {
+		t.Errorf("expected [AL] on 40m CW, got %v", got)
+	}
+	if was.IsNeeded("CT", awards.BandMode{Mode: "SSB"}) != true {
+		t.Errorf("expected CT to be needed on SSB")
+	}
+	if was.IsNeeded("CT", awards.BandMode{Mode: "CW"}) != false {
+		t.Errorf("expected CT to not be needed on CW")
+	}
+}
+
+func TestUSACA(t *testing.T) {
+	ca := awards.NewUSACA()
+	ca.Add(adif.Record{
+		{Name: adif.Call, Value: "W1AW"},
+		{Name: adif.County, Value: "CT,Hartford"},
+		{Name: adif.LOTWReceived, Value: "Y"},
+	})
+	ca.Add(adif.Record{{Name: adif.Call, Value: "K1ABC"}, {Name: adif.USACACounties, Value: "MA,Franklin:MA,Hampshire"}})
+	ca.Add(adif.Record{{Name: adif.Call, Value: "K1ABC"}, {Name: adif.County, Value: "MA,Not A County"}})
+	if ca.Worked() != 3 {
+		t.Errorf("expected 3 counties worked, got %d", ca.Worked())
+	}
+	if ca.Confirmed() != 1 {
+		t.Errorf("expected 1 county confirmed, got %d", ca.Confirmed())
+	}
+	if got := len(ca.Needed("MA")); got != 12 {
+		t.Errorf("expected 12 MA counties needed, got %d", got)
+	}
+	if ca.WorkedInState("MA") != 2 {
+		t.Errorf("expected 2 MA counties worked, got %d", ca.WorkedInState("MA"))
+	}
+	if ca.WorkedInState("ma") != 2 {
+		t.Errorf("expected 2 ma counties worked, got %d", ca.WorkedInState("ma"))
+	}
+	if ca.IsNeeded("CT,Hartford") {
+		t.Errorf("expected CT,Hartford to not be needed")
+	}
+	if !ca.IsNeeded("CT,Tolland") {
+		t.Errorf("expected CT,Tolland to be needed")
+	}
+}
+
+func TestVUCC(t *testing.T) {
+	v := awards.NewVUCC()
+	v.Add(adif.Record{
+		{Name: adif.Call, Value: "W1AW"},
+		{Name: adif.GridSquare, Value: "FN31pr"},
+		{Name: adif.ABand, Value: "2m"},
+		{Name: adif.LOTWReceived, Value: "Y"},
+	})
+	v.Add(adif.Record{
+		{Name: adif.Call, Value: "K1ABC"},
+		{Name: adif.VUCC_Grids, Value: "FN32,FN42"},
+		{Name: adif.ABand, Value: "2m"},
+	})
+	v.Add(adif.Record{
+		{Name: adif.Call, Value: "K1ABC"},
+		{Name: adif.GridSquare, Value: "FN41"},
+		{Name: adif.Frequency, Value: "50.313"},
+	})
+	v.Add(adif.Record{
+		{Name: adif.Call, Value: "K1ABC"},
+		{Name: adif.GridSquare, Value: "EM79"},
+		{Name: adif.ABand, Value: "2m"},
+		{Name: adif.PropMode, Value: "SAT"},
+	})
+	// HF doesn't count
+	v.Add(adif.Record{
+		{Name: adif.Call, Value: "K1ABC"},
+		{Name: adif.GridSquare, Value: "EM80"},
+		{Name: adif.ABand, Value: "20m"},
+	})
+
+	if got := v.Bands(); len(got) != 3 || got[0] != "2m" || got[1] != "6m" || got[2] != awards.VUCCSatellite {
+		t.Errorf("expected [2m 6m SAT], got %v", got)
+	}
+	if got := v.Worked("2m"); len(got) != 3 || got[0] != "FN31" || got[1] != "FN32" || got[2] != "FN42" {
+		t.Errorf("expected [FN31 FN32 FN42], got %v", got)
+	}
+	if got := v.Confirmed("2m"); len(got) != 1 || got[0] != "FN31" {
+		t.Errorf("expected [FN31], got %v", got)
+	}
+	if v.Status("2m", "fn42") != awards.GridWorked {
+		t.Errorf("expected FN42 to be worked")
+	}
+	if v.Status("2m", "EM79") != awards.GridNeeded {
+		t.Errorf("expected satellite QSO to not count for 2m")
+	}
+
+	lines, err := v.Map("2m", "FN31", 1)
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+	expected := []string{
+		"FN22. FN32+ FN42+",
+		"FN21. FN31# FN41.",
+		"FN20. FN30. FN40.",
+	}
+	if len(lines) != len(expected) {
+		t.Fatalf("expected %d lines, got %d", len(expected), len(lines))
+	}
+	for i := range lines {
+		if lines[i] != expected[i] {
+			t.Errorf("expected %q, got %q", expected[i], lines[i])
+		}
+	}
+	// wraps around the anti-meridian
+	lines, _ = v.Map("2m", "AA00", 1)
+	if len(lines) != 2 || lines[0] != "RA91. AA01. AA11." {
+		t.Errorf("expected wrapped map, got %v", lines)
+	}
+	if _, err := v.Map("2m", "ZZ99", 1); err == nil || !strings.Contains(err.Error(), "ZZ99") {
+		t.Errorf("expected an error naming the invalid grid, got %v", err)
+	}
+}
+
+func TestWPX(t *testing.T) {
+	w := awards.NewWPX()
+	w.Add(adif.Record{
+		{Name: adif.Call, Value: "N8BJQ"},
+		{Name: adif.ABand, Value: "20m"},
+		{Name: adif.AMode, Value: "CW"},
+		{Name: adif.LOTWReceived, Value: "Y"},
+	})
+	w.Add(adif.Record{
+		{Name: adif.Call, Value: "W8ABC"},
+		{Name: adif.ABand, Value: "20m"},
+		{Name: adif.AMode, Value: "SSB"},
+	})
+	w.Add(adif.Record{
+		{Name: adif.Call, Value: "N8BJQ/KH9"},
+		{Name: adif.ABand, Value: "40m"},
+		{Name: adif.AMode, Value: "CW"},
+	})
+	w.Add(adif.Record{
+		{Name: adif.Call, Value: "K1ABC"},
+		{Name: adif.PFX, Value: "K1"},
+		{Name: adif.ABand, Value: "40m"},
+		{Name: adif.AMode, Value: "CW"},
+	})
+	w.Add(adif.Record{
+		{Name: adif.Call, Value: "N8XYZ"},
+		{Name: adif.ABand, Value: "40m"},
+		{Name: adif.AMode, Value: "CW"},
+	})
+
+	mixed := awards.BandMode{}
+	if got := w.Worked(mixed); len(got) != 4 || got[0] != "K1" || got[1] != "KH9" || got[2] != "N8" || got[3] != "W8" {
+		t.Errorf("expected [K1 KH9 N8 W8], got %v", got)
+	}
+	if got := w.Confirmed(mixed); len(got) != 1 || got[0] != "N8" {
+		t.Errorf("expected [N8], got %v", got)
+	}
+	if got := w.Worked(awards.BandMode{Mode: "CW"}); len(got) != 3 {
+		t.Errorf("expected 3 CW prefixes, got %v", got)
+	}
+	if w.IsNeeded("N8ZZZ", mixed) {
+		t.Errorf("expected N8 to not be needed")
+	}
+	if !w.IsNeeded("W8ZZZ", awards.BandMode{Mode: "CW"}) {
+		t.Errorf("expected W8 to be needed on CW")
+	}
+}
+
+func TestWAZ(t *testing.T) {
+	w := awards.NewWAZ()
+	w.Add(adif.Record{
+		{Name: adif.Call, Value: "W1AW"},
+		{Name: adif.CQZone, Value: "5"},
+		{Name: adif.ABand, Value: "20m"},
+		{Name: adif.AMode, Value: "CW"},
+		{Name: adif.LOTWReceived, Value: "Y"},
+	})
+	w.Add(adif.Record{
+		{Name: adif.Call, Value: "JA1ABC"},
+		{Name: adif.ABand, Value: "20m"},
+		{Name: adif.AMode, Value: "CW"},
+	})
+	w.Add(adif.Record{
+		{Name: adif.Call, Value: "QQ1ABC"},
+		{Name: adif.ABand, Value: "20m"},
+		{Name: adif.AMode, Value: "CW"},
+	})
+
+	mixed := awards.BandMode{}
+	if got := w.Worked(mixed); len(got) != 2 || got[0] != 5 || got[1] != 25 {
+		t.Errorf("expected [5 25], got %v", got)
+	}
+	if got := w.Confirmed(mixed); len(got) != 1 || got[0] != 5 {
+		t.Errorf("expected [5], got %v", got)
+	}
+	if got := len(w.Needed(mixed)); got != 38 {
+		t.Errorf("expected 38 zones needed, got %d", got)
+	}
+	if !w.IsNeeded(25, awards.BandMode{Band: "40m"}) {
+		t.Errorf("expected zone 25 to be needed on 40m")
+	}
+}
+
+func TestDXCC(t *testing.T) {
+	d := awards.NewDXCC()
+	d.Add(adif.Record{
+		{Name: adif.Call, Value: "W1AW"},
+		{Name: adif.DXCC, Value: "291"},
+		{Name: adif.ABand, Value: "20m"},
+		{Name: adif.AMode, Value: "CW"},
+		{Name: adif.LOTWReceived, Value: "Y"},
+	})
+	d.Add(adif.Record{
+		{Name: adif.Call, Value: "JA1ABC"},
+		{Name: adif.ABand, Value: "40m"},
+		{Name: adif.AMode, Value: "SSB"},
+	})
+	d.Add(adif.Record{
+		{Name: adif.Call, Value: "QQ1ABC"},
+		{Name: adif.ABand, Value: "20m"},
+		{Name: adif.AMode, Value: "CW"},
+	})
+
+	mixed := awards.BandMode{}
+	if got := d.Worked(mixed); len(got) != 2 || got[0] != 291 || got[1] != 339 {
+		t.Errorf("expected [291 339], got %v", got)
+	}
+	if got := d.Confirmed(mixed); len(got) != 1 || got[0] != 291 {
+		t.Errorf("expected [291], got %v", got)
+	}
+	if d.IsNeeded(339, mixed) {
+		t.Errorf("expected Japan to not be needed")
+	}
+	if !d.IsNeeded(339, awards.BandMode{Band: "20m"}) {
+		t.Errorf("expected Japan to be needed on 20m")
+	}
+	if d.IsNeeded(291, awards.BandMode{Band: "20m", Mode: "CW"}) {
+		t.Errorf("expected USA to not be needed on 20m CW")
+	}
+}
@@ -0,0 +1,77 @@
+package awards
+
+import "sort"
+
+// BandMode identifies a band and mode combination that an award is tracked
+// for.  An empty Band or Mode matches any band or mode, so the zero value is
+// the mixed award.
+type BandMode struct {
+	Band string
+	Mode string
+}
+
+// expandBandMode returns all of the band/mode combinations that a QSO on the
+// given band and mode counts towards.
+func expandBandMode(band, mode string) []BandMode {
+	ret := []BandMode{{}}
+	if band != "" {
+		ret = append(ret, BandMode{Band: band})
+	}
+	if mode != "" {
+		ret = append(ret, BandMode{Mode: mode})
+	}
+	if band != "" && mode != "" {
+		ret = append(ret, BandMode{Band: band, Mode: mode})
+	}
+	return ret
+}
+
+// bandModeSet tracks the worked/confirmed status of award items (states,
+// prefixes, zones) by band and mode.
+type bandModeSet map[BandMode]map[string]bool
+
+// add marks an item as worked, and possibly confirmed, for all of the band/mode
+// combinations that a QSO on band and mode counts towards.
+func (s bandModeSet) add(band, mode, item string, confirmed bool) {
+	for _, bm := range expandBandMode(band, mode) {
+		items, ok := s[bm]
+		if !ok {
+			items = map[string]bool{}
+			s[bm] = items
+		}
+		items[item] = items[item] || confirmed
+	}
+}
+
+// bandModes returns the band/mode combinations with at least one item.
+func (s bandModeSet) bandModes() []BandMode {
+	ret := []BandMode{}
+	for bm := range s {
+		ret = append(ret, bm)
+	}
+	sort.Slice(ret, func(a, b int) bool {
+		if ret[a].Band != ret[b].Band {
+			return ret[a].Band < ret[b].Band
+		}
+		return ret[a].Mode < ret[b].Mode
+	})
+	return ret
+}
+
+// isWorked returns true if the item has been worked on the band/mode.
+func (s bandModeSet) isWorked(item string, bm BandMode) bool {
+	_, worked := s[bm][item]
+	return worked
+}
+
+// filter returns the sorted items on the band/mode that match fn.
+func (s bandModeSet) filter(bm BandMode, fn func(confirmed bool) bool) []string {
+	ret := []string{}
+	for item, confirmed := range s[bm] {
+		if fn(confirmed) {
+			ret = append(ret, item)
+		}
+	}
+	sort.Strings(ret)
+	return ret
+}
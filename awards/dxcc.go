@@ -0,0 +1,65 @@
+package awards
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/tzneal/ham-go/adif"
+)
+
+// DXCC tracks progress towards the ARRL DXCC award by band and mode.
+type DXCC struct {
+	entities bandModeSet
+}
+
+// NewDXCC constructs a new DXCC tracker.
+func NewDXCC() *DXCC {
+	return &DXCC{
+		entities: bandModeSet{},
+	}
+}
+
+// Add adds a QSO to the tracker.
+func (d *DXCC) Add(rec adif.Record) {
+	code := recordDXCC(rec)
+	if code == 0 {
+		return
+	}
+	d.entities.add(rec.GetBand(), strings.ToUpper(rec.Get(adif.AMode)), strconv.Itoa(code), LOTWConfirmed(rec))
+}
+
+// BandModes returns the band/mode combinations that have at least one QSO.
+func (d *DXCC) BandModes() []BandMode {
+	return d.entities.bandModes()
+}
+
+// Worked returns the entity codes worked on a band/mode in numerical order.
+func (d *DXCC) Worked(bm BandMode) []int {
+	return d.entities.codes(bm, func(confirmed bool) bool { return true })
+}
+
+// Confirmed returns the entity codes confirmed on a band/mode in numerical
+// order.
+func (d *DXCC) Confirmed(bm BandMode) []int {
+	return d.entities.codes(bm, func(confirmed bool) bool { return confirmed })
+}
+
+// IsNeeded returns true if the entity hasn't been worked on the band/mode.
+func (d *DXCC) IsNeeded(code int, bm BandMode) bool {
+	if code == 0 {
+		return false
+	}
+	return !d.entities.isWorked(strconv.Itoa(code), bm)
+}
+
+func (s bandModeSet) codes(bm BandMode, fn func(confirmed bool) bool) []int {
+	ret := []int{}
+	for _, item := range s.filter(bm, fn) {
+		if code, err := strconv.Atoi(item); err == nil {
+			ret = append(ret, code)
+		}
+	}
+	sort.Ints(ret)
+	return ret
+}
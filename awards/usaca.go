@@ -0,0 +1,134 @@
+package awards
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/tzneal/ham-go/adif"
+)
+
+// countyIndex maps a normalized "ST,NAME" key to the canonical county name
+var countyIndex = map[string]string{}
+
+func init() {
+	for st, counties := range USACounties {
+		for _, c := range counties {
+			countyIndex[countyKey(st, c)] = st + "," + c
+		}
+	}
+}
+
+// countyKey normalizes a state and county name so that minor differences in
+// how counties are logged (case, spacing, punctuation, "Saint" vs "St.", a
+// trailing "County") don't prevent a match.
+func countyKey(state, county string) string {
+	county = strings.ToUpper(county)
+	county = strings.NewReplacer(".", "", "'", "", "-", " ").Replace(county)
+	county = strings.Join(strings.Fields(county), " ")
+	if strings.HasPrefix(county, "SAINT ") {
+		county = "ST " + county[6:]
+	} else if strings.HasPrefix(county, "SAINTE ") {
+		county = "STE " + county[7:]
+	}
+	for _, sfx := range []string{" COUNTY", " PARISH", " BOROUGH"} {
+		county = strings.TrimSuffix(county, sfx)
+	}
+	return strings.ToUpper(strings.TrimSpace(state)) + "," + strings.Replace(county, " ", "", -1)
+}
+
+// LookupCounty resolves an ADIF county value of the form "ST,County" to the
+// canonical USA-CA county name, returning false if it isn't a known county.
+func LookupCounty(cnty string) (string, bool) {
+	sp := strings.SplitN(cnty, ",", 2)
+	if len(sp) != 2 {
+		return "", false
+	}
+	if c, ok := countyIndex[countyKey(sp[0], sp[1])]; ok {
+		return c, true
+	}
+	// Virginia independent cities are often logged without the suffix
+	c, ok := countyIndex[countyKey(sp[0], sp[1]+" City")]
+	return c, ok
+}
+
+// TotalCounties returns the number of counties that count for USA-CA.
+func TotalCounties() int {
+	return len(countyIndex)
+}
+
+// USACA tracks progress towards the USA Counties award.
+type USACA struct {
+	// counties maps the canonical county name to whether a QSO with that
+	// county has been confirmed
+	counties map[string]bool
+}
+
+// NewUSACA constructs a new USA-CA tracker.
+func NewUSACA() *USACA {
+	return &USACA{
+		counties: map[string]bool{},
+	}
+}
+
+// Add adds a QSO to the tracker. Both the cnty and usaca_counties fields are
+// used, the latter allowing county line QSOs to count for multiple counties.
+func (u *USACA) Add(rec adif.Record) {
+	if !isUSA(rec) {
+		return
+	}
+	confirmed := LOTWConfirmed(rec)
+	values := []string{rec.Get(adif.County)}
+	values = append(values, strings.Split(rec.Get(adif.USACACounties), ":")...)
+	for _, v := range values {
+		if c, ok := LookupCounty(v); ok {
+			u.counties[c] = u.counties[c] || confirmed
+		}
+	}
+}
+
+// Worked returns the number of counties worked.
+func (u *USACA) Worked() int {
+	return len(u.counties)
+}
+
+// Confirmed returns the number of counties confirmed.
+func (u *USACA) Confirmed() int {
+	n := 0
+	for _, confirmed := range u.counties {
+		if confirmed {
+			n++
+		}
+	}
+	return n
+}
+
+// WorkedInState returns the number of counties worked in a state.
+func (u *USACA) WorkedInState(state string) int {
+	state = strings.ToUpper(state)
+	return len(USACounties[state]) - len(u.Needed(state))
+}
+
+// IsNeeded returns true if the county, in "ST,County" form, is a valid county
+// that hasn't been worked yet.
+func (u *USACA) IsNeeded(cnty string) bool {
+	c, ok := LookupCounty(cnty)
+	if !ok {
+		return false
+	}
+	_, worked := u.counties[c]
+	return !worked
+}
+
+// Needed returns the sorted names of the counties in a state that haven't been
+// worked.
+func (u *USACA) Needed(state string) []string {
+	state = strings.ToUpper(state)
+	ret := []string{}
+	for _, c := range USACounties[state] {
+		if _, worked := u.counties[state+","+c]; !worked {
+			ret = append(ret, c)
+		}
+	}
+	sort.Strings(ret)
+	return ret
+}
@@ -0,0 +1,179 @@
+package awards
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tzneal/ham-go/adif"
+)
+
+// VUCCSatellite is the pseudo-band that satellite QSOs are tracked under as
+// they count separately from the band they were made on.
+const VUCCSatellite = "SAT"
+
+// vuccBands are the bands that VUCC is awarded for, excluding satellite.
+var vuccBands = map[string]bool{
+	"6m": true, "4m": true, "2m": true, "1.25m": true, "70cm": true, "33cm": true, "23cm": true,
+	"13cm": true, "9cm": true, "6cm": true, "3cm": true, "1.25cm": true, "6mm": true, "4mm": true,
+	"2.5mm": true, "2mm": true, "1mm": true,
+}
+
+// GridStatus is the status of a grid square for an award.
+type GridStatus int
+
+// GridStatus constants
+const (
+	GridNeeded GridStatus = iota
+	GridWorked
+	GridConfirmed
+)
+
+// VUCC tracks progress towards the VHF/UHF Century Club award.  Grids are
+// tracked per band for 6m and up, with satellite QSOs tracked separately.
+type VUCC struct {
+	// grids maps band to 4 character grid to whether a QSO with that grid
+	// has been confirmed
+	grids map[string]map[string]bool
+}
+
+// NewVUCC constructs a new VUCC tracker.
+func NewVUCC() *VUCC {
+	return &VUCC{
+		grids: map[string]map[string]bool{},
+	}
+}
+
+// NormalizeGrid returns the upper case 4 character field/square of a grid
+// locator, or false if it isn't a valid locator.
+func NormalizeGrid(grid string) (string, bool) {
+	grid = strings.ToUpper(strings.TrimSpace(grid))
+	if len(grid) < 4 {
+		return "", false
+	}
+	if grid[0] < 'A' || grid[0] > 'R' || grid[1] < 'A' || grid[1] > 'R' ||
+		grid[2] < '0' || grid[2] > '9' || grid[3] < '0' || grid[3] > '9' {
+		return "", false
+	}
+	return grid[0:4], true
+}
+
+// Add adds a QSO to the tracker. QSOs on bands below 6m are ignored. Grid line
+// and grid corner QSOs count for each grid listed in vucc_grids.
+func (v *VUCC) Add(rec adif.Record) {
+	band := rec.GetBand()
+	if strings.ToUpper(rec.Get(adif.PropMode)) == "SAT" {
+		band = VUCCSatellite
+	} else if !vuccBands[band] {
+		return
+	}
+
+	values := strings.Split(rec.Get(adif.VUCC_Grids), ",")
+	values = append(values, rec.Get(adif.GridSquare))
+	confirmed := LOTWConfirmed(rec)
+	for _, v4 := range values {
+		grid, ok := NormalizeGrid(v4)
+		if !ok {
+			continue
+		}
+		grids, ok := v.grids[band]
+		if !ok {
+			grids = map[string]bool{}
+			v.grids[band] = grids
+		}
+		grids[grid] = grids[grid] || confirmed
+	}
+}
+
+// Bands returns the bands with at least one grid worked.
+func (v *VUCC) Bands() []string {
+	ret := []string{}
+	for band := range v.grids {
+		ret = append(ret, band)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// Worked returns the sorted list of grids worked on a band.
+func (v *VUCC) Worked(band string) []string {
+	ret := []string{}
+	for grid := range v.grids[band] {
+		ret = append(ret, grid)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// Confirmed returns the sorted list of grids confirmed on a band.
+func (v *VUCC) Confirmed(band string) []string {
+	ret := []string{}
+	for grid, confirmed := range v.grids[band] {
+		if confirmed {
+			ret = append(ret, grid)
+		}
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// Status returns the status of a grid on a band.
+func (v *VUCC) Status(band, grid string) GridStatus {
+	grid, ok := NormalizeGrid(grid)
+	if !ok {
+		return GridNeeded
+	}
+	confirmed, worked := v.grids[band][grid]
+	switch {
+	case confirmed:
+		return GridConfirmed
+	case worked:
+		return GridWorked
+	}
+	return GridNeeded
+}
+
+// Map renders an ASCII map of the grids within radius squares of the home
+// grid on a band, with north at the top.  Each grid is followed by a marker
+// indicating its status: '#' for confirmed, '+' for worked and '.' for needed.
+func (v *VUCC) Map(band, home string, radius int) ([]string, error) {
+	normHome, ok := NormalizeGrid(home)
+	if !ok {
+		return nil, fmt.Errorf("invalid home grid %q", home)
+	}
+	// grids are 18 fields of 10 squares in each direction
+	lon := int(normHome[0]-'A')*10 + int(normHome[2]-'0')
+	lat := int(normHome[1]-'A')*10 + int(normHome[3]-'0')
+
+	lines := []string{}
+	for y := lat + radius; y >= lat-radius; y-- {
+		if y < 0 || y >= 180 {
+			continue
+		}
+		sb := strings.Builder{}
+		for x := lon - radius; x <= lon+radius; x++ {
+			// longitude wraps around
+			wx := (x + 180) % 180
+			grid := string([]byte{
+				byte('A' + wx/10),
+				byte('A' + y/10),
+				byte('0' + wx%10),
+				byte('0' + y%10),
+			})
+			marker := '.'
+			switch v.Status(band, grid) {
+			case GridConfirmed:
+				marker = '#'
+			case GridWorked:
+				marker = '+'
+			}
+			if sb.Len() > 0 {
+				sb.WriteByte(' ')
+			}
+			sb.WriteString(grid)
+			sb.WriteRune(marker)
+		}
+		lines = append(lines, sb.String())
+	}
+	return lines, nil
+}
@@ -0,0 +1,74 @@
+package awards
+
+import (
+	"strings"
+
+	"github.com/tzneal/ham-go/adif"
+)
+
+// WAS tracks progress towards the Worked All States award by band and mode.
+type WAS struct {
+	states bandModeSet
+}
+
+// NewWAS constructs a new WAS tracker.
+func NewWAS() *WAS {
+	return &WAS{
+		states: bandModeSet{},
+	}
+}
+
+// Add adds a QSO to the tracker.  QSOs without a state, or that are not with
+// a station in the USA are ignored.
+func (w *WAS) Add(rec adif.Record) {
+	state := strings.ToUpper(strings.TrimSpace(rec.Get(adif.State)))
+	if _, ok := USStates[state]; !ok {
+		return
+	}
+	if !isUSA(rec) {
+		return
+	}
+	w.states.add(rec.GetBand(), strings.ToUpper(rec.Get(adif.AMode)), state, LOTWConfirmed(rec))
+}
+
+// BandModes returns the band/mode combinations that have at least one QSO.
+func (w *WAS) BandModes() []BandMode {
+	return w.states.bandModes()
+}
+
+// Worked returns the sorted list of states worked on a band/mode.
+func (w *WAS) Worked(bm BandMode) []string {
+	return w.states.filter(bm, func(confirmed bool) bool { return true })
+}
+
+// Confirmed returns the sorted list of states confirmed on a band/mode.
+func (w *WAS) Confirmed(bm BandMode) []string {
+	return w.states.filter(bm, func(confirmed bool) bool { return confirmed })
+}
+
+// Unconfirmed returns the sorted list of states worked but not yet confirmed
+// on a band/mode.
+func (w *WAS) Unconfirmed(bm BandMode) []string {
+	return w.states.filter(bm, func(confirmed bool) bool { return !confirmed })
+}
+
+// Needed returns the sorted list of states that haven't been worked on a
+// band/mode.
+func (w *WAS) Needed(bm BandMode) []string {
+	ret := []string{}
+	for _, st := range StateAbbreviations() {
+		if !w.states.isWorked(st, bm) {
+			ret = append(ret, st)
+		}
+	}
+	return ret
+}
+
+// IsNeeded returns true if the state hasn't been worked on the band/mode.
+func (w *WAS) IsNeeded(state string, bm BandMode) bool {
+	state = strings.ToUpper(state)
+	if _, ok := USStates[state]; !ok {
+		return false
+	}
+	return !w.states.isWorked(state, bm)
+}
@@ -0,0 +1,87 @@
+package awards
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/tzneal/ham-go/adif"
+	"github.com/tzneal/ham-go/dxcc"
+)
+
+// NumCQZones is the number of CQ zones.
+const NumCQZones = 40
+
+// RecordCQZone returns the CQ zone of a QSO, using the cqz field if present
+// and otherwise looking up the call on the QSO date, returning zero if it can't
+// be determined.
+func RecordCQZone(rec adif.Record) int {
+	if zone := rec.GetInt(adif.CQZone); zone > 0 {
+		return int(zone)
+	}
+	ent, ok := dxcc.LookupRecord(rec)
+	if !ok {
+		return 0
+	}
+	return ent.CQZone
+}
+
+// WAZ tracks progress towards the CQ Worked All Zones award by band and mode.
+type WAZ struct {
+	zones bandModeSet
+}
+
+// NewWAZ constructs a new WAZ tracker.
+func NewWAZ() *WAZ {
+	return &WAZ{
+		zones: bandModeSet{},
+	}
+}
+
+// Add adds a QSO to the tracker.
+func (w *WAZ) Add(rec adif.Record) {
+	zone := RecordCQZone(rec)
+	if zone < 1 || zone > NumCQZones {
+		return
+	}
+	w.zones.add(rec.GetBand(), strings.ToUpper(rec.Get(adif.AMode)), strconv.Itoa(zone), LOTWConfirmed(rec))
+}
+
+// BandModes returns the band/mode combinations that have at least one QSO.
+func (w *WAZ) BandModes() []BandMode {
+	return w.zones.bandModes()
+}
+
+// Worked returns the zones worked on a band/mode in numerical order.
+func (w *WAZ) Worked(bm BandMode) []int {
+	return w.zones.zones(bm, func(worked, confirmed bool) bool { return worked })
+}
+
+// Confirmed returns the zones confirmed on a band/mode in numerical order.
+func (w *WAZ) Confirmed(bm BandMode) []int {
+	return w.zones.zones(bm, func(worked, confirmed bool) bool { return confirmed })
+}
+
+// Needed returns the zones that haven't been worked on a band/mode in
+// numerical order.
+func (w *WAZ) Needed(bm BandMode) []int {
+	return w.zones.zones(bm, func(worked, confirmed bool) bool { return !worked })
+}
+
+// IsNeeded returns true if the zone hasn't been worked on the band/mode.
+func (w *WAZ) IsNeeded(zone int, bm BandMode) bool {
+	if zone < 1 || zone > NumCQZones {
+		return false
+	}
+	return !w.zones.isWorked(strconv.Itoa(zone), bm)
+}
+
+func (s bandModeSet) zones(bm BandMode, fn func(worked, confirmed bool) bool) []int {
+	ret := []int{}
+	for zone := 1; zone <= NumCQZones; zone++ {
+		confirmed, worked := s[bm][strconv.Itoa(zone)]
+		if fn(worked, confirmed) {
+			ret = append(ret, zone)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,59 @@
+package awards
+
+import (
+	"strings"
+
+	"github.com/tzneal/ham-go/adif"
+	"github.com/tzneal/ham-go/callsigns"
+)
+
+// RecordPrefix returns the WPX prefix of a QSO, using the pfx field if present
+// and otherwise computing it from the call.
+func RecordPrefix(rec adif.Record) string {
+	if pfx := strings.ToUpper(strings.TrimSpace(rec.Get(adif.PFX))); pfx != "" {
+		return pfx
+	}
+	return callsigns.WPXPrefix(rec.Get(adif.Call))
+}
+
+// WPX tracks progress towards the CQ WPX award by band and mode.
+type WPX struct {
+	prefixes bandModeSet
+}
+
+// NewWPX constructs a new WPX tracker.
+func NewWPX() *WPX {
+	return &WPX{
+		prefixes: bandModeSet{},
+	}
+}
+
+// Add adds a QSO to the tracker.
+func (w *WPX) Add(rec adif.Record) {
+	pfx := RecordPrefix(rec)
+	if pfx == "" {
+		return
+	}
+	w.prefixes.add(rec.GetBand(), strings.ToUpper(rec.Get(adif.AMode)), pfx, LOTWConfirmed(rec))
+}
+
+// BandModes returns the band/mode combinations that have at least one QSO.
+func (w *WPX) BandModes() []BandMode {
+	return w.prefixes.bandModes()
+}
+
+// Worked returns the sorted list of prefixes worked on a band/mode.
+func (w *WPX) Worked(bm BandMode) []string {
+	return w.prefixes.filter(bm, func(confirmed bool) bool { return true })
+}
+
+// Confirmed returns the sorted list of prefixes confirmed on a band/mode.
+func (w *WPX) Confirmed(bm BandMode) []string {
+	return w.prefixes.filter(bm, func(confirmed bool) bool { return confirmed })
+}
+
+// IsNeeded returns true if the prefix of the call hasn't been worked on the
+// band/mode.
+func (w *WPX) IsNeeded(call string, bm BandMode) bool {
+	return !w.prefixes.isWorked(callsigns.WPXPrefix(call), bm)
+}
@@ -0,0 +1,52 @@
+package callsigns
+
+import (
+	"strconv"
+
+	"github.com/tzneal/ham-go/adif"
+)
+
+// FillRecord adds the looked up information to a record, skipping any fields
+// that the record already has a value for.
+func (r *Response) FillRecord(rec adif.Record) adif.Record {
+	add := func(name adif.Identifier, value string) {
+		if value == "" {
+			return
+		}
+		for i := range rec {
+			if rec[i].Name == name {
+				// fill in a field that is present but empty
+				if rec[i].Value == "" {
+					rec[i].Value = value
+				}
+				return
+			}
+		}
+		rec = append(rec, adif.Field{Name: name, Value: value})
+	}
+	addString := func(name adif.Identifier, value *string) {
+		if value != nil {
+			add(name, *value)
+		}
+	}
+	addInt := func(name adif.Identifier, value *int) {
+		if value != nil && *value != 0 {
+			add(name, strconv.Itoa(*value))
+		}
+	}
+
+	addString(adif.Name, r.Name)
+	addString(adif.GridSquare, r.Grid)
+	addString(adif.Country, r.Country)
+	addInt(adif.DXCC, r.DXCC)
+	addString(adif.QTH, r.QTH)
+	addString(adif.State, r.State)
+	addString(adif.County, r.County)
+	addString(adif.Continent, r.Continent)
+	addString(adif.IOTA, r.IOTA)
+	addString(adif.QSLVia, r.QSLVia)
+	addString(adif.Email, r.Email)
+	addInt(adif.CQZone, r.CQZone)
+	addInt(adif.ITUZone, r.ITUZone)
+	return rec
+}
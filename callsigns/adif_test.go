@@ -0,0 +1,55 @@
+package callsigns_test
+
+import (
+	"testing"
+
+	"github.com/tzneal/ham-go/adif"
+	"github.com/tzneal/ham-go/callsigns"
+)
+
+func TestFillRecord(t *testing.T) {
+	rsp := &callsigns.Response{
+		Call:      "W1AW",
+		Name:      sptr("ARRL HQ OPERATORS CLUB"),
+		Grid:      sptr("FN31pr"),
+		Country:   sptr("United States"),
+		QTH:       sptr("Newington"),
+		State:     sptr("CT"),
+		County:    sptr("CT,Hartford"),
+		Continent: sptr("NA"),
+		Email:     sptr("w1aw@arrl.org"),
+		QSLVia:    sptr("BURO"),
+		CQZone:    iptr(5),
+		ITUZone:   iptr(8),
+		DXCC:      iptr(291),
+	}
+	rec := adif.Record{
+		{Name: adif.Call, Value: "W1AW"},
+		{Name: adif.Name, Value: ""},
+		{Name: adif.GridSquare, Value: "FN31"},
+	}
+	rec = rsp.FillRecord(rec)
+
+	exp := map[adif.Identifier]string{
+		adif.Name:       "ARRL HQ OPERATORS CLUB",
+		adif.GridSquare: "FN31", // already set
+		adif.Country:    "United States",
+		adif.DXCC:       "291",
+		adif.QTH:        "Newington",
+		adif.State:      "CT",
+		adif.County:     "CT,Hartford",
+		adif.Continent:  "NA",
+		adif.Email:      "w1aw@arrl.org",
+		adif.QSLVia:     "BURO",
+		adif.CQZone:     "5",
+		adif.ITUZone:    "8",
+	}
+	for k, v := range exp {
+		if got := rec.Get(k); got != v {
+			t.Errorf("expected %s = %s, got %s", k, v, got)
+		}
+	}
+	if len(rec) != len(exp)+1 {
+		t.Errorf("expected %d fields, got %d", len(exp)+1, len(rec))
+	}
+}
@@ -0,0 +1,160 @@
+package callsigns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// ErrNotFound is returned by a lookup when the call sign is definitely not
+// known to the provider, as opposed to the lookup failing.  Only these
+// failures are cached as negative results.
+var ErrNotFound = errors.New("call sign not found")
+
+// ErrNotCached is returned by an offline cached lookup when there is no
+// cached result for a call.
+var ErrNotCached = errors.New("call sign not cached")
+
+const (
+	// DefaultCacheTTL is how long a successful lookup is cached for
+	DefaultCacheTTL = 30 * 24 * time.Hour
+	// DefaultNegativeCacheTTL is how long a not found result is cached for
+	DefaultNegativeCacheTTL = 24 * time.Hour
+)
+
+// Cache is a persistent cache of lookup results, shared between the cached
+// lookups for each provider.
+type Cache struct {
+	db *bolt.DB
+}
+
+// OpenCache opens or creates a lookup cache file.
+func OpenCache(filename string) (*Cache, error) {
+	db, err := bolt.Open(filename, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, err
+	}
+	return &Cache{db: db}, nil
+}
+
+// Close closes the cache file.
+func (c *Cache) Close() error {
+	return c.db.Close()
+}
+
+type cacheEntry struct {
+	Time     time.Time
+	Response *Response // nil for a negative result
+}
+
+func (c *Cache) get(provider, call string) (cacheEntry, bool) {
+	var ent cacheEntry
+	found := false
+	c.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(provider))
+		if b == nil {
+			return nil
+		}
+		v := b.Get([]byte(call))
+		if v == nil {
+			return nil
+		}
+		if err := json.Unmarshal(v, &ent); err == nil {
+			found = true
+		}
+		return nil
+	})
+	return ent, found
+}
+
+func (c *Cache) put(provider, call string, ent cacheEntry) error {
+	v, err := json.Marshal(ent)
+	if err != nil {
+		return err
+	}
+	return c.db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte(provider))
+		if err != nil {
+			return fmt.Errorf("create bucket: %s", err)
+		}
+		return b.Put([]byte(call), v)
+	})
+}
+
+type cached struct {
+	cache    *Cache
+	provider string
+	lookup   Lookup
+	ttl      time.Duration
+	negTTL   time.Duration
+	offline  bool
+}
+
+// NewCachedLookup wraps a lookup, caching its results under the provider name.
+// Successful lookups are cached for ttl and not found results for negTTL.  If
+// offline is true, the wrapped lookup is never called and only cached
+// results are returned regardless of their age.
+func NewCachedLookup(c *Cache, provider string, lu Lookup, ttl, negTTL time.Duration, offline bool) Lookup {
+	return &cached{
+		cache:    c,
+		provider: provider,
+		lookup:   lu,
+		ttl:      ttl,
+		negTTL:   negTTL,
+		offline:  offline,
+	}
+}
+
+func (c *cached) RequiresNetwork() bool {
+	if c.offline {
+		return false
+	}
+	return c.lookup.RequiresNetwork()
+}
+
+func (c *cached) Lookup(ctx context.Context, call string) (*Response, error) {
+	key := strings.ToUpper(strings.TrimSpace(call))
+	ent, found := c.cache.get(c.provider, key)
+	if found {
+		age := time.Now().Sub(ent.Time)
+		switch {
+		case c.offline:
+			return ent.result()
+		case ent.Response != nil && age < c.ttl:
+			return ent.result()
+		case ent.Response == nil && age < c.negTTL:
+			return ent.result()
+		}
+	}
+	if c.offline {
+		return nil, ErrNotCached
+	}
+
+	rsp, err := c.lookup.Lookup(ctx, call)
+	switch {
+	case err == nil && rsp != nil:
+		if c.ttl > 0 {
+			c.cache.put(c.provider, key, cacheEntry{Time: time.Now(), Response: rsp})
+		}
+	case errors.Is(err, ErrNotFound):
+		if c.negTTL > 0 {
+			c.cache.put(c.provider, key, cacheEntry{Time: time.Now()})
+		}
+	case err != nil && found && ent.Response != nil:
+		// the lookup failed, so an expired result is better than nothing
+		return ent.Response, nil
+	}
+	return rsp, err
+}
+
+func (e cacheEntry) result() (*Response, error) {
+	if e.Response == nil {
+		return nil, ErrNotFound
+	}
+	return e.Response, nil
+}
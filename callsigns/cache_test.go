@@ -0,0 +1,184 @@
+package callsigns_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/tzneal/ham-go/callsigns"
+)
+
+// fakeLookup is a network lookup that knows about a single call
+type fakeLookup struct {
+	calls  int
+	broken bool
+}
+
+func (f *fakeLookup) Lookup(ctx context.Context, call string) (*callsigns.Response, error) {
+	f.calls++
+	if f.broken {
+		return nil, errors.New("network is unreachable")
+	}
+	if call != "W1AW" {
+		return nil, callsigns.ErrNotFound
+	}
+	name := "ARRL HQ OPERATORS CLUB"
+	return &callsigns.Response{Call: call, Name: &name}, nil
+}
+
+func (f *fakeLookup) RequiresNetwork() bool {
+	return true
+}
+
+func newCache(t *testing.T) (*callsigns.Cache, string) {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "lookupcache")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %s", err)
+	}
+	fn := filepath.Join(dir, "lookupcache.db")
+	c, err := callsigns.OpenCache(fn)
+	if err != nil {
+		t.Fatalf("error opening cache: %s", err)
+	}
+	return c, dir
+}
+
+func TestCachedLookup(t *testing.T) {
+	c, dir := newCache(t)
+	defer os.RemoveAll(dir)
+	defer c.Close()
+
+	fake := &fakeLookup{}
+	lu := callsigns.NewCachedLookup(c, "fake", fake, time.Hour, time.Hour, false)
+	for i := 0; i < 2; i++ {
+		rsp, err := lu.Lookup(context.Background(), "W1AW")
+		if err != nil {
+			t.Fatalf("error looking up W1AW: %s", err)
+		}
+		if rsp.Name == nil || *rsp.Name != "ARRL HQ OPERATORS CLUB" {
+			t.Errorf("unexpected name %v", rsp.Name)
+		}
+		if _, err := lu.Lookup(context.Background(), "QQ1ABC"); err != callsigns.ErrNotFound {
+			t.Errorf("expected not found, got %v", err)
+		}
+	}
+	if fake.calls != 2 {
+		t.Errorf("expected 2 lookups, got %d", fake.calls)
+	}
+
+	// network errors aren't cached
+	fake.broken = true
+	if _, err := lu.Lookup(context.Background(), "K1ABC"); err == nil {
+		t.Errorf("expected an error")
+	}
+	if _, err := lu.Lookup(context.Background(), "K1ABC"); err == nil {
+		t.Errorf("expected an error")
+	}
+	if fake.calls != 4 {
+		t.Errorf("expected 4 lookups, got %d", fake.calls)
+	}
+}
+
+func TestCachedLookupExpired(t *testing.T) {
+	c, dir := newCache(t)
+	defer os.RemoveAll(dir)
+	defer c.Close()
+
+	fake := &fakeLookup{}
+	lu := callsigns.NewCachedLookup(c, "fake", fake, time.Nanosecond, time.Nanosecond, false)
+	lu.Lookup(context.Background(), "W1AW")
+	lu.Lookup(context.Background(), "QQ1ABC")
+	time.Sleep(time.Millisecond)
+	lu.Lookup(context.Background(), "W1AW")
+	lu.Lookup(context.Background(), "QQ1ABC")
+	if fake.calls != 4 {
+		t.Errorf("expected expired entries to be looked up again, got %d lookups", fake.calls)
+	}
+
+	// an expired entry is still used if the lookup fails
+	fake.broken = true
+	rsp, err := lu.Lookup(context.Background(), "W1AW")
+	if err != nil {
+		t.Fatalf("expected the expired entry, got %s", err)
+	}
+	if rsp.Name == nil || *rsp.Name != "ARRL HQ OPERATORS CLUB" {
+		t.Errorf("unexpected name %v", rsp.Name)
+	}
+}
+
+func TestCachedLookupOffline(t *testing.T) {
+	c, dir := newCache(t)
+	defer os.RemoveAll(dir)
+
+	fake := &fakeLookup{}
+	lu := callsigns.NewCachedLookup(c, "fake", fake, time.Nanosecond, time.Hour, false)
+	lu.Lookup(context.Background(), "W1AW")
+	lu.Lookup(context.Background(), "QQ1ABC")
+	c.Close()
+
+	// reopen the cache to ensure results are persisted
+	c, err := callsigns.OpenCache(filepath.Join(dir, "lookupcache.db"))
+	if err != nil {
+		t.Fatalf("error opening cache: %s", err)
+	}
+	defer c.Close()
+	fake = &fakeLookup{}
+	lu = callsigns.NewCachedLookup(c, "fake", fake, time.Nanosecond, time.Hour, true)
+	if lu.RequiresNetwork() {
+		t.Errorf("offline lookup shouldn't require the network")
+	}
+	rsp, err := lu.Lookup(context.Background(), "w1aw")
+	if err != nil {
+		t.Fatalf("error looking up W1AW: %s", err)
+	}
+	if rsp.Name == nil || *rsp.Name != "ARRL HQ OPERATORS CLUB" {
+		t.Errorf("unexpected name %v", rsp.Name)
+	}
+	if _, err := lu.Lookup(context.Background(), "QQ1ABC"); err != callsigns.ErrNotFound {
+		t.Errorf("expected not found, got %v", err)
+	}
+	if _, err := lu.Lookup(context.Background(), "K1ABC"); err != callsigns.ErrNotCached {
+		t.Errorf("expected not cached, got %v", err)
+	}
+	if fake.calls != 0 {
+		t.Errorf("expected no network lookups, got %d", fake.calls)
+	}
+}
+
+func TestBuildLookupCached(t *testing.T) {
+	c, dir := newCache(t)
+	defer os.RemoveAll(dir)
+	defer c.Close()
+
+	fake := &fakeLookup{}
+	callsigns.RegisterLookup("fake", func(cfg callsigns.LookupConfig) callsigns.Lookup {
+		return fake
+	})
+	cfg := map[string]callsigns.LookupConfig{
+		"fake": {"CacheTTL": "1h"},
+	}
+	lu := callsigns.BuildLookup(cfg, false, c)
+	lu.Lookup(context.Background(), "W1AW")
+	lu.Lookup(context.Background(), "W1AW")
+	if fake.calls != 1 {
+		t.Errorf("expected 1 lookup, got %d", fake.calls)
+	}
+
+	// without the network, cached results are still available
+	lu = callsigns.BuildLookup(cfg, true, c)
+	rsp, err := lu.Lookup(context.Background(), "W1AW")
+	if err != nil {
+		t.Fatalf("error looking up W1AW: %s", err)
+	}
+	if rsp.Name == nil || *rsp.Name != "ARRL HQ OPERATORS CLUB" {
+		t.Errorf("unexpected name %v", rsp.Name)
+	}
+	if fake.calls != 1 {
+		t.Errorf("expected 1 lookup, got %d", fake.calls)
+	}
+}
@@ -0,0 +1,223 @@
+package callsigns
+
+import "strings"
+
+// Designator is the kind of operating designator that follows a call sign,
+// e.g. the P in W1AW/P.
+type Designator int
+
+const (
+	DesignatorNone Designator = iota
+	DesignatorPortable
+	DesignatorMobile
+	DesignatorMaritimeMobile
+	DesignatorAeronauticalMobile
+	DesignatorQRP
+	DesignatorBeacon
+	// DesignatorCallArea is a single digit operating call area, e.g. W1AW/8
+	DesignatorCallArea
+	// DesignatorLocation is a country prefix the station is operating from,
+	// e.g. W1AW/KH6
+	DesignatorLocation
+	// DesignatorOther is any other suffix, e.g. a license class identifier
+	DesignatorOther
+)
+
+func (d Designator) String() string {
+	switch d {
+	case DesignatorNone:
+		return ""
+	case DesignatorPortable:
+		return "Portable"
+	case DesignatorMobile:
+		return "Mobile"
+	case DesignatorMaritimeMobile:
+		return "Maritime Mobile"
+	case DesignatorAeronauticalMobile:
+		return "Aeronautical Mobile"
+	case DesignatorQRP:
+		return "QRP"
+	case DesignatorBeacon:
+		return "Beacon"
+	case DesignatorCallArea:
+		return "Call Area"
+	case DesignatorLocation:
+		return "Location"
+	}
+	return "Other"
+}
+
+// designators maps the suffixes with a fixed meaning to their kind
+var designators = map[string]Designator{
+	"P":    DesignatorPortable,
+	"M":    DesignatorMobile,
+	"MM":   DesignatorMaritimeMobile,
+	"AM":   DesignatorAeronauticalMobile,
+	"QRP":  DesignatorQRP,
+	"QRPP": DesignatorQRP,
+	"B":    DesignatorBeacon,
+	"BCN":  DesignatorBeacon,
+	"A":    DesignatorOther,
+	"E":    DesignatorOther,
+	"J":    DesignatorOther,
+	"LH":   DesignatorOther,
+}
+
+// Callsign is a parsed call sign.
+type Callsign struct {
+	// Prefix is the country prefix written before the call when operating
+	// from another entity, e.g. KH6 in KH6/W1AW/P
+	Prefix string
+	// Base is the call sign without any prefix or suffix, e.g. W1AW
+	Base string
+	// Suffix is whatever follows the call, e.g. P in KH6/W1AW/P
+	Suffix string
+	// Designator is the kind of suffix
+	Designator Designator
+}
+
+// ParseCallsign parses a call sign such as W1AW, W1AW/P, W1AW/8, W1AW/KH6 or
+// KH6/W1AW/P.
+func ParseCallsign(sign string) Callsign {
+	sign = strings.ToUpper(strings.TrimSpace(sign))
+	sp := strings.Split(sign, "/")
+	c := Callsign{}
+	switch len(sp) {
+	case 2:
+		// some ambiguity here we need to resolve, could be a prefix or a suffix
+		if isBase(sp[0], sp[1]) {
+			c.Base, c.Suffix = sp[0], sp[1]
+		} else {
+			c.Prefix, c.Base = sp[0], sp[1]
+		}
+	case 3:
+		if isBase(sp[0], sp[1]) {
+			// W1AW/KH6/P, the location goes with the prefix
+			c.Prefix, c.Base, c.Suffix = sp[1], sp[0], sp[2]
+		} else {
+			c.Prefix, c.Base, c.Suffix = sp[0], sp[1], sp[2]
+		}
+	default:
+		c.Base = sign
+	}
+	c.Designator = designatorOf(c.Suffix)
+	return c
+}
+
+// Parse parses a call sign into its prefix/call/suffix parts. If there is no
+// prefix/suffix, those parts are the empty string.
+func Parse(sign string) (prefix, call, suffix string) {
+	c := ParseCallsign(sign)
+	return c.Prefix, c.Base, c.Suffix
+}
+
+// String returns the call sign in its normal prefix/call/suffix form.
+func (c Callsign) String() string {
+	s := c.Base
+	if c.Prefix != "" {
+		s = c.Prefix + "/" + s
+	}
+	if c.Suffix != "" {
+		s += "/" + c.Suffix
+	}
+	return s
+}
+
+// LocationPrefix returns the country prefix the station is operating from if
+// it's outside of its home entity, either written before the call (KH6/W1AW)
+// or after it (W1AW/KH6).  It returns the empty string for stations operating
+// from home.
+func (c Callsign) LocationPrefix() string {
+	if c.Prefix != "" {
+		return c.Prefix
+	}
+	if c.Designator == DesignatorLocation {
+		return c.Suffix
+	}
+	return ""
+}
+
+// DXCCPrefix returns the part of the call sign that determines the station's
+// DXCC entity, which is the location prefix if there is one and the base call
+// otherwise.
+func (c Callsign) DXCCPrefix() string {
+	if pfx := c.LocationPrefix(); pfx != "" {
+		return pfx
+	}
+	return c.Base
+}
+
+// HasEntity returns false for maritime and aeronautical mobile stations, which
+// aren't in any DXCC entity.
+func (c Callsign) HasEntity() bool {
+	return c.Designator != DesignatorMaritimeMobile &&
+		c.Designator != DesignatorAeronauticalMobile
+}
+
+// CallArea returns the call area the station is operating from, or -1 if it's
+// unknown.  A call area designator (W1AW/8) takes priority over the number in
+// a location prefix (KH6/W1AW), which takes priority over the number in the
+// call itself.
+func (c Callsign) CallArea() int {
+	if c.Designator == DesignatorCallArea {
+		return int(c.Suffix[0] - '0')
+	}
+	pfx := c.LocationPrefix()
+	if pfx == "" {
+		pfx = c.Base
+	}
+	// a leading number is part of the prefix (e.g. 4X), not a call area
+	if idx := strings.LastIndexAny(pfx, "0123456789"); idx > 0 {
+		return int(pfx[idx] - '0')
+	}
+	return -1
+}
+
+// designatorOf returns the kind of a call sign suffix.
+func designatorOf(suffix string) Designator {
+	if suffix == "" {
+		return DesignatorNone
+	}
+	if d, ok := designators[suffix]; ok {
+		return d
+	}
+	if len(suffix) == 1 && isDigit(suffix[0]) {
+		return DesignatorCallArea
+	}
+	// country prefixes are short and have at least one letter
+	if len(suffix) <= 4 && strings.IndexFunc(suffix, isLetter) != -1 &&
+		strings.Trim(suffix, "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789") == "" {
+		return DesignatorLocation
+	}
+	return DesignatorOther
+}
+
+// isBase returns true if a is the base call given the two parts a/b of a call
+// sign.
+func isBase(a, b string) bool {
+	if d := designatorOf(b); d != DesignatorLocation && d != DesignatorOther {
+		return true
+	}
+	aCall, bCall := looksLikeCall(a), looksLikeCall(b)
+	switch {
+	case aCall && bCall:
+		// the prefix is the shorter part
+		return len(a) >= len(b)
+	case bCall:
+		return false
+	}
+	return true
+}
+
+// looksLikeCall returns true if s has the shape of a full call sign rather
+// than a prefix, i.e. it has a number followed by at least one letter.
+func looksLikeCall(s string) bool {
+	if len(s) < 3 || !isLetter(rune(s[len(s)-1])) {
+		return false
+	}
+	return strings.ContainsAny(s, "0123456789")
+}
+
+func isLetter(r rune) bool {
+	return r >= 'A' && r <= 'Z'
+}
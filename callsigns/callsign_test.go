@@ -0,0 +1,57 @@
+package callsigns_test
+
+import (
+	"testing"
+
+	"github.com/tzneal/ham-go/callsigns"
+)
+
+func TestParseCallsign(t *testing.T) {
+	td := []struct {
+		Input      string
+		Prefix     string
+		Base       string
+		Suffix     string
+		Designator callsigns.Designator
+		DXCC       string
+		Area       int
+	}{
+		{"W1AW", "", "W1AW", "", callsigns.DesignatorNone, "W1AW", 1},
+		{"w1aw/p", "", "W1AW", "P", callsigns.DesignatorPortable, "W1AW", 1},
+		{"W1AW/M", "", "W1AW", "M", callsigns.DesignatorMobile, "W1AW", 1},
+		{"W1AW/MM", "", "W1AW", "MM", callsigns.DesignatorMaritimeMobile, "W1AW", 1},
+		{"W1AW/AM", "", "W1AW", "AM", callsigns.DesignatorAeronauticalMobile, "W1AW", 1},
+		{"W1AW/QRP", "", "W1AW", "QRP", callsigns.DesignatorQRP, "W1AW", 1},
+		{"W1AW/8", "", "W1AW", "8", callsigns.DesignatorCallArea, "W1AW", 8},
+		{"W1AW/KH6", "", "W1AW", "KH6", callsigns.DesignatorLocation, "KH6", 6},
+		{"KH6/W1AW", "KH6", "W1AW", "", callsigns.DesignatorNone, "KH6", 6},
+		{"KH6/W1AW/P", "KH6", "W1AW", "P", callsigns.DesignatorPortable, "KH6", 6},
+		{"W1AW/KH6/P", "KH6", "W1AW", "P", callsigns.DesignatorPortable, "KH6", 6},
+		{"PA/N8BJQ", "PA", "N8BJQ", "", callsigns.DesignatorNone, "PA", -1},
+		{"OH2AAA/4X", "", "OH2AAA", "4X", callsigns.DesignatorLocation, "4X", -1},
+		{"VP2E/KN4LHY", "VP2E", "KN4LHY", "", callsigns.DesignatorNone, "VP2E", 2},
+		{"KN4LHY/OVERHERE", "", "KN4LHY", "OVERHERE", callsigns.DesignatorOther, "KN4LHY", 4},
+		{"XEFTJW", "", "XEFTJW", "", callsigns.DesignatorNone, "XEFTJW", -1},
+	}
+	for _, tc := range td {
+		c := callsigns.ParseCallsign(tc.Input)
+		if c.Prefix != tc.Prefix || c.Base != tc.Base || c.Suffix != tc.Suffix {
+			t.Errorf("expected %s/%s/%s for %s, got %s/%s/%s", tc.Prefix, tc.Base, tc.Suffix,
+				tc.Input, c.Prefix, c.Base, c.Suffix)
+		}
+		if c.Designator != tc.Designator {
+			t.Errorf("expected designator %v for %s, got %v", tc.Designator, tc.Input, c.Designator)
+		}
+		if got := c.DXCCPrefix(); got != tc.DXCC {
+			t.Errorf("expected DXCC prefix %s for %s, got %s", tc.DXCC, tc.Input, got)
+		}
+		if got := c.CallArea(); got != tc.Area {
+			t.Errorf("expected call area %d for %s, got %d", tc.Area, tc.Input, got)
+		}
+		maritime := tc.Designator == callsigns.DesignatorMaritimeMobile ||
+			tc.Designator == callsigns.DesignatorAeronauticalMobile
+		if c.HasEntity() == maritime {
+			t.Errorf("expected HasEntity = %v for %s", !maritime, tc.Input)
+		}
+	}
+}
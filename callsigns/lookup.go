@@ -1,17 +1,12 @@
 package callsigns
 
-import (
-	"regexp"
-	"strings"
-
-	maidenhead "github.com/pd0mz/go-maidenhead"
-	"github.com/tzneal/ham-go/dxcc"
-)
+import "context"
 
 // Lookup is used to lookup a call sign via some method.
 type Lookup interface {
-	// Lookup looks up call sign information
-	Lookup(call string) (*Response, error)
+	// Lookup looks up call sign information, giving up if the context is
+	// cancelled or its deadline passes
+	Lookup(ctx context.Context, call string) (*Response, error)
 	// RequiresNetwork returns true if this lookup requires network access
 	RequiresNetwork() bool
 }
@@ -26,45 +21,29 @@ type Response struct {
 	Latitude   *float64
 	Longitude  *float64
 	Country    *string
+	QTH        *string // city or other location description
+	State      *string // two letter state abbreviation
+	County     *string // county in ADIF "ST,County" form
+	Continent  *string // two letter continent abbreviation
+	IOTA       *string // Islands on the Air reference, e.g. "NA-005"
 	DXCC       *int
 	CQZone     *int
 	ITUZone    *int
-}
-
-var validCallRegexp = regexp.MustCompile(`[\d]{0,1}[A-Z]{1,2}\d([A-Z]{1,4}|\d{3,3}|\d{1,3}[A-Z])[A-Z]{0,5}`)
+	Email      *string
+	QSLVia     *string // QSL manager or route
+	LoTW       *bool   // true if the station uses LoTW
+	EQSL       *bool   // true if the station uses eQSL
+	ImageURL   *string
+	// LicenseClass is the operator license class, e.g. "Extra"
+	LicenseClass *string
 
-// Parse parses a call sign into its prefix/call/suffix parts. If there is no
-// prefix/suffix, those parts are the empty string.
-func Parse(sign string) (prefix, call, suffix string) {
-	sign = strings.ToUpper(strings.TrimSpace(sign))
-	sp := strings.Split(sign, "/")
-	switch len(sp) {
-	case 1:
-		return "", sign, ""
-	case 2:
-		// some ambiguity here we need to resolve, could be a prefix or a suffix
-		if validCallRegexp.MatchString(sp[0]) {
-			return "", sp[0], sp[1]
-		}
-		return sp[0], sp[1], ""
-	case 3:
-		return sp[0], sp[1], sp[2]
-	}
-	return "", sign, ""
+	// Sources maps field names (e.g. "Grid") to the name of the provider
+	// that supplied them, for responses from a merged lookup.
+	Sources map[string]string
 }
 
-// AssignDXCC is used to overwrite results from a lookup response given a DXCC
-// entity.  This is useful for handling callsigns with another country prefix (e.g. ZS2/KN4LHY)
-func AssignDXCC(ent dxcc.Entity, rsp *Response) {
-	rsp.DXCC = &ent.DXCC
-	rsp.Country = &ent.Entity
-	rsp.Latitude = &ent.Latitude
-	rsp.Longitude = &ent.Longitude
-	rsp.CQZone = &ent.CQZone
-	rsp.ITUZone = &ent.ITUZone
-	pt := maidenhead.NewPoint(ent.Latitude, ent.Longitude)
-	gs, err := pt.GridSquare()
-	if err == nil {
-		rsp.Grid = &gs
-	}
+// Source returns the name of the provider that supplied a response field, or
+// the empty string if it's unknown.
+func (r *Response) Source(field string) string {
+	return r.Sources[field]
 }
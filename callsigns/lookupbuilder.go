@@ -1,15 +1,30 @@
 package callsigns
 
 import (
-	"errors"
+	"fmt"
 	"log"
 	"math"
 	"sort"
 	"strconv"
+	"time"
 )
 
 type LookupConfig map[string]string
 
+// parseDuration parses a duration option such as a cache TTL or request
+// timeout from a lookup config, returning def if it isn't specified.
+func parseDuration(cfg LookupConfig, key string, def time.Duration) (time.Duration, error) {
+	v, ok := cfg[key]
+	if !ok || v == "" {
+		return def, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %s", key, v, err)
+	}
+	return d, nil
+}
+
 type constructor func(cfg LookupConfig) Lookup
 
 var registered map[string]constructor = map[string]constructor{}
@@ -17,7 +32,12 @@ var registered map[string]constructor = map[string]constructor{}
 func RegisterLookup(name string, fn func(cfg LookupConfig) Lookup) {
 	registered[name] = fn
 }
-func BuildLookup(cfg map[string]LookupConfig, noNet bool) Lookup {
+
+// BuildLookup constructs a lookup that tries each of the configured lookups in
+// order of priority.  If cache is non-nil, results from lookups that require
+// network access are cached with the provider's CacheTTL and NegativeCacheTTL
+// durations.  With noNet set, those lookups are only answered from the cache.
+func BuildLookup(cfg map[string]LookupConfig, noNet bool, cache *Cache) Lookup {
 
 	type sortedConfig struct {
 		name string
@@ -44,97 +64,44 @@ func BuildLookup(cfg map[string]LookupConfig, noNet bool) Lookup {
 		return ap < bp
 	})
 
-	lookups := []Lookup{}
+	providers := []Provider{}
 	for _, sl := range sortedLookups {
 		if fn, ok := registered[sl.name]; ok {
 			lu := fn(sl.cfg)
+			if lu == nil {
+				log.Fatalf("error constructing lookup %s", sl.name)
+			}
+			if lu.RequiresNetwork() && cache != nil {
+				ttl, err := parseDuration(sl.cfg, "CacheTTL", DefaultCacheTTL)
+				if err != nil {
+					log.Fatalf("error constructing lookup %s: %s", sl.name, err)
+				}
+				negTTL, err := parseDuration(sl.cfg, "NegativeCacheTTL", DefaultNegativeCacheTTL)
+				if err != nil {
+					log.Fatalf("error constructing lookup %s: %s", sl.name, err)
+				}
+				lu = NewCachedLookup(cache, sl.name, lu, ttl, negTTL, noNet)
+			}
 			if noNet && lu.RequiresNetwork() {
 				continue
 			}
-			if lu == nil {
-				log.Fatalf("error constructing lookup %s", sl.name)
+			timeout, err := parseDuration(sl.cfg, "Timeout", DefaultLookupTimeout)
+			if err != nil {
+				log.Fatalf("error constructing lookup %s: %s", sl.name, err)
 			}
-			lookups = append(lookups, lu)
+			providers = append(providers, Provider{Name: sl.name, Lookup: lu, Timeout: timeout})
 		} else if !ok {
 			log.Fatalf("unknown lookup %s", sl.name)
 		}
 	}
 
-	if len(lookups) == 0 {
+	if len(providers) == 0 {
 		// add the default DXCC if none are specified
 		fn, _ := registered["dxcc"]
 		if fn != nil {
-			lookups = append(lookups, fn(nil))
-		}
-	}
-
-	return NewMergedLookup(lookups)
-}
-
-type merged struct {
-	lookups []Lookup
-}
-
-func (m merged) RequiresNetwork() bool {
-	for _, v := range m.lookups {
-		if v.RequiresNetwork() {
-			return true
+			providers = append(providers, Provider{Name: "dxcc", Lookup: fn(nil), Timeout: DefaultLookupTimeout})
 		}
 	}
-	return false
-}
 
-func NewMergedLookup(lookups []Lookup) Lookup {
-	return merged{lookups: lookups}
-}
-
-func (m merged) Lookup(call string) (*Response, error) {
-	if len(call) < 2 {
-		return nil, errors.New("lookup failed")
-	}
-
-	for _, v := range m.lookups {
-		rsp, err := v.Lookup(call)
-		if rsp != nil && err == nil {
-			if isEmpty(rsp.Country) && isEmpty(rsp.Name) {
-				continue
-			}
-			return rsp, nil
-		}
-	}
-
-	// Parallel lookup?
-	/*rspChan := make(chan *Response)
-	for _, v := range m.lookups {
-		go func() {
-			rsp, err := v.Lookup(call)
-			if err != nil {
-				log.Printf("error looking up %s: %s", call, err)
-				rspChan <- nil
-			} else {
-				rspChan <- rsp
-			}
-		}()
-	}
-	rsps := []*Response{}
-	for _ = range m.lookups {
-		r := <-rspChan
-		if r != nil {
-			rsps = append(rsps, r)
-		}
-	}
-	close(rspChan)
-
-	if len(rsps) > 0 {
-		return rsps[0], nil
-	}*/
-
-	return nil, errors.New("lookup failed")
-}
-
-func isEmpty(s *string) bool {
-	if s == nil || *s == "" {
-		return true
-	}
-	return false
+	return NewMergedLookup(providers)
 }
@@ -0,0 +1,123 @@
+package callsigns
+
+import (
+	"context"
+	"errors"
+	"log"
+	"reflect"
+	"time"
+)
+
+// DefaultLookupTimeout is how long a merged lookup waits for a provider.
+const DefaultLookupTimeout = 5 * time.Second
+
+// Provider is a named lookup used by a merged lookup.
+type Provider struct {
+	Name    string
+	Lookup  Lookup
+	Timeout time.Duration
+}
+
+type merged struct {
+	providers []Provider
+}
+
+// NewMergedLookup constructs a lookup that queries all of the providers
+// concurrently and merges their responses.  Each response field is taken from
+// the first provider in the list that supplied it, and providers that don't
+// respond within their timeout are ignored.
+func NewMergedLookup(providers []Provider) Lookup {
+	return merged{providers: providers}
+}
+
+func (m merged) RequiresNetwork() bool {
+	for _, v := range m.providers {
+		if v.Lookup.RequiresNetwork() {
+			return true
+		}
+	}
+	return false
+}
+
+type providerResult struct {
+	rsp *Response
+	err error
+}
+
+func (m merged) Lookup(ctx context.Context, call string) (*Response, error) {
+	if len(call) < 2 {
+		return nil, errors.New("lookup failed")
+	}
+
+	// buffered so that providers that ignore their deadline don't block forever
+	results := make([]chan providerResult, len(m.providers))
+	contexts := make([]context.Context, len(m.providers))
+	for i, p := range m.providers {
+		timeout := p.Timeout
+		if timeout <= 0 {
+			timeout = DefaultLookupTimeout
+		}
+		pctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		results[i] = make(chan providerResult, 1)
+		contexts[i] = pctx
+		go func(p Provider, ch chan providerResult) {
+			rsp, err := p.Lookup.Lookup(pctx, call)
+			ch <- providerResult{rsp, err}
+		}(p, results[i])
+	}
+
+	var rsp *Response
+	for i, p := range m.providers {
+		select {
+		case res := <-results[i]:
+			if res.err == nil && res.rsp != nil {
+				rsp = mergeResponse(rsp, res.rsp, p.Name)
+			}
+		case <-contexts[i].Done():
+			if ctx.Err() != nil {
+				// the whole lookup was cancelled
+				return nil, ctx.Err()
+			}
+			log.Printf("lookup of %s via %s timed out", call, p.Name)
+		}
+	}
+
+	if rsp == nil || (isEmpty(rsp.Country) && isEmpty(rsp.Name)) {
+		return nil, errors.New("lookup failed")
+	}
+	return rsp, nil
+}
+
+// mergeResponse copies the fields from src that haven't been set in dst,
+// recording the provider that supplied them.
+func mergeResponse(dst, src *Response, provider string) *Response {
+	if dst == nil {
+		dst = &Response{Call: src.Call}
+	}
+	if dst.Sources == nil {
+		dst.Sources = map[string]string{}
+	}
+	dv := reflect.ValueOf(dst).Elem()
+	sv := reflect.ValueOf(src).Elem()
+	for i := 0; i < dv.NumField(); i++ {
+		df, sf := dv.Field(i), sv.Field(i)
+		if df.Kind() != reflect.Ptr || sf.IsNil() || !df.IsNil() {
+			continue
+		}
+		// treat empty strings as missing so a later provider can fill them
+		if s, ok := sf.Interface().(*string); ok && *s == "" {
+			continue
+		}
+		df.Set(sf)
+		dst.Sources[dv.Type().Field(i).Name] = provider
+	}
+	return dst
+}
+
+func isEmpty(s *string) bool {
+	if s == nil || *s == "" {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,156 @@
+package callsigns_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tzneal/ham-go/callsigns"
+)
+
+// staticLookup returns a fixed response after an optional delay
+type staticLookup struct {
+	rsp   *callsigns.Response
+	err   error
+	delay time.Duration
+}
+
+func (s staticLookup) Lookup(ctx context.Context, call string) (*callsigns.Response, error) {
+	select {
+	case <-time.After(s.delay):
+		return s.rsp, s.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (s staticLookup) RequiresNetwork() bool {
+	return false
+}
+
+func sptr(s string) *string {
+	return &s
+}
+
+func iptr(i int) *int {
+	return &i
+}
+
+func TestMergedLookup(t *testing.T) {
+	lu := callsigns.NewMergedLookup([]callsigns.Provider{
+		{
+			Name:   "broken",
+			Lookup: staticLookup{err: errors.New("broken")},
+		},
+		{
+			Name: "callook",
+			Lookup: staticLookup{rsp: &callsigns.Response{
+				Call:  "W1AW",
+				Name:  sptr("ARRL HQ OPERATORS CLUB"),
+				Grid:  sptr(""),
+				State: sptr("CT"),
+			}},
+		},
+		{
+			Name: "slow",
+			Lookup: staticLookup{rsp: &callsigns.Response{
+				Call:   "W1AW",
+				County: sptr("CT,Hartford"),
+			}, delay: time.Second},
+			Timeout: 50 * time.Millisecond,
+		},
+		{
+			Name: "hamqth",
+			Lookup: staticLookup{rsp: &callsigns.Response{
+				Call:    "W1AW",
+				Name:    sptr("Hiram"),
+				Grid:    sptr("FN31pr"),
+				Country: sptr("United States"),
+				CQZone:  iptr(5),
+			}},
+		},
+	})
+
+	start := time.Now()
+	rsp, err := lu.Lookup(context.Background(), "W1AW")
+	if err != nil {
+		t.Fatalf("error looking up W1AW: %s", err)
+	}
+	if time.Since(start) > 500*time.Millisecond {
+		t.Errorf("expected the slow provider to time out")
+	}
+
+	td := []struct {
+		Field  string
+		Got    *string
+		Exp    string
+		Source string
+	}{
+		{"Name", rsp.Name, "ARRL HQ OPERATORS CLUB", "callook"},
+		{"Grid", rsp.Grid, "FN31pr", "hamqth"},
+		{"State", rsp.State, "CT", "callook"},
+		{"Country", rsp.Country, "United States", "hamqth"},
+	}
+	for _, tc := range td {
+		if tc.Got == nil {
+			t.Errorf("expected %s = %s, got nil", tc.Field, tc.Exp)
+			continue
+		}
+		if *tc.Got != tc.Exp {
+			t.Errorf("expected %s = %s, got %s", tc.Field, tc.Exp, *tc.Got)
+		}
+		if src := rsp.Source(tc.Field); src != tc.Source {
+			t.Errorf("expected %s from %s, got %s", tc.Field, tc.Source, src)
+		}
+	}
+	if rsp.CQZone == nil || *rsp.CQZone != 5 {
+		t.Errorf("expected CQ zone 5, got %v", rsp.CQZone)
+	}
+	if rsp.County != nil {
+		t.Errorf("expected no county from the slow provider, got %s", *rsp.County)
+	}
+	if src := rsp.Source("County"); src != "" {
+		t.Errorf("expected no county source, got %s", src)
+	}
+}
+
+func TestMergedLookupFailed(t *testing.T) {
+	lu := callsigns.NewMergedLookup([]callsigns.Provider{
+		{
+			Name:   "broken",
+			Lookup: staticLookup{err: errors.New("broken")},
+		},
+		{
+			Name:   "empty",
+			Lookup: staticLookup{rsp: &callsigns.Response{Call: "W1AW"}},
+		},
+	})
+	if _, err := lu.Lookup(context.Background(), "W1AW"); err == nil {
+		t.Errorf("expected an error")
+	}
+}
+
+func TestMergedLookupCancelled(t *testing.T) {
+	lu := callsigns.NewMergedLookup([]callsigns.Provider{
+		{
+			Name: "slow",
+			Lookup: staticLookup{rsp: &callsigns.Response{
+				Call: "W1AW",
+				Name: sptr("ARRL HQ OPERATORS CLUB"),
+			}, delay: time.Second},
+		},
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	start := time.Now()
+	if _, err := lu.Lookup(ctx, "W1AW"); err != context.Canceled {
+		t.Errorf("expected the lookup to be cancelled, got %v", err)
+	}
+	if time.Since(start) > 500*time.Millisecond {
+		t.Errorf("expected the lookup to stop when cancelled")
+	}
+}
@@ -1,11 +1,14 @@
 package providers
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"net/http"
+	"net/url"
+	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/tzneal/ham-go/dxcc"
 
@@ -15,6 +18,10 @@ import (
 type callook struct {
 }
 
+// matches the city and state in the second address line, e.g.
+// "NEWINGTON, CT 06111"
+var callookStateRegexp = regexp.MustCompile(`^\s*(.*?),\s*([A-Z]{2})\s+\d{5}`)
+
 func init() {
 	callsigns.RegisterLookup("callook", NewCallookInfo)
 }
@@ -26,13 +33,13 @@ func (c *callook) RequiresNetwork() bool {
 	return true
 }
 
-func (c *callook) Lookup(call string) (*callsigns.Response, error) {
+func (c *callook) Lookup(ctx context.Context, call string) (*callsigns.Response, error) {
 	if len(call) < 2 {
 		return nil, errors.New("invalid callsign")
 	}
 
-	prefix, realCall, suffix := callsigns.Parse(call)
-	rsp, err := http.Get(fmt.Sprintf("https://callook.info/%s/json", realCall))
+	sign := callsigns.ParseCallsign(call)
+	rsp, err := httpGet(ctx, fmt.Sprintf("https://callook.info/%s/json", url.PathEscape(sign.Base)))
 	if err != nil {
 		return nil, err
 	}
@@ -43,10 +50,9 @@ func (c *callook) Lookup(call string) (*callsigns.Response, error) {
 		return nil, err
 	}
 	if js.Status == "INVALID" {
-		return nil, errors.New("invalid callsign")
+		return nil, callsigns.ErrNotFound
 	}
 	cs := &callsigns.Response{}
-	cs.Call = realCall
 
 	if len(js.Name) > 0 {
 		cs.Name = &js.Name
@@ -54,6 +60,16 @@ func (c *callook) Lookup(call string) (*callsigns.Response, error) {
 	if len(js.Location.Gridsquare) > 0 {
 		cs.Grid = &js.Location.Gridsquare
 	}
+	if m := callookStateRegexp.FindStringSubmatch(js.Address.Line2); m != nil {
+		if m[1] != "" {
+			cs.QTH = &m[1]
+		}
+		cs.State = &m[2]
+	}
+	if c := js.Current.OperClass; c != "" {
+		// reported in upper case, e.g. "EXTRA"
+		cs.LicenseClass = sptr(strings.ToUpper(c[:1]) + strings.ToLower(c[1:]))
+	}
 	lat, latErr := strconv.ParseFloat(js.Location.Latitude, 64)
 	lon, lonErr := strconv.ParseFloat(js.Location.Longitude, 64)
 	if latErr == nil {
@@ -63,27 +79,18 @@ func (c *callook) Lookup(call string) (*callsigns.Response, error) {
 		cs.Longitude = &lon
 	}
 
-	ent, ok := dxcc.Lookup(call)
+	ent, ok := dxcc.Lookup(sign.Base)
 	if ok {
 		cs.Country = &ent.Entity
 		cs.DXCC = &ent.DXCC
 		cs.CQZone = &ent.CQZone
 		cs.ITUZone = &ent.ITUZone
+		cs.Continent = &ent.Continent
 	}
 
-	// has a prefix, so look that up and possibly overwrite what we've got for
+	// operating from somewhere else, so possibly overwrite what we've got for
 	// the call location
-	if prefix != "" {
-		cs.CallPrefix = &prefix
-		dx, ok := dxcc.Lookup(prefix)
-		if ok {
-			callsigns.AssignDXCC(dx, cs)
-		}
-	}
-	if suffix != "" {
-		cs.CallSuffix = &suffix
-	}
-
+	assignCallsign(sign, cs)
 	return cs, nil
 }
 
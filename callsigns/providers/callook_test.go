@@ -1,6 +1,7 @@
 package providers_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/tzneal/ham-go/callsigns/providers"
@@ -8,7 +9,7 @@ import (
 
 func TestCallookup(t *testing.T) {
 	lu := providers.NewCallookInfo(nil)
-	rsp, err := lu.Lookup("w1aw/p")
+	rsp, err := lu.Lookup(context.Background(), "w1aw/p")
 	if err != nil {
 		t.Fatalf("error looking up w1aw: %s", err)
 	}
@@ -1,10 +1,12 @@
 package providers
 
 import (
-	"errors"
+	"context"
+	"fmt"
 
 	"github.com/tzneal/ham-go/callsigns"
 	"github.com/tzneal/ham-go/dxcc"
+	"github.com/tzneal/ham-go/geo"
 )
 
 type dxc struct {
@@ -18,29 +20,75 @@ func NewDXCCLookup(cfg callsigns.LookupConfig) callsigns.Lookup {
 	return &dxc{}
 }
 
-func (c *dxc) Lookup(call string) (*callsigns.Response, error) {
-	prefix, realCall, suffix := callsigns.Parse(call)
-	ent, ok := dxcc.Lookup(realCall)
+func (c *dxc) Lookup(ctx context.Context, call string) (*callsigns.Response, error) {
+	cs := callsigns.ParseCallsign(call)
+	ent, ok := dxcc.Lookup(cs.String())
 	if !ok {
-		return nil, errors.New("invalid callsign")
+		return nil, fmt.Errorf("no DXCC entity for %s", cs)
 	}
 	rsp := &callsigns.Response{}
-
-	callsigns.AssignDXCC(ent, rsp)
-	rsp.Call = realCall
-	if prefix != "" {
-		rsp.CallPrefix = &prefix
-		dx, ok := dxcc.Lookup(prefix)
-		if ok {
-			callsigns.AssignDXCC(dx, rsp)
-		}
-	}
-	if prefix != "" {
-		rsp.CallSuffix = &suffix
-	}
+	assignDXCC(ent, rsp)
+	assignCallsign(cs, rsp)
 	return rsp, nil
 }
 
 func (c *dxc) RequiresNetwork() bool {
 	return false
 }
+
+// assignCallsign sets the call sign parts of a lookup response and adjusts the
+// location for where the station is operating from.  A station operating from
+// another entity (e.g. ZS2/KN4LHY) takes on that entity's information, and
+// maritime and aeronautical mobile stations aren't in any entity at all.
+func assignCallsign(cs callsigns.Callsign, rsp *callsigns.Response) {
+	rsp.Call = cs.Base
+	if cs.Prefix != "" {
+		rsp.CallPrefix = sptr(cs.Prefix)
+	}
+	if cs.Suffix != "" {
+		rsp.CallSuffix = sptr(cs.Suffix)
+	}
+
+	if !cs.HasEntity() {
+		clearLocation(rsp)
+		rsp.DXCC = nil
+		rsp.Country = nil
+		rsp.CQZone = nil
+		rsp.ITUZone = nil
+		rsp.Continent = nil
+		return
+	}
+	if cs.LocationPrefix() != "" {
+		clearLocation(rsp)
+		if ent, ok := dxcc.Lookup(cs.String()); ok {
+			assignDXCC(ent, rsp)
+		}
+	}
+}
+
+// clearLocation removes the station's home location from a lookup response,
+// for a station that isn't operating from home.
+func clearLocation(rsp *callsigns.Response) {
+	rsp.Latitude = nil
+	rsp.Longitude = nil
+	rsp.Grid = nil
+	rsp.State = nil
+	rsp.County = nil
+	rsp.QTH = nil
+}
+
+// assignDXCC is used to overwrite results from a lookup response given a DXCC
+// entity.  This is useful for handling callsigns with another country prefix (e.g. ZS2/KN4LHY)
+func assignDXCC(ent dxcc.Entity, rsp *callsigns.Response) {
+	rsp.DXCC = &ent.DXCC
+	rsp.Country = &ent.Entity
+	rsp.Latitude = &ent.Latitude
+	rsp.Longitude = &ent.Longitude
+	rsp.CQZone = &ent.CQZone
+	rsp.ITUZone = &ent.ITUZone
+	rsp.Continent = &ent.Continent
+	gs, err := geo.NewPoint(ent.Latitude, ent.Longitude).Grid(6)
+	if err == nil {
+		rsp.Grid = &gs
+	}
+}
@@ -1,10 +1,11 @@
 package providers
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
-	"net/http"
 	"net/url"
+	"sync"
 
 	"github.com/tzneal/ham-go/dxcc"
 
@@ -12,6 +13,7 @@ import (
 )
 
 type hamqth struct {
+	mu        sync.Mutex // guards the session ID
 	sessionID string
 	user      string
 	password  string
@@ -43,7 +45,7 @@ type lookupRSP struct {
 		QTH         string   `xml:"qth"`
 		Country     string   `xml:"country"`
 		ADIF        int      `xml:"adif"`
-		ITU         int      `xml:"int"`
+		ITU         int      `xml:"itu"`
 		CQ          int      `xml:"cq"`
 		Grid        string   `xml:"grid"`
 		AdrName     string   `xml:"adr_name"`
@@ -53,9 +55,13 @@ type lookupRSP struct {
 		AdrCountry  string   `xml:"adr_country"`
 		AdrAdif     int      `xml:"adr_adif"`
 		District    int      `xml:"district"`
+		USState     string   `xml:"us_state"`
+		USCounty    string   `xml:"us_county"`
 		LOTW        string   `xml:"lotw"`
 		QSL         string   `xml:"qsl"`
 		QSLDirect   string   `xml:"qsldirect"`
+		QSLVia      string   `xml:"qsl_via"`
+		IOTA        string   `xml:"iota"`
 		EQSL        string   `xml:"eqsl"`
 		Email       string   `xml:"email"`
 		Jabber      string   `xml:"jabber"`
@@ -71,9 +77,11 @@ type lookupRSP struct {
 	}
 }
 
-func (h *hamqth) Lookup(call string) (*callsigns.Response, error) {
+func (h *hamqth) Lookup(ctx context.Context, call string) (*callsigns.Response, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
 	if h.sessionID == "" {
-		rsp, err := http.Get(fmt.Sprintf("https://www.hamqth.com/xml.php?u=%s&p=%s", url.QueryEscape(h.user), url.QueryEscape(h.password)))
+		rsp, err := httpGet(ctx, fmt.Sprintf("https://www.hamqth.com/xml.php?u=%s&p=%s", url.QueryEscape(h.user), url.QueryEscape(h.password)))
 		if err != nil {
 			return nil, err
 		}
@@ -91,10 +99,10 @@ func (h *hamqth) Lookup(call string) (*callsigns.Response, error) {
 		return nil, nil
 	}
 
-	prefix, realCall, suffix := callsigns.Parse(call)
-	rsp, err := http.Get(fmt.Sprintf("https://www.hamqth.com/xml.php?id=%s&callsign=%s&prg=%s",
+	sign := callsigns.ParseCallsign(call)
+	rsp, err := httpGet(ctx, fmt.Sprintf("https://www.hamqth.com/xml.php?id=%s&callsign=%s&prg=%s",
 		url.QueryEscape(h.sessionID),
-		url.QueryEscape(realCall),
+		url.QueryEscape(sign.Base),
 		"termlog"))
 	if err != nil {
 		return nil, err
@@ -105,14 +113,10 @@ func (h *hamqth) Lookup(call string) (*callsigns.Response, error) {
 	if err := dec.Decode(&lrsp); err != nil {
 		return nil, err
 	}
-	cs := &callsigns.Response{}
-	cs.Call = realCall
-	if prefix != "" {
-		cs.CallPrefix = &prefix
-	}
-	if suffix != "" {
-		cs.CallSuffix = &suffix
+	if lrsp.Search.Error == "Callsign not found" {
+		return nil, callsigns.ErrNotFound
 	}
+	cs := &callsigns.Response{}
 	cs.Country = &lrsp.Search.Country
 	cs.CQZone = &lrsp.Search.CQ
 	cs.Grid = &lrsp.Search.Grid
@@ -120,11 +124,46 @@ func (h *hamqth) Lookup(call string) (*callsigns.Response, error) {
 	cs.Latitude = &lrsp.Search.Latitude
 	cs.Longitude = &lrsp.Search.Longitude
 	cs.Name = &lrsp.Search.Nick
+	if lrsp.Search.QTH != "" {
+		cs.QTH = &lrsp.Search.QTH
+	}
+	if lrsp.Search.Continent != "" {
+		cs.Continent = &lrsp.Search.Continent
+	}
+	if lrsp.Search.IOTA != "" {
+		cs.IOTA = &lrsp.Search.IOTA
+	}
+	if lrsp.Search.Email != "" {
+		cs.Email = &lrsp.Search.Email
+	}
+	if lrsp.Search.QSLVia != "" {
+		cs.QSLVia = &lrsp.Search.QSLVia
+	}
+	if lrsp.Search.LOTW != "" {
+		lotw := lrsp.Search.LOTW == "Y"
+		cs.LoTW = &lotw
+	}
+	if lrsp.Search.EQSL != "" {
+		eqsl := lrsp.Search.EQSL == "Y"
+		cs.EQSL = &eqsl
+	}
+	if lrsp.Search.USState != "" {
+		cs.State = &lrsp.Search.USState
+		if lrsp.Search.USCounty != "" {
+			cs.County = sptr(lrsp.Search.USState + "," + lrsp.Search.USCounty)
+		}
+	}
 	for _, d := range dxcc.Entities {
 		if d.DXCC == lrsp.Search.AdrAdif {
 			cs.DXCC = &lrsp.Search.AdrAdif
 		}
 	}
+	if cs.DXCC == nil {
+		if ent, err := dxcc.LookupEntity(lrsp.Search.Country); err == nil {
+			cs.DXCC = &ent.DXCC
+		}
+	}
+	assignCallsign(sign, cs)
 	return cs, nil
 }
 
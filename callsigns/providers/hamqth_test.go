@@ -3,7 +3,7 @@ package providers_test
 /*
 func TestHamQTH(t *testing.T) {
 	lu := providers.NewHamQTH(nil)
-	rsp, err := lu.Lookup("w1aw/p")
+	rsp, err := lu.Lookup(context.Background(), "w1aw/p")
 	if err != nil {
 		t.Fatalf("error looking up w1aw: %s", err)
 	}
@@ -0,0 +1,20 @@
+package providers
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// httpClient is used by all of the network lookups so that a slow or
+// unresponsive service can't block a lookup forever.
+var httpClient = &http.Client{Timeout: 10 * time.Second}
+
+// httpGet performs a GET request that is cancelled along with the context.
+func httpGet(ctx context.Context, url string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return httpClient.Do(req.WithContext(ctx))
+}
@@ -0,0 +1,285 @@
+package providers
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/tzneal/ham-go/callsigns"
+	"github.com/tzneal/ham-go/dxcc"
+)
+
+// QRZURL is the QRZ.com XML data service
+const QRZURL = "https://xmldata.qrz.com/xml/current/"
+
+const qrzAgent = "termlog"
+
+type qrz struct {
+	mu        sync.Mutex // guards the session ID
+	url       string
+	sessionID string
+	user      string
+	password  string
+}
+
+func init() {
+	callsigns.RegisterLookup("qrz", NewQRZ)
+}
+
+// NewQRZ constructs a QRZ.com XML lookup.  A QRZ XML subscription is required
+// to receive more than the name for a call.  The config requires a Username
+// and Password and optionally a URL to override the QRZ service URL.
+func NewQRZ(cfg callsigns.LookupConfig) callsigns.Lookup {
+	q := &qrz{
+		url:      cfg["URL"],
+		user:     cfg["Username"],
+		password: cfg["Password"],
+	}
+	if q.url == "" {
+		q.url = QRZURL
+	}
+	return q
+}
+
+func (q *qrz) RequiresNetwork() bool {
+	return true
+}
+
+type qrzSession struct {
+	Key     string `xml:"Key"`
+	Count   int    `xml:"Count"`
+	SubExp  string `xml:"SubExp"`
+	GMTime  string `xml:"GMTime"`
+	Error   string `xml:"Error"`
+	Message string `xml:"Message"`
+}
+
+type qrzRSP struct {
+	XMLName  xml.Name `xml:"QRZDatabase"`
+	Callsign *struct {
+		Call     string  `xml:"call"`
+		FName    string  `xml:"fname"`
+		Name     string  `xml:"name"`
+		Nickname string  `xml:"nickname"`
+		NameFmt  string  `xml:"name_fmt"`
+		Addr1    string  `xml:"addr1"`
+		Addr2    string  `xml:"addr2"`
+		State    string  `xml:"state"`
+		Zip      string  `xml:"zip"`
+		Country  string  `xml:"country"`
+		Land     string  `xml:"land"`
+		DXCC     int     `xml:"dxcc"`
+		Lat      float64 `xml:"lat"`
+		Lon      float64 `xml:"lon"`
+		Grid     string  `xml:"grid"`
+		County   string  `xml:"county"`
+		QSLMgr   string  `xml:"qslmgr"`
+		Email    string  `xml:"email"`
+		EQSL     string  `xml:"eqsl"`
+		LoTW     string  `xml:"lotw"`
+		CQZone   int     `xml:"cqzone"`
+		ITUZone  int     `xml:"ituzone"`
+		Image    string  `xml:"image"`
+		IOTA     string  `xml:"iota"`
+	} `xml:"Callsign"`
+	Session qrzSession `xml:"Session"`
+}
+
+// errQRZSession is returned when the session key has expired or is invalid
+var errQRZSession = errors.New("qrz session expired")
+
+func (q *qrz) Lookup(ctx context.Context, call string) (*callsigns.Response, error) {
+	if len(call) < 2 {
+		return nil, errors.New("invalid callsign")
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.sessionID == "" {
+		if err := q.login(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	sign := callsigns.ParseCallsign(call)
+	rsp, err := q.lookup(ctx, sign.Base)
+	if err == errQRZSession {
+		// session keys expire after 24 hours, so log in again and retry once
+		if err := q.login(ctx); err != nil {
+			return nil, err
+		}
+		rsp, err = q.lookup(ctx, sign.Base)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	cs := &callsigns.Response{}
+
+	c := rsp.Callsign
+	if name := qrzName(c.FName, c.Nickname, c.Name, c.NameFmt); name != "" {
+		cs.Name = &name
+	}
+	if c.Grid != "" {
+		cs.Grid = sptr(c.Grid)
+	}
+	if c.Lat != 0 || c.Lon != 0 {
+		cs.Latitude = &c.Lat
+		cs.Longitude = &c.Lon
+	}
+	if c.Country != "" {
+		cs.Country = sptr(c.Country)
+	}
+	if c.Addr2 != "" {
+		cs.QTH = sptr(c.Addr2)
+	}
+	if c.Email != "" {
+		cs.Email = sptr(c.Email)
+	}
+	if c.IOTA != "" {
+		cs.IOTA = sptr(c.IOTA)
+	}
+	if c.DXCC != 0 {
+		cs.DXCC = iptr(c.DXCC)
+	}
+	if c.CQZone != 0 {
+		cs.CQZone = iptr(c.CQZone)
+	}
+	if c.ITUZone != 0 {
+		cs.ITUZone = iptr(c.ITUZone)
+	}
+	// state and county are only meaningful for US stations
+	if c.State != "" && isUSEntity(c.DXCC) {
+		cs.State = sptr(c.State)
+		if c.County != "" {
+			cs.County = sptr(c.State + "," + c.County)
+		}
+	}
+	if c.QSLMgr != "" {
+		cs.QSLVia = sptr(c.QSLMgr)
+	}
+	if c.LoTW != "" {
+		lotw := c.LoTW == "1"
+		cs.LoTW = &lotw
+	}
+	if c.EQSL != "" {
+		eqsl := c.EQSL == "1"
+		cs.EQSL = &eqsl
+	}
+	if c.Image != "" {
+		cs.ImageURL = sptr(c.Image)
+	}
+
+	// missing zone information can be filled in from the DXCC data
+	if ent, ok := dxcc.Lookup(sign.Base); ok {
+		if cs.Country == nil {
+			cs.Country = &ent.Entity
+		}
+		if cs.DXCC == nil {
+			cs.DXCC = &ent.DXCC
+		}
+		if cs.CQZone == nil {
+			cs.CQZone = &ent.CQZone
+		}
+		if cs.ITUZone == nil {
+			cs.ITUZone = &ent.ITUZone
+		}
+		cs.Continent = &ent.Continent
+	}
+
+	// operating from another country, so look that up and overwrite what we've
+	// got for the call location
+	assignCallsign(sign, cs)
+	return cs, nil
+}
+
+// login retrieves a new session key
+func (q *qrz) login(ctx context.Context) error {
+	q.sessionID = ""
+	v := url.Values{}
+	v.Set("username", q.user)
+	v.Set("password", q.password)
+	v.Set("agent", qrzAgent)
+	rsp, err := q.get(ctx, v)
+	if err != nil {
+		return err
+	}
+	if rsp.Session.Key == "" {
+		if rsp.Session.Error != "" {
+			return fmt.Errorf("qrz login failed: %s", rsp.Session.Error)
+		}
+		return errors.New("qrz login failed")
+	}
+	q.sessionID = rsp.Session.Key
+	return nil
+}
+
+// lookup looks up a call using the current session key
+func (q *qrz) lookup(ctx context.Context, call string) (*qrzRSP, error) {
+	v := url.Values{}
+	v.Set("s", q.sessionID)
+	v.Set("callsign", call)
+	rsp, err := q.get(ctx, v)
+	if err != nil {
+		return nil, err
+	}
+	if rsp.Session.Key == "" {
+		// no key in the response means the session is no longer valid
+		q.sessionID = ""
+		return nil, errQRZSession
+	}
+	q.sessionID = rsp.Session.Key
+	if rsp.Callsign == nil {
+		if strings.HasPrefix(rsp.Session.Error, "Not found") {
+			return nil, callsigns.ErrNotFound
+		}
+		if rsp.Session.Error != "" {
+			return nil, fmt.Errorf("qrz: %s", rsp.Session.Error)
+		}
+		return nil, errors.New("qrz: no callsign data")
+	}
+	return rsp, nil
+}
+
+func (q *qrz) get(ctx context.Context, v url.Values) (*qrzRSP, error) {
+	rsp, err := httpGet(ctx, q.url+"?"+v.Encode())
+	if err != nil {
+		return nil, err
+	}
+	defer rsp.Body.Close()
+	if rsp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("qrz: %s", rsp.Status)
+	}
+	dec := xml.NewDecoder(rsp.Body)
+	qr := &qrzRSP{}
+	if err := dec.Decode(qr); err != nil {
+		return nil, err
+	}
+	return qr, nil
+}
+
+// qrzName constructs a display name from the QRZ name fields, preferring the
+// name formatted as the user requested.
+func qrzName(first, nick, last, formatted string) string {
+	if formatted != "" {
+		return formatted
+	}
+	if nick != "" {
+		first = nick
+	}
+	return strings.TrimSpace(first + " " + last)
+}
+
+// isUSEntity returns true if the DXCC entity is one of the US states, i.e. the
+// contiguous US, Alaska or Hawaii.
+func isUSEntity(dxcc int) bool {
+	switch dxcc {
+	case 6, 110, 291:
+		return true
+	}
+	return false
+}
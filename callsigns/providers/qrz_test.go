@@ -0,0 +1,343 @@
+package providers_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/tzneal/ham-go/callsigns"
+	"github.com/tzneal/ham-go/callsigns/providers"
+)
+
+const qrzLoginRSP = `<?xml version="1.0" ?>
+<QRZDatabase version="1.34" xmlns="http://xmldata.qrz.com">
+<Session>
+<Key>%s</Key>
+<Count>123</Count>
+<SubExp>Wed Jan 1 12:34:03 2031</SubExp>
+<GMTime>Sun Aug 16 03:51:47 2020</GMTime>
+</Session>
+</QRZDatabase>`
+
+const qrzW1AWRSP = `<?xml version="1.0" ?>
+<QRZDatabase version="1.34" xmlns="http://xmldata.qrz.com">
+<Callsign>
+<call>W1AW</call>
+<aliases>W1AW/1</aliases>
+<dxcc>291</dxcc>
+<fname>ARRL HQ OPERATORS</fname>
+<name>CLUB</name>
+<name_fmt>ARRL HQ OPERATORS CLUB</name_fmt>
+<addr1>225 MAIN ST</addr1>
+<addr2>NEWINGTON</addr2>
+<state>CT</state>
+<zip>06111</zip>
+<country>United States</country>
+<lat>41.714775</lat>
+<lon>-72.727260</lon>
+<grid>FN31pr</grid>
+<county>Hartford</county>
+<land>United States</land>
+<qslmgr>VIA BUREAU</qslmgr>
+<email>w1aw@arrl.org</email>
+<eqsl>0</eqsl>
+<lotw>1</lotw>
+<cqzone>5</cqzone>
+<ituzone>8</ituzone>
+<image>https://cdn-xml.qrz.com/w1aw.jpg</image>
+</Callsign>
+<Session>
+<Key>%s</Key>
+<Count>124</Count>
+<SubExp>Wed Jan 1 12:34:03 2031</SubExp>
+<GMTime>Sun Aug 16 03:51:47 2020</GMTime>
+</Session>
+</QRZDatabase>`
+
+// qrzStateRSP is a response for a call in a DXCC entity with a state
+const qrzStateRSP = `<?xml version="1.0" ?>
+<QRZDatabase version="1.34" xmlns="http://xmldata.qrz.com">
+<Callsign>
+<call>%s</call>
+<dxcc>%d</dxcc>
+<state>%s</state>
+<county>%s</county>
+</Callsign>
+<Session>
+<Key>%s</Key>
+</Session>
+</QRZDatabase>`
+
+const qrzTimeoutRSP = `<?xml version="1.0" ?>
+<QRZDatabase version="1.34" xmlns="http://xmldata.qrz.com">
+<Session>
+<Error>Session Timeout</Error>
+<GMTime>Sun Aug 16 03:51:47 2020</GMTime>
+</Session>
+</QRZDatabase>`
+
+const qrzNotFoundRSP = `<?xml version="1.0" ?>
+<QRZDatabase version="1.34" xmlns="http://xmldata.qrz.com">
+<Session>
+<Key>%s</Key>
+<Error>Not found: QQ1ABC</Error>
+<GMTime>Sun Aug 16 03:51:47 2020</GMTime>
+</Session>
+</QRZDatabase>`
+
+// fakeQRZ is a local stand-in for the QRZ XML service that issues a new
+// session key on every login.
+type fakeQRZ struct {
+	logins int
+	key    string
+}
+
+func (f *fakeQRZ) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Get("username") != "" {
+		if q.Get("username") != "kn4lhy" || q.Get("password") != "secret" {
+			fmt.Fprint(w, `<QRZDatabase><Session><Error>Username/password incorrect</Error></Session></QRZDatabase>`)
+			return
+		}
+		f.logins++
+		f.key = fmt.Sprintf("key%d", f.logins)
+		fmt.Fprintf(w, qrzLoginRSP, f.key)
+		return
+	}
+	if q.Get("s") != f.key {
+		fmt.Fprint(w, qrzTimeoutRSP)
+		return
+	}
+	switch q.Get("callsign") {
+	case "W1AW":
+		fmt.Fprintf(w, qrzW1AWRSP, f.key)
+	case "KL7ABC":
+		fmt.Fprintf(w, qrzStateRSP, "KL7ABC", 6, "AK", "Anchorage", f.key)
+	case "KH6ABC":
+		fmt.Fprintf(w, qrzStateRSP, "KH6ABC", 110, "HI", "Honolulu", f.key)
+	case "VE3ABC":
+		fmt.Fprintf(w, qrzStateRSP, "VE3ABC", 1, "ON", "York", f.key)
+	default:
+		fmt.Fprintf(w, qrzNotFoundRSP, f.key)
+	}
+}
+
+func newQRZ(url string) callsigns.Lookup {
+	return providers.NewQRZ(callsigns.LookupConfig{
+		"URL":      url,
+		"Username": "kn4lhy",
+		"Password": "secret",
+	})
+}
+
+func TestQRZ(t *testing.T) {
+	fake := &fakeQRZ{}
+	srv := httptest.NewServer(fake)
+	defer srv.Close()
+
+	lu := newQRZ(srv.URL)
+	rsp, err := lu.Lookup(context.Background(), "w1aw/p")
+	if err != nil {
+		t.Fatalf("error looking up w1aw: %s", err)
+	}
+	if rsp.Call != "W1AW" {
+		t.Errorf("expected W1AW, got %s", rsp.Call)
+	}
+	if rsp.CallSuffix == nil || *rsp.CallSuffix != "P" {
+		t.Errorf("expected suffix P, got %v", rsp.CallSuffix)
+	}
+	expStr := []struct {
+		Name string
+		Got  *string
+		Exp  string
+	}{
+		{"name", rsp.Name, "ARRL HQ OPERATORS CLUB"},
+		{"grid", rsp.Grid, "FN31pr"},
+		{"country", rsp.Country, "United States"},
+		{"state", rsp.State, "CT"},
+		{"county", rsp.County, "CT,Hartford"},
+		{"QSL via", rsp.QSLVia, "VIA BUREAU"},
+		{"QTH", rsp.QTH, "NEWINGTON"},
+		{"email", rsp.Email, "w1aw@arrl.org"},
+		{"continent", rsp.Continent, "NA"},
+		{"image", rsp.ImageURL, "https://cdn-xml.qrz.com/w1aw.jpg"},
+	}
+	for _, tc := range expStr {
+		if tc.Got == nil {
+			t.Errorf("expected %s %s, got nil", tc.Name, tc.Exp)
+		} else if *tc.Got != tc.Exp {
+			t.Errorf("expected %s %s, got %s", tc.Name, tc.Exp, *tc.Got)
+		}
+	}
+	if rsp.Latitude == nil || *rsp.Latitude != 41.714775 {
+		t.Errorf("expected latitude 41.714775, got %v", rsp.Latitude)
+	}
+	if rsp.Longitude == nil || *rsp.Longitude != -72.727260 {
+		t.Errorf("expected longitude -72.727260, got %v", rsp.Longitude)
+	}
+	if rsp.DXCC == nil || *rsp.DXCC != 291 {
+		t.Errorf("expected DXCC 291, got %v", rsp.DXCC)
+	}
+	if rsp.CQZone == nil || *rsp.CQZone != 5 {
+		t.Errorf("expected CQ zone 5, got %v", rsp.CQZone)
+	}
+	if rsp.LoTW == nil || !*rsp.LoTW {
+		t.Errorf("expected LoTW user, got %v", rsp.LoTW)
+	}
+	if rsp.EQSL == nil || *rsp.EQSL {
+		t.Errorf("expected non-eQSL user, got %v", rsp.EQSL)
+	}
+	if fake.logins != 1 {
+		t.Errorf("expected a single login, got %d", fake.logins)
+	}
+
+	// a second lookup reuses the session
+	if _, err := lu.Lookup(context.Background(), "W1AW"); err != nil {
+		t.Fatalf("error looking up w1aw: %s", err)
+	}
+	if fake.logins != 1 {
+		t.Errorf("expected the session to be reused, got %d logins", fake.logins)
+	}
+}
+
+func TestQRZState(t *testing.T) {
+	srv := httptest.NewServer(&fakeQRZ{})
+	defer srv.Close()
+
+	lu := newQRZ(srv.URL)
+	sval := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return *s
+	}
+	td := []struct {
+		Call   string
+		State  string
+		County string
+	}{
+		{"KL7ABC", "AK", "AK,Anchorage"},
+		{"KH6ABC", "HI", "HI,Honolulu"},
+		// provinces aren't US states
+		{"VE3ABC", "", ""},
+	}
+	for _, tc := range td {
+		rsp, err := lu.Lookup(context.Background(), tc.Call)
+		if err != nil {
+			t.Fatalf("error looking up %s: %s", tc.Call, err)
+		}
+		if got := sval(rsp.State); got != tc.State {
+			t.Errorf("expected %s state %q, got %q", tc.Call, tc.State, got)
+		}
+		if got := sval(rsp.County); got != tc.County {
+			t.Errorf("expected %s county %q, got %q", tc.Call, tc.County, got)
+		}
+	}
+}
+
+func TestQRZAwayFromHome(t *testing.T) {
+	srv := httptest.NewServer(&fakeQRZ{})
+	defer srv.Close()
+
+	lu := newQRZ(srv.URL)
+	td := []struct {
+		Call    string
+		Country string
+		Grid    bool
+	}{
+		// the entity's location replaces the home one
+		{"ZS2/W1AW", "South Africa", true},
+		// maritime and aeronautical mobile have no location at all
+		{"W1AW/MM", "", false},
+		{"W1AW/AM", "", false},
+	}
+	for _, tc := range td {
+		rsp, err := lu.Lookup(context.Background(), tc.Call)
+		if err != nil {
+			t.Fatalf("error looking up %s: %s", tc.Call, err)
+		}
+		if rsp.State != nil || rsp.County != nil || rsp.QTH != nil {
+			t.Errorf("expected no home state, county or QTH for %s", tc.Call)
+		}
+		if rsp.Grid != nil && *rsp.Grid == "FN31pr" {
+			t.Errorf("expected the home grid to be replaced for %s", tc.Call)
+		}
+		if (rsp.Grid != nil) != tc.Grid || (rsp.Latitude != nil) != tc.Grid || (rsp.Longitude != nil) != tc.Grid {
+			t.Errorf("expected %s to have a location %v, got grid %v", tc.Call, tc.Grid, rsp.Grid)
+		}
+		country := ""
+		if rsp.Country != nil {
+			country = *rsp.Country
+		}
+		if country != tc.Country {
+			t.Errorf("expected %s country %q, got %q", tc.Call, tc.Country, country)
+		}
+	}
+}
+
+func TestQRZSessionExpired(t *testing.T) {
+	fake := &fakeQRZ{}
+	srv := httptest.NewServer(fake)
+	defer srv.Close()
+
+	lu := newQRZ(srv.URL)
+	if _, err := lu.Lookup(context.Background(), "W1AW"); err != nil {
+		t.Fatalf("error looking up w1aw: %s", err)
+	}
+	// expire the session on the server side
+	fake.key = "expired"
+	rsp, err := lu.Lookup(context.Background(), "W1AW")
+	if err != nil {
+		t.Fatalf("error looking up w1aw after session expiry: %s", err)
+	}
+	if rsp.Name == nil || *rsp.Name != "ARRL HQ OPERATORS CLUB" {
+		t.Errorf("unexpected name %v", rsp.Name)
+	}
+	if fake.logins != 2 {
+		t.Errorf("expected a second login, got %d", fake.logins)
+	}
+}
+
+func TestQRZErrors(t *testing.T) {
+	fake := &fakeQRZ{}
+	srv := httptest.NewServer(fake)
+	defer srv.Close()
+
+	lu := newQRZ(srv.URL)
+	if _, err := lu.Lookup(context.Background(), "QQ1ABC"); err == nil {
+		t.Errorf("expected an error for an unknown call")
+	}
+
+	lu = providers.NewQRZ(callsigns.LookupConfig{
+		"URL":      srv.URL,
+		"Username": "kn4lhy",
+		"Password": "wrong",
+	})
+	if _, err := lu.Lookup(context.Background(), "W1AW"); err == nil {
+		t.Errorf("expected an error for invalid credentials")
+	}
+}
+
+func TestQRZDeadline(t *testing.T) {
+	done := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// never responds until the test is over
+		<-done
+	}))
+	defer srv.Close()
+	defer close(done)
+
+	lu := newQRZ(srv.URL)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, err := lu.Lookup(ctx, "W1AW"); err == nil {
+		t.Errorf("expected an error")
+	}
+	if time.Since(start) > 5*time.Second {
+		t.Errorf("expected the lookup to stop at the deadline")
+	}
+}
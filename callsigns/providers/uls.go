@@ -0,0 +1,96 @@
+package providers
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/tzneal/ham-go/callsigns"
+	"github.com/tzneal/ham-go/dxcc"
+	"github.com/tzneal/ham-go/uls"
+)
+
+type ulsLookup struct {
+	path string
+	once sync.Once
+	db   *uls.Database
+	err  error
+}
+
+func init() {
+	callsigns.RegisterLookup("uls", NewULS)
+}
+
+// NewULS constructs an offline lookup of US calls from a license database
+// built with uls.Import.  The config Path is the database file, defaulting to
+// uls.DefaultFile.
+func NewULS(cfg callsigns.LookupConfig) callsigns.Lookup {
+	path := cfg["Path"]
+	if path == "" {
+		path = uls.DefaultFile
+	}
+	if strings.HasPrefix(path, "~") {
+		if home, err := os.UserHomeDir(); err == nil {
+			path = filepath.Join(home, path[1:])
+		}
+	}
+	return &ulsLookup{path: path}
+}
+
+func (u *ulsLookup) RequiresNetwork() bool {
+	return false
+}
+
+func (u *ulsLookup) Lookup(ctx context.Context, call string) (*callsigns.Response, error) {
+	// opened on first use, so there's no cost if it's never needed
+	u.once.Do(func() {
+		if _, err := os.Stat(u.path); err != nil {
+			u.err = uls.ErrNotImported
+			return
+		}
+		u.db, u.err = uls.Open(u.path)
+	})
+	if u.err != nil {
+		return nil, u.err
+	}
+
+	sign := callsigns.ParseCallsign(call)
+	lic, found, err := u.db.Lookup(sign.Base)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, callsigns.ErrNotFound
+	}
+
+	cs := &callsigns.Response{}
+	if lic.Name != "" {
+		cs.Name = sptr(lic.Name)
+	}
+	if lic.City != "" {
+		cs.QTH = sptr(lic.City)
+	}
+	if lic.State != "" {
+		cs.State = sptr(lic.State)
+	}
+	if lic.Class != "" {
+		cs.LicenseClass = sptr(lic.Class)
+	}
+	if lic.Grid != "" {
+		cs.Grid = sptr(lic.Grid)
+		lat, lon := lic.Latitude, lic.Longitude
+		cs.Latitude = &lat
+		cs.Longitude = &lon
+	}
+	if ent, ok := dxcc.Lookup(sign.Base); ok {
+		cs.Country = &ent.Entity
+		cs.DXCC = &ent.DXCC
+		cs.CQZone = &ent.CQZone
+		cs.ITUZone = &ent.ITUZone
+		cs.Continent = &ent.Continent
+	}
+	assignCallsign(sign, cs)
+	return cs, nil
+}
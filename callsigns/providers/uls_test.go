@@ -0,0 +1,78 @@
+package providers_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/tzneal/ham-go/callsigns"
+	"github.com/tzneal/ham-go/callsigns/providers"
+	"github.com/tzneal/ham-go/uls"
+)
+
+func TestULS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "uls")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	for fn, data := range map[string]string{
+		"HD.dat": "HD|101|0000000002||KN4LHY|A|HA|01/01/2020|01/01/2030|||\n",
+		"AM.dat": "AM|101|0000000002||KN4LHY|E|D|4||||||||||\n",
+		"EN.dat": "EN|101|0000000002||KN4LHY|L|L00002|Neal, Todd|Todd||Neal|||||1 Any St|Madison|AL|35756||||0002|||\n",
+	} {
+		if err := ioutil.WriteFile(filepath.Join(dir, fn), []byte(data), 0644); err != nil {
+			t.Fatalf("error writing %s: %s", fn, err)
+		}
+	}
+
+	dbFile := filepath.Join(dir, "uls.db")
+	lu := providers.NewULS(callsigns.LookupConfig{"Path": dbFile})
+	if lu.RequiresNetwork() {
+		t.Errorf("expected ULS lookups to work offline")
+	}
+	if _, err := uls.Import(dir, dbFile); err != nil {
+		t.Fatalf("error importing: %s", err)
+	}
+
+	rsp, err := lu.Lookup(context.Background(), "KN4LHY/P")
+	if err != nil {
+		t.Fatalf("error looking up KN4LHY: %s", err)
+	}
+	if rsp.Name == nil || *rsp.Name != "Todd Neal" {
+		t.Errorf("unexpected name %v", rsp.Name)
+	}
+	if rsp.QTH == nil || *rsp.QTH != "Madison" {
+		t.Errorf("unexpected QTH %v", rsp.QTH)
+	}
+	if rsp.State == nil || *rsp.State != "AL" {
+		t.Errorf("unexpected state %v", rsp.State)
+	}
+	if rsp.LicenseClass == nil || *rsp.LicenseClass != "Extra" {
+		t.Errorf("unexpected license class %v", rsp.LicenseClass)
+	}
+	// the prefix puts the station in Canada, away from the licensed address
+	rsp, err = lu.Lookup(context.Background(), "VE3/KN4LHY/P")
+	if err != nil {
+		t.Fatalf("error looking up VE3/KN4LHY/P: %s", err)
+	}
+	if rsp.Country == nil || *rsp.Country != "Canada" {
+		t.Errorf("unexpected country %v", rsp.Country)
+	}
+	if rsp.QTH != nil || rsp.State != nil {
+		t.Errorf("expected no US QTH or state in Canada, got %v %v", rsp.QTH, rsp.State)
+	}
+
+	if _, err := lu.Lookup(context.Background(), "K1ABC"); err != callsigns.ErrNotFound {
+		t.Errorf("expected not found, got %v", err)
+	}
+}
+
+func TestULSNotImported(t *testing.T) {
+	lu := providers.NewULS(callsigns.LookupConfig{"Path": filepath.Join(os.TempDir(), "does-not-exist-uls.db")})
+	if _, err := lu.Lookup(context.Background(), "KN4LHY"); err != uls.ErrNotImported {
+		t.Errorf("expected not imported, got %v", err)
+	}
+}
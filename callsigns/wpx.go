@@ -0,0 +1,52 @@
+package callsigns
+
+import "strings"
+
+// WPXPrefix returns the prefix of a call sign following the CQ WPX rules:
+//   - the prefix is the letter/number combination that forms the first part of
+//     the call, e.g. N8BJQ is N8 and HG19ABC is HG19
+//   - a portable designator becomes the prefix, e.g. N8BJQ/KH9 is KH9
+//   - a portable designator that doesn't end in a number is assigned a zero,
+//     e.g. PA/N8BJQ is PA0 and OH2AAA/4X is 4X0
+//   - a call area designator replaces the number, e.g. N8BJQ/3 is N3 and
+//     PA/N8BJQ/3 is PA3
+//   - calls without numbers are assigned a zero after the first two letters,
+//     e.g. XEFTJW is XE0
+//   - mobile, portable, maritime mobile and license class designators are
+//     ignored
+func WPXPrefix(sign string) string {
+	c := ParseCallsign(sign)
+	pfx := c.LocationPrefix()
+	if pfx != "" {
+		if !isDigit(pfx[len(pfx)-1]) {
+			pfx += "0"
+		}
+	} else {
+		pfx = callPrefix(c.Base)
+	}
+	if c.Designator == DesignatorCallArea {
+		pfx = strings.TrimRight(pfx, "0123456789") + c.Suffix
+	}
+	return pfx
+}
+
+// callPrefix returns the leading letters and numbers of a call up to and
+// including the last number before the suffix letters.
+func callPrefix(call string) string {
+	if !strings.ContainsAny(call, "0123456789") {
+		if len(call) < 2 {
+			return call + "0"
+		}
+		return call[0:2] + "0"
+	}
+	// skip back over the suffix letters
+	end := len(call)
+	for end > 0 && !isDigit(call[end-1]) {
+		end--
+	}
+	return call[0:end]
+}
+
+func isDigit(b byte) bool {
+	return b >= '0' && b <= '9'
+}
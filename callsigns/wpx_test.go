@@ -0,0 +1,42 @@
+package callsigns_test
+
+import (
+	"testing"
+
+	"github.com/tzneal/ham-go/callsigns"
+)
+
+func TestWPXPrefix(t *testing.T) {
+	td := []struct {
+		Call   string
+		Prefix string
+	}{
+		{"N8BJQ", "N8"},
+		{"W8AW", "W8"},
+		{"WD8ABC", "WD8"},
+		{"HG1A", "HG1"},
+		{"HG19ABC", "HG19"},
+		{"LY1000X", "LY1000"},
+		{"2E0ABC", "2E0"},
+		{"9A1A", "9A1"},
+		{"3DA0RU", "3DA0"},
+		{"kn4lhy", "KN4"},
+		{"N8BJQ/KH9", "KH9"},
+		{"OH2AAA/4X", "4X0"},
+		{"PA/N8BJQ", "PA0"},
+		{"N8BJQ/3", "N3"},
+		{"PA/N8BJQ/3", "PA3"},
+		{"KH6/N8BJQ/2", "KH2"},
+		{"N8BJQ/P", "N8"},
+		{"N8BJQ/MM", "N8"},
+		{"N8BJQ/QRP", "N8"},
+		{"ZS2/KN4LHY/P", "ZS2"},
+		{"XEFTJW", "XE0"},
+		{"RAEM", "RA0"},
+	}
+	for _, tc := range td {
+		if got := callsigns.WPXPrefix(tc.Call); got != tc.Prefix {
+			t.Errorf("expected %s for %s, got %s", tc.Prefix, tc.Call, got)
+		}
+	}
+}
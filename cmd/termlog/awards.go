@@ -0,0 +1,300 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/tzneal/ham-go/adif"
+	"github.com/tzneal/ham-go/awards"
+	"github.com/tzneal/ham-go/pota"
+	"github.com/tzneal/ham-go/sota"
+)
+
+// awardTrackers holds the award trackers that are computed from the logs
+type awardTrackers struct {
+	was   *awards.WAS
+	usaca *awards.USACA
+	vucc  *awards.VUCC
+	wpx   *awards.WPX
+	waz   *awards.WAZ
+
+	parks         pota.Parks
+	potaActivator *pota.Activator
+	potaHunter    *pota.Hunter
+
+	sota        *sota.Tracker
+	sotaInvalid []string // QSOs with invalid summit references
+}
+
+func newAwardTrackers(parks pota.Parks, summits sota.Summits) *awardTrackers {
+	return &awardTrackers{
+		was:           awards.NewWAS(),
+		usaca:         awards.NewUSACA(),
+		vucc:          awards.NewVUCC(),
+		wpx:           awards.NewWPX(),
+		waz:           awards.NewWAZ(),
+		parks:         parks,
+		potaActivator: pota.NewActivator(),
+		potaHunter:    pota.NewHunter(),
+		sota:          sota.NewTracker(summits),
+	}
+}
+
+func (a *awardTrackers) Add(rec adif.Record) {
+	a.was.Add(rec)
+	a.usaca.Add(rec)
+	a.vucc.Add(rec)
+	a.wpx.Add(rec)
+	a.waz.Add(rec)
+	a.potaActivator.Add(rec)
+	a.potaHunter.Add(rec)
+	if err := a.sota.Add(rec); err != nil {
+		a.sotaInvalid = append(a.sotaInvalid, fmt.Sprintf("%s %s %s: %s", rec.Get(adif.QSODateStart),
+			rec.Get(adif.TimeOn), rec.Get(adif.Call), err))
+	}
+}
+
+// loadParks loads the configured POTA park list, returning an empty list if
+// there isn't one.
+func loadParks(c *Config) pota.Parks {
+	if c.POTA.ParkList == "" {
+		return pota.Parks{}
+	}
+	parks, err := pota.LoadParksFile(expandPath(c.POTA.ParkList))
+	if err != nil {
+		log.Printf("error loading POTA park list: %s", err)
+		return pota.Parks{}
+	}
+	return parks
+}
+
+// walkLogs calls fn for every valid record in the ADIF logs found under dir.
+// The log named skip is not read from disk, allowing the in-memory copy of the
+// current log to be used instead.
+func walkLogs(dir string, skip string, fn func(rec adif.Record)) error {
+	return filepath.Walk(dir,
+		func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			ext := strings.ToLower(filepath.Ext(path))
+			if ext != ".adi" && ext != ".adif" {
+				return nil
+			}
+			if skip != "" && filepath.Clean(path) == filepath.Clean(skip) {
+				return nil
+			}
+			alog, err := adif.ParseFile(path)
+			if err != nil {
+				log.Printf("error parsing %s: %s", path, err)
+				return nil
+			}
+			for _, rec := range alog.Records() {
+				if adif.IsValid(rec) {
+					fn(rec)
+				}
+			}
+			return nil
+		})
+}
+
+// loadSummits loads the configured SOTA summits list, returning an empty list
+// if there isn't one.
+func loadSummits(c *Config) sota.Summits {
+	if c.SOTA.SummitList == "" {
+		return sota.Summits{}
+	}
+	summits, err := sota.LoadSummitsFile(expandPath(c.SOTA.SummitList))
+	if err != nil {
+		log.Printf("error loading SOTA summits list: %s", err)
+		return sota.Summits{}
+	}
+	return summits
+}
+
+// loadAwards computes award progress from all of the logs in the log directory
+// and the current log.
+func loadAwards(c *Config, current *adif.Log, parks pota.Parks, summits sota.Summits) (*awardTrackers, error) {
+	at := newAwardTrackers(parks, summits)
+	skip := ""
+	if current != nil {
+		skip = current.Filename
+		for _, rec := range current.Records() {
+			if adif.IsValid(rec) {
+				at.Add(rec)
+			}
+		}
+	}
+	err := walkLogs(expandPath(c.Operator.Logdir), skip, at.Add)
+	return at, err
+}
+
+// PrintAwards prints award progress for all of the logs in the log directory.
+func PrintAwards(c *Config) error {
+	at, err := loadAwards(c, nil, loadParks(c), loadSummits(c))
+	if err != nil {
+		return err
+	}
+	at.WriteReport(os.Stdout)
+	return nil
+}
+
+// WriteReport writes a detailed report of award progress.
+func (a *awardTrackers) WriteReport(w io.Writer) {
+	fmt.Fprintln(w, "Worked All States")
+	writeBandModes(w, a.was.BandModes(), func(bm awards.BandMode) (int, int) {
+		return len(a.was.Worked(bm)), len(a.was.Confirmed(bm))
+	})
+	mixed := awards.BandMode{}
+	fmt.Fprintf(w, "Needed: %s\n", strings.Join(a.was.Needed(mixed), " "))
+	fmt.Fprintf(w, "Unconfirmed: %s\n", strings.Join(a.was.Unconfirmed(mixed), " "))
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(w, "USA Counties")
+	fmt.Fprintf(w, "Worked %d/%d, Confirmed %d/%d\n", a.usaca.Worked(), awards.TotalCounties(),
+		a.usaca.Confirmed(), awards.TotalCounties())
+	for _, st := range awards.StateAbbreviations() {
+		needed := a.usaca.Needed(st)
+		if len(needed) == 0 {
+			continue
+		}
+		fmt.Fprintf(w, "%s (%d/%d): %s\n", st, a.usaca.WorkedInState(st), len(awards.USACounties[st]),
+			strings.Join(needed, ", "))
+	}
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(w, "Worked All Zones")
+	writeBandModes(w, a.waz.BandModes(), func(bm awards.BandMode) (int, int) {
+		return len(a.waz.Worked(bm)), len(a.waz.Confirmed(bm))
+	})
+	fmt.Fprintf(w, "Needed: %s\n", joinInts(a.waz.Needed(mixed)))
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(w, "WPX")
+	writeBandModes(w, a.wpx.BandModes(), func(bm awards.BandMode) (int, int) {
+		return len(a.wpx.Worked(bm)), len(a.wpx.Confirmed(bm))
+	})
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(w, "VUCC")
+	tw := tabwriter.NewWriter(w, 8, 8, 1, ' ', 0)
+	fmt.Fprintf(tw, "Band\tWorked\tConfirmed\n")
+	for _, band := range a.vucc.Bands() {
+		fmt.Fprintf(tw, "%s\t%d\t%d\n", band, len(a.vucc.Worked(band)), len(a.vucc.Confirmed(band)))
+	}
+	tw.Flush()
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(w, "POTA Activations")
+	tw = tabwriter.NewWriter(w, 8, 8, 1, ' ', 0)
+	fmt.Fprintf(tw, "Date\tPark\tQSOs\tP2P\tValid\tName\n")
+	for _, act := range a.potaActivator.Activations() {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%v\t%s\n", act.Date, act.Park, act.QSOs(), act.ParkToPark, act.Valid(),
+			a.parks.Name(act.Park))
+	}
+	tw.Flush()
+	fmt.Fprintln(w)
+
+	fmt.Fprintf(w, "POTA Parks Hunted: %d\n", len(a.potaHunter.Parks()))
+	tw = tabwriter.NewWriter(w, 8, 8, 1, ' ', 0)
+	fmt.Fprintf(tw, "Park\tQSOs\tName\n")
+	for _, park := range a.potaHunter.Parks() {
+		fmt.Fprintf(tw, "%s\t%d\t%s\n", park, a.potaHunter.QSOs(park), a.parks.Name(park))
+	}
+	tw.Flush()
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(w, "SOTA")
+	fmt.Fprintf(w, "Activator Points: %d, Chaser Points: %d, S2S QSOs: %d\n", a.sota.ActivatorPoints(),
+		a.sota.ChaserPoints(), a.sota.S2S())
+	fmt.Fprintf(w, "Summits Activated: %d, Summits Chased: %d\n", len(a.sota.ActivatedSummits()),
+		len(a.sota.ChasedSummits()))
+	tw = tabwriter.NewWriter(w, 8, 8, 1, ' ', 0)
+	fmt.Fprintf(tw, "Date\tSummit\tQSOs\tS2S\tQualifies\n")
+	for _, act := range a.sota.Activations() {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%v\n", act.Date, act.Summit, act.QSOs(), act.S2S, act.Qualifies())
+	}
+	tw.Flush()
+	for _, inv := range a.sotaInvalid {
+		fmt.Fprintf(w, "invalid reference %s\n", inv)
+	}
+}
+
+// Summary returns a short summary of award progress, suitable for display in
+// a dialog.
+func (a *awardTrackers) Summary() string {
+	sb := strings.Builder{}
+	mixed := awards.BandMode{}
+	fmt.Fprintf(&sb, "WAS: %d worked, %d confirmed\n", len(a.was.Worked(mixed)), len(a.was.Confirmed(mixed)))
+	writeWrapped(&sb, "Needed: ", a.was.Needed(mixed))
+	writeWrapped(&sb, "Unconfirmed: ", a.was.Unconfirmed(mixed))
+	sb.WriteString("\n")
+	fmt.Fprintf(&sb, "USA-CA: %d/%d worked, %d confirmed\n", a.usaca.Worked(), awards.TotalCounties(),
+		a.usaca.Confirmed())
+	fmt.Fprintf(&sb, "WAZ: %d worked, %d confirmed\n", len(a.waz.Worked(mixed)), len(a.waz.Confirmed(mixed)))
+	writeWrapped(&sb, "Needed: ", strings.Fields(joinInts(a.waz.Needed(mixed))))
+	fmt.Fprintf(&sb, "WPX: %d prefixes worked, %d confirmed\n", len(a.wpx.Worked(mixed)),
+		len(a.wpx.Confirmed(mixed)))
+	for _, band := range a.vucc.Bands() {
+		fmt.Fprintf(&sb, "VUCC %s: %d worked, %d confirmed\n", band, len(a.vucc.Worked(band)),
+			len(a.vucc.Confirmed(band)))
+	}
+	valid := 0
+	for _, act := range a.potaActivator.Activations() {
+		if act.Valid() {
+			valid++
+		}
+	}
+	fmt.Fprintf(&sb, "POTA: %d parks hunted, %d valid activations\n", len(a.potaHunter.Parks()), valid)
+	fmt.Fprintf(&sb, "SOTA: %d activator points, %d chaser points, %d S2S\n", a.sota.ActivatorPoints(),
+		a.sota.ChaserPoints(), a.sota.S2S())
+	return sb.String()
+}
+
+// writeWrapped writes a list of short values, wrapping the lines so they fit
+// in a dialog.
+func writeWrapped(sb *strings.Builder, label string, values []string) {
+	line := label
+	for _, v := range values {
+		if len(line)+len(v) > 60 {
+			sb.WriteString(line + "\n")
+			line = strings.Repeat(" ", len(label))
+		}
+		line += v + " "
+	}
+	sb.WriteString(line + "\n")
+}
+
+// writeBandModes writes a table of worked and confirmed counts for each band
+// and mode combination.
+func writeBandModes(w io.Writer, bms []awards.BandMode, counts func(bm awards.BandMode) (int, int)) {
+	tw := tabwriter.NewWriter(w, 8, 8, 1, ' ', 0)
+	fmt.Fprintf(tw, "Band\tMode\tWorked\tConfirmed\n")
+	for _, bm := range bms {
+		band, mode := bm.Band, bm.Mode
+		if band == "" {
+			band = "mixed"
+		}
+		if mode == "" {
+			mode = "mixed"
+		}
+		worked, confirmed := counts(bm)
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\n", band, mode, worked, confirmed)
+	}
+	tw.Flush()
+}
+
+// joinInts joins a list of integers with spaces.
+func joinInts(values []int) string {
+	s := make([]string, len(values))
+	for i, v := range values {
+		s[i] = strconv.Itoa(v)
+	}
+	return strings.Join(s, " ")
+}
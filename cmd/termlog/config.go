@@ -1,9 +1,11 @@
 package main
 
 import (
+	"fmt"
 	"io/ioutil"
 	"os"
 	"os/exec"
+	"strconv"
 
 	"github.com/BurntSushi/toml"
 	"github.com/go-git/go-git/v5/plumbing/transport/ssh"
@@ -65,6 +67,17 @@ type DXCluster struct {
 	Server     string
 	Port       int
 	ZoneLookup bool
+	Commands   []string // sent after logging in, e.g. set/filter commands
+}
+
+// RBN allows enabled Reverse Beacon Network or CW skimmer monitoring
+type RBN struct {
+	Enabled           bool
+	Server            string
+	Port              int
+	WindowSeconds     int // how long reports from different skimmers are aggregated
+	HoldoffMinutes    int // how long before a station is spotted again
+	MaxSpotsPerMinute int
 }
 
 // POTASpot allows enabled POTA spot monitoring
@@ -79,6 +92,16 @@ type SOTASpot struct {
 	URL     string
 }
 
+// POTA configures Parks on the Air tracking
+type POTA struct {
+	ParkList string // path to the POTA park list CSV, used for park names
+}
+
+// SOTA configures Summits on the Air tracking
+type SOTA struct {
+	SummitList string // path to the SOTA summitslist.csv, used to validate references
+}
+
 // Label is a label that will be displayed when tuned to a particular frequency.
 // The start/end are the limits.
 type Label struct {
@@ -97,8 +120,14 @@ type Config struct {
 	FLLog      FLLog
 	Lookup     map[string]callsigns.LookupConfig
 	DXCluster  DXCluster
+	RBN        RBN
 	POTASpot   POTASpot
 	SOTASpot   SOTASpot
+	SpotSource []spotting.SourceConfig
+	SpotFilter spotting.FilterConfig
+	SpotAlert  spotting.AlertConfig
+	POTA       POTA
+	SOTA       SOTA
 	Theme      ui.Theme
 	Label      []Label
 	noNet      bool // lowercase, so it shouldn't be serialized
@@ -113,6 +142,48 @@ func (c *Config) GitAuth() (*ssh.PublicKeys, error) {
 	return nil, nil
 }
 
+// SpotSources returns the enabled spot sources, both those in the SpotSource
+// list and the DXCluster, RBN, POTASpot and SOTASpot sections.
+func (c *Config) SpotSources() []spotting.SourceConfig {
+	var ret []spotting.SourceConfig
+	if c.DXCluster.Enabled {
+		ret = append(ret, spotting.SourceConfig{
+			Type:     "dxcluster",
+			Address:  fmt.Sprintf("%s:%d", c.DXCluster.Server, c.DXCluster.Port),
+			Commands: c.DXCluster.Commands,
+			Options:  map[string]string{"ZoneLookup": strconv.FormatBool(c.DXCluster.ZoneLookup)},
+		})
+	}
+	if c.RBN.Enabled {
+		ret = append(ret, spotting.SourceConfig{
+			Type:    "rbn",
+			Address: fmt.Sprintf("%s:%d", c.RBN.Server, c.RBN.Port),
+			Options: map[string]string{
+				"WindowSeconds":     strconv.Itoa(c.RBN.WindowSeconds),
+				"HoldoffMinutes":    strconv.Itoa(c.RBN.HoldoffMinutes),
+				"MaxSpotsPerMinute": strconv.Itoa(c.RBN.MaxSpotsPerMinute),
+			},
+		})
+	}
+	if c.POTASpot.Enabled {
+		ret = append(ret, spotting.SourceConfig{Type: "pota", Address: c.POTASpot.URL})
+	}
+	if c.SOTASpot.Enabled {
+		ret = append(ret, spotting.SourceConfig{Type: "sota", Address: c.SOTASpot.URL})
+	}
+	for _, src := range c.SpotSource {
+		if !src.Disabled {
+			ret = append(ret, src)
+		}
+	}
+	for i := range ret {
+		if ret[i].Callsign == "" {
+			ret[i].Callsign = c.Operator.Call
+		}
+	}
+	return ret
+}
+
 // SaveAs saves a config file to disk.
 func (c *Config) SaveAs(filename string) error {
 	f, err := os.Create(filename)
@@ -202,6 +273,12 @@ func NewConfig() *Config {
 
 	cfg.DXCluster.ZoneLookup = true
 
+	cfg.RBN.Server = "telnet.reversebeacon.net"
+	cfg.RBN.Port = 7000
+	cfg.RBN.WindowSeconds = 10
+	cfg.RBN.HoldoffMinutes = 10
+	cfg.RBN.MaxSpotsPerMinute = 30
+
 	// POTA
 	cfg.POTASpot.Enabled = true
 	cfg.POTASpot.URL = spotting.POTAURL
@@ -0,0 +1,19 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/tzneal/ham-go/dxcc"
+)
+
+// UpdateCountryFile validates a cty.csv, cty.dat or Club Log cty.xml country
+// file and installs it in dir, where it's loaded at startup in place of the
+// built in DXCC data.
+func UpdateCountryFile(dir string, filename string) error {
+	dst, n, err := dxcc.Install(filename, dir)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("installed %s with %d entities\n", dst, n)
+	return nil
+}
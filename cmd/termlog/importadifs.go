@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/tzneal/ham-go/adif"
+	"github.com/tzneal/ham-go/dxcc"
 )
 
 func ImportAdifs(filenames []string, dst *adif.Log) {
@@ -42,7 +43,8 @@ func ImportAdifs(filenames []string, dst *adif.Log) {
 			}
 			if !haveRecord {
 				nAdded++
-				dst.AddRecord(r)
+				// fill in the entity as of the QSO date if it's missing
+				dst.AddRecord(dxcc.FillRecord(r))
 				dirtyLog = true
 			}
 		}
@@ -21,8 +21,10 @@ import (
 
 	"github.com/tzneal/ham-go"
 	"github.com/tzneal/ham-go/adif"
+	"github.com/tzneal/ham-go/callsigns"
 	_ "github.com/tzneal/ham-go/callsigns/providers" // to register providers
 	"github.com/tzneal/ham-go/db"
+	"github.com/tzneal/ham-go/dxcc"
 )
 
 func main() {
@@ -38,6 +40,9 @@ have occurred *after* the oldest record in the current logfile`)
 	keyTest := flag.Bool("key-test", false, "list keyboard events")
 	upgradeConfig := flag.Bool("upgrade-config", false, "upgrade the configuration file to the latest format")
 	syncLOTWQSQL := flag.Bool("sync-lotw-qsl", false, "fetches QSL information from LoTW to update log QSL information in the default log directory")
+	showAwards := flag.Bool("awards", false, "print WAS, USA-CA, WAZ, WPX, VUCC, POTA and SOTA award progress for the logs in the default log directory")
+	updateCTY := flag.String("update-cty", "", "validate and install a cty.csv, cty.dat or Club Log cty.xml country file in the cty directory of the config directory")
+	importULS := flag.String("import-uls", "", "import the FCC ULS amateur license files (EN.dat, HD.dat and AM.dat) from a directory for offline US call sign lookups")
 	config := flag.String("config", "~/.termlog.toml", "path to the configuration file")
 
 	flag.Parse()
@@ -94,6 +99,19 @@ have occurred *after* the oldest record in the current logfile`)
 		os.Exit(1)
 	}
 
+	// country files in the cty directory of the config directory override
+	// the built in DXCC data
+	ctyDir := filepath.Join(filepath.Dir(*config), "cty")
+	if *updateCTY != "" {
+		if err := UpdateCountryFile(ctyDir, expandPath(*updateCTY)); err != nil {
+			log.Printf("error updating country file: %s", err)
+		}
+		return
+	}
+	if _, err := dxcc.Load(ctyDir); err != nil {
+		log.Printf("error loading country file, using built in DXCC data: %s", err)
+	}
+
 	if *syncLOTWQSQL {
 		if err := SyncLOTWQSL(cfg); err != nil {
 			log.Printf("error syncing LoTW QSLs: %s", err)
@@ -101,6 +119,20 @@ have occurred *after* the oldest record in the current logfile`)
 		return
 	}
 
+	if *showAwards {
+		if err := PrintAwards(cfg); err != nil {
+			log.Printf("error computing awards: %s", err)
+		}
+		return
+	}
+
+	if *importULS != "" {
+		if err := ImportULS(cfg, expandPath(*importULS)); err != nil {
+			log.Printf("error importing ULS data: %s", err)
+		}
+		return
+	}
+
 	// go open the log
 	logDir := expandPath(cfg.Operator.Logdir)
 
@@ -236,7 +268,15 @@ have occurred *after* the oldest record in the current logfile`)
 		}
 	}
 
-	mainScreen := newMainScreen(cfg, alog, logRepo, bookmarks, rc, d)
+	// a missing lookup cache isn't fatal, lookups just go to the network
+	lookupCache, err := callsigns.OpenCache(filepath.Join(logDir, "lookupcache.db"))
+	if err != nil {
+		log.Printf("error opening callsign lookup cache: %s", err)
+	} else {
+		defer lookupCache.Close()
+	}
+
+	mainScreen := newMainScreen(cfg, alog, logRepo, bookmarks, rc, d, lookupCache)
 	for _, l := range plog.logs {
 		mainScreen.logInfo(l)
 	}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -8,6 +9,7 @@ import (
 	"os/exec"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/dh1tw/goHamlib"
@@ -18,15 +20,20 @@ import (
 
 	"github.com/tzneal/ham-go"
 	"github.com/tzneal/ham-go/adif"
+	"github.com/tzneal/ham-go/awards"
 	"github.com/tzneal/ham-go/cabrillo"
 	"github.com/tzneal/ham-go/callsigns"
 	"github.com/tzneal/ham-go/cmd/termlog/input"
 	"github.com/tzneal/ham-go/cmd/termlog/ui"
+	"github.com/tzneal/ham-go/contest"
 	"github.com/tzneal/ham-go/db"
 	"github.com/tzneal/ham-go/dxcc"
+	"github.com/tzneal/ham-go/geo"
 	"github.com/tzneal/ham-go/logingest"
 	"github.com/tzneal/ham-go/logsync"
+	"github.com/tzneal/ham-go/pota"
 	"github.com/tzneal/ham-go/rig"
+	"github.com/tzneal/ham-go/sota"
 	"github.com/tzneal/ham-go/spotting"
 )
 
@@ -49,6 +56,16 @@ type mainScreen struct {
 	shutdown        chan struct{}
 	lookup          callsigns.Lookup
 	loggingReplaced bool
+	parks           pota.Parks
+	summits         sota.Summits
+	dxclient        *spotting.DXClusterClient
+	sources         []spotting.Source
+	console         *ui.ClusterConsole
+	bandmap         *ui.BandMap
+	spotFilter      *spotting.Filter
+	needed          *neededTracker
+	progress        *potaProgress // nil unless activating a park
+	alerts          chan spotting.Alert
 }
 type logRequest struct {
 	record   adif.Record
@@ -62,7 +79,7 @@ func (m *mainScreen) Write(p []byte) (n int, err error) {
 }
 
 func newMainScreen(cfg *Config, alog *adif.Log, repo *git.Repository, bookmarks *ham.Bookmarks, rig *rig.RigCache,
-	d *db.Database) *mainScreen {
+	d *db.Database, lookupCache *callsigns.Cache) *mainScreen {
 	c := ui.NewController(cfg.Theme)
 	c.RefreshEvery(250 * time.Millisecond)
 
@@ -75,7 +92,15 @@ func newMainScreen(cfg *Config, alog *adif.Log, repo *git.Repository, bookmarks
 	sb.AddClock("Local")
 	sb.AddText("/")
 	sb.AddClock("UTC")
-	if cfg.Operator.Sig != "" && cfg.Operator.SigInfo != "" {
+	var progress *potaProgress
+	if strings.EqualFold(cfg.Operator.Sig, pota.SIG) && cfg.Operator.SigInfo != "" {
+		// show progress towards a valid activation
+		parks := pota.References(cfg.Operator.SigInfo)
+		sb.AddText("/")
+		sb.AddText(cfg.Operator.Sig)
+		progress = newPOTAProgress(alog, parks)
+		sb.AddFunction(progress.String, 12*len(parks))
+	} else if cfg.Operator.Sig != "" && cfg.Operator.SigInfo != "" {
 		sb.AddText("/")
 		sb.AddText(cfg.Operator.Sig)
 		sb.AddText(cfg.Operator.SigInfo)
@@ -84,7 +109,7 @@ func newMainScreen(cfg *Config, alog *adif.Log, repo *git.Repository, bookmarks
 	yPos++
 	remainingHeight--
 
-	lookup := callsigns.BuildLookup(cfg.Lookup, cfg.noNet)
+	lookup := callsigns.BuildLookup(cfg.Lookup, cfg.noNet, lookupCache)
 	qso := ui.NewQSO(yPos, c.Theme(), lookup, cfg.Operator.CustomFields, rig)
 	c.AddWidget(qso)
 	yPos += qso.Height()
@@ -95,7 +120,11 @@ func newMainScreen(cfg *Config, alog *adif.Log, repo *git.Repository, bookmarks
 	msgHeight := 5
 
 	// but fill the screen if the spotting is disbled
-	if !cfg.DXCluster.Enabled && !cfg.POTASpot.Enabled {
+	var spotSources []spotting.SourceConfig
+	if !cfg.noNet {
+		spotSources = cfg.SpotSources()
+	}
+	if len(spotSources) == 0 {
 		// - 1 due to the status bar
 		qsoHeight = remainingHeight - 1 - msgHeight
 	}
@@ -109,12 +138,38 @@ func newMainScreen(cfg *Config, alog *adif.Log, repo *git.Repository, bookmarks
 
 	// is the spot monitoring enabled?
 	shutdown := make(chan struct{})
-	if !cfg.noNet && (cfg.DXCluster.Enabled || cfg.POTASpot.Enabled) {
+	var dxclient *spotting.DXClusterClient
+	var console *ui.ClusterConsole
+	var bandmap *ui.BandMap
+	var sources []spotting.Source
+	var sourceErrs []error
+
+	// spots are filtered before they're displayed
+	spotFilter, filterErr := spotting.NewFilter(cfg.SpotFilter)
+	if filterErr != nil {
+		spotFilter, _ = spotting.NewFilter(spotting.FilterConfig{})
+	}
+	needed := newNeededTracker(cfg, alog, d)
+	spotFilter.SetNeeded(needed.IsNeeded)
+	if home, err := geo.ParseGrid(cfg.Operator.Grid); err == nil {
+		spotFilter.SetHome(home)
+	}
+	alerter, alertErr := spotting.NewAlerter(cfg.SpotAlert)
+	if alertErr != nil {
+		alerter, _ = spotting.NewAlerter(spotting.AlertConfig{})
+	}
+	alerter.SetNeeded(needed.IsNeeded)
+	alerts := make(chan spotting.Alert, 10)
+
+	if len(spotSources) > 0 {
 		// create the UI
 		dxHeight := remainingHeight - 1 - msgHeight // -1 due to status bar
-		spotlist := ui.NewSpottingList(yPos, dxHeight, time.Duration(cfg.Operator.SpotExpiration)*time.Second, cfg.Theme)
+		expiration := time.Duration(cfg.Operator.SpotExpiration) * time.Second
+		spotlist := ui.NewSpottingList(yPos, dxHeight, expiration, cfg.Theme)
+		_, h := termbox.Size()
+		bandmap = ui.NewBandMap(1, h-2, expiration, rig, cfg.Theme)
 		if rig != nil {
-			spotlist.OnTune(func(f float64) {
+			tune := func(f float64) {
 				f = f * 1e6
 				// ensure we are in the proper mode
 				if f < 10000000 {
@@ -123,106 +178,67 @@ func newMainScreen(cfg *Config, alog *adif.Log, repo *git.Repository, bookmarks
 					rig.SetMode(goHamlib.VFOCurrent, goHamlib.ModeUSB, 0)
 				}
 				rig.SetFreq(goHamlib.VFOCurrent, f)
-			})
+			}
+			spotlist.OnTune(tune)
+			bandmap.OnTune(tune)
 		}
+		bandmap.OnStatus(func(spot spotting.Spot) ui.SpotStatus {
+			switch {
+			case needed.IsNeeded(spot, spotting.NeededBand):
+				return ui.SpotNeeded
+			case !needed.IsNeeded(spot, spotting.NeededCall):
+				return ui.SpotWorked
+			}
+			return ui.SpotNew
+		})
 		c.AddWidget(spotlist)
 		yPos += dxHeight
 
-		if cfg.DXCluster.Enabled {
-			dcfg := spotting.DXClusterConfig{
-				Network:    "tcp",
-				Address:    fmt.Sprintf("%s:%d", cfg.DXCluster.Server, cfg.DXCluster.Port),
-				Callsign:   cfg.Operator.Call,
-				ZoneLookup: cfg.DXCluster.ZoneLookup,
+		spots := make(chan spotting.Spot)
+		for _, scfg := range spotSources {
+			src, err := spotting.NewSource(scfg)
+			if err != nil {
+				sourceErrs = append(sourceErrs, err)
+				continue
 			}
-			dxclient := spotting.NewDXClusterClient(dcfg)
-			dxclient.Run()
-			go func() {
-				for {
-					select {
-					case <-shutdown:
-						return
-					case spot := <-dxclient.Spots:
-						st := fmt.Sprintf("%s %s", time.Now().Format("02 Jan 06"), spot.Time)
-						tm, err := time.Parse("02 Jan 06 1504Z", st)
-						if err != nil {
-							log.Printf("error parsing DX time: %s", err)
-						}
-						spotlist.AddSpot(ui.SpotRecord{
-							Source:    "DX",
-							Frequency: spot.Frequency,
-							Station:   spot.DXStation,
-							Comment:   spot.Comment,
-							Time:      tm.Local(),
-							Location:  spot.Location,
-						})
-					}
-				}
-			}()
-		}
-		if cfg.POTASpot.Enabled {
-			pcfg := spotting.POTAConfig{
-				URL: cfg.POTASpot.URL,
+			// the first DX cluster is used for the console and posting spots
+			if dx, ok := src.(*spotting.DXClusterSource); ok && dxclient == nil {
+				dxclient = dx.Client
+				_, h := termbox.Size()
+				console = ui.NewClusterConsole(2, h-8, cfg.Theme)
+				dx.OnLine(console.AddLine)
 			}
-			pclient := spotting.NewPOTAClient(pcfg)
-			pclient.Run()
-			go func() {
-				for {
-					select {
-					case <-shutdown:
-						return
-					case spot := <-pclient.Spots:
-						freq, _ := strconv.ParseFloat(spot.Frequency, 64)
-						tm, _ := spot.Time()
-						location := spot.Reference + "/" + spot.ParkName
-						if spot.LocationDescription != "" {
-							location = fmt.Sprintf("%s/%s/%s", spot.Reference, spot.ParkName, spot.LocationDescription)
-						}
-						spotlist.AddSpot(ui.SpotRecord{
-							Source:    "POTA",
-							Frequency: freq,
-							Station:   spot.Activator,
-							Comment:   spot.Comments,
-							Time:      tm.Local(),
-							Location:  location,
-						})
-						_ = spot
-					}
-				}
-			}()
+			src.Run(spots)
+			sources = append(sources, src)
 		}
-
-		if cfg.SOTASpot.Enabled {
-			pcfg := spotting.SOTAConfig{
-				URL: cfg.SOTASpot.URL,
-			}
-			pclient := spotting.NewSOTAClient(pcfg)
-			pclient.Run()
-			go func() {
-				for {
-					select {
-					case <-shutdown:
-						return
-					case spot := <-pclient.Spots:
-						freq, _ := strconv.ParseFloat(spot.Frequency, 64)
-						tm, _ := spot.Time()
-						location := spot.SummitCode
-						if spot.SummitDetails != "" {
-							location = fmt.Sprintf("%s/%s", spot.SummitCode, spot.SummitDetails)
+		go func() {
+			for {
+				select {
+				case <-shutdown:
+					return
+				case spot := <-spots:
+					if !spotFilter.Allow(spot) {
+						continue
+					}
+					spotlist.AddSpot(ui.SpotRecord{
+						Source:    spot.Source,
+						Frequency: spot.Frequency,
+						Station:   spot.DXStation,
+						Comment:   spot.Comment,
+						Time:      spot.Time.Local(),
+						Location:  spot.Location,
+					})
+					bandmap.AddSpot(spot)
+					// alerts are shown by the UI, so drop them if it's behind
+					for _, alert := range alerter.Check(spot, time.Now()) {
+						select {
+						case alerts <- alert:
+						default:
 						}
-						spotlist.AddSpot(ui.SpotRecord{
-							Source:    "SOTA",
-							Frequency: freq * 1e3,
-							Station:   spot.ActivatorCallsign,
-							Comment:   spot.Comments,
-							Time:      tm.Local(),
-							Location:  location,
-						})
-						_ = spot
 					}
 				}
-			}()
-		}
+			}
+		}()
 	}
 
 	msgs := ui.NewMessages(yPos, msgHeight, cfg.Theme)
@@ -293,6 +309,32 @@ func newMainScreen(cfg *Config, alog *adif.Log, repo *git.Repository, bookmarks
 		d:          d,
 		shutdown:   shutdown,
 		toBeLogged: make(chan logRequest),
+		parks:      loadParks(cfg),
+		summits:    loadSummits(cfg),
+		dxclient:   dxclient,
+		sources:    sources,
+		console:    console,
+		bandmap:    bandmap,
+		spotFilter: spotFilter,
+		needed:     needed,
+		alerts:     alerts,
+		progress:   progress,
+	}
+	if filterErr != nil {
+		ms.logErrorf("error in spot filter config: %s", filterErr)
+	}
+	if alertErr != nil {
+		ms.logErrorf("error in spot alert config: %s", alertErr)
+	}
+	for _, err := range sourceErrs {
+		ms.logErrorf("error creating spot source: %s", err)
+	}
+	if strings.EqualFold(cfg.Operator.Sig, pota.SIG) {
+		for _, park := range pota.References(cfg.Operator.SigInfo) {
+			if name := ms.parks.Name(park); name != "" {
+				ms.logInfo("activating %s (%s)", park, name)
+			}
+		}
 	}
 
 	go ms.logRoutine()
@@ -353,6 +395,12 @@ func newMainScreen(cfg *Config, alog *adif.Log, repo *git.Repository, bookmarks
 	c.AddCommand(input.KeyCtrlX, ms.exportCabrillo)
 
 	c.AddCommand(input.KeyCtrlE, ms.executeCommands)
+	c.AddCommand(input.KeyCtrlW, ms.showAwards)
+	c.AddCommand(input.KeyCtrlK, ms.showGridMap)
+	c.AddCommand(input.KeyCtrlT, ms.showClusterConsole)
+	c.AddCommand(input.KeyCtrlP, ms.spotQSO)
+	c.AddCommand(input.KeyCtrlF, ms.showSpotFilters)
+	c.AddCommand(input.KeyCtrlA, ms.showBandMap)
 	c.AddCommand(input.KeyAltLeft, ms.tuneLeft)
 	c.AddCommand(input.KeyAltRight, ms.tuneRight)
 	return ms
@@ -369,27 +417,13 @@ func (m *mainScreen) logRoutine() {
 
 			// perform lookup on external submitted QSOs (e.g. from WSJT-X)
 			if rec.external {
-				rsp, err := m.lookup.Lookup(rec.record.Get(adif.Call))
+				rsp, err := m.lookup.Lookup(context.Background(), rec.record.Get(adif.Call))
 				if err == nil {
-					if rsp.Name != nil && rec.record.Get(adif.Name) == "" {
-						rec.record = append(rec.record, adif.Field{Name: adif.Name, Value: *rsp.Name})
-					}
-					if rsp.Grid != nil && rec.record.Get(adif.GridSquare) == "" {
-						rec.record = append(rec.record, adif.Field{Name: adif.GridSquare, Value: *rsp.Grid})
-					}
-					if rsp.Country != nil {
-						rec.record = append(rec.record, adif.Field{Name: adif.Country, Value: *rsp.Country})
-
-						ent, err := dxcc.LookupEntity(*rsp.Country)
-						if err == nil {
-							rec.record = append(rec.record,
-								adif.Field{
-									Name:  adif.DXCC,
-									Value: strconv.FormatInt(int64(ent.DXCC), 10),
-								})
-						}
-					}
+					rec.record = rsp.FillRecord(rec.record)
 				}
+				// anything the lookup didn't provide comes from the entity
+				// as of the QSO date
+				rec.record = dxcc.FillRecord(rec.record)
 			}
 
 			if m.cfg.Operator.Sig != "" {
@@ -397,6 +431,17 @@ func (m *mainScreen) logRoutine() {
 			}
 			if m.cfg.Operator.SigInfo != "" {
 				rec.record = append(rec.record, adif.Field{Name: adif.MySIGInfo, Value: m.cfg.Operator.SigInfo})
+				if strings.EqualFold(m.cfg.Operator.Sig, "SOTA") && rec.record.Get(adif.MySOTARef) == "" {
+					rec.record = append(rec.record, adif.Field{Name: adif.MySOTARef, Value: m.cfg.Operator.SigInfo})
+				}
+			}
+
+			// QSOs from the editor already have the distance from the more
+			// precise looked up location
+			if home, err := geo.ParseGrid(m.cfg.Operator.Grid); err == nil {
+				if dx, ok := geo.RecordLocation(rec.record); ok {
+					rec.record = geo.FillRecord(rec.record, home, dx)
+				}
 			}
 
 			// upload to LoTW?
@@ -407,12 +452,21 @@ func (m *mainScreen) logRoutine() {
 
 			m.alog.AddRecord(rec.record)
 			m.alog.Save()
+			m.needed.Add(rec.record)
+			if m.progress != nil {
+				m.progress.Add(rec.record)
+			}
 
 			// index the QSO so we can quickly identify if we've seen it before
 			r, err := db.AdifToRecord(rec.record)
 			if err == nil {
 				m.d.AddRecord(r)
 			}
+
+			// the station is now worked, and may no longer be needed
+			if m.bandmap != nil {
+				m.bandmap.UpdateStatus()
+			}
 		}
 	}
 }
@@ -492,7 +546,17 @@ func (m *mainScreen) exportCabrillo() {
 		return
 	}
 
-	cl.ClaimedScore, ok = ui.InputInteger(m.controller, "Claimed Score")
+	if strings.HasPrefix(strings.ToUpper(cl.Contest), "CQ-WPX") {
+		score := contest.NewWPX(m.cfg.Operator.Call)
+		for _, v := range m.alog.Records() {
+			score.Add(v)
+		}
+		cl.ClaimedScore = score.Score()
+		m.logInfo("WPX claimed score %d (%d QSOs, %d points, %d prefixes)", score.Score(),
+			score.QSOs(), score.Points(), score.Multipliers())
+	} else {
+		cl.ClaimedScore, ok = ui.InputInteger(m.controller, "Claimed Score")
+	}
 
 	cl.Operators = m.cfg.Operator.Call
 	for _, v := range m.alog.Records() {
@@ -707,10 +771,20 @@ func (m *mainScreen) focusQSOList() {
 func (m *mainScreen) saveQSO() {
 	if m.qso.IsValid() || ui.YesNoQuestion("Missing callsign or frequency, save anyway?") {
 		rec := m.qso.GetRecord()
+		m.validateSOTA(rec)
 		if m.editingQSO {
 			idx := m.qsoList.SelectedIndex()
 			m.alog.ReplaceRecord(idx, rec)
 			m.alog.Save()
+			// the edit may have changed what was worked
+			if m.progress != nil {
+				m.progress.Reset(m.alog)
+			}
+			m.needed.Reload(func() {
+				if m.bandmap != nil {
+					m.bandmap.UpdateStatus()
+				}
+			})
 		} else {
 			m.toBeLogged <- logRequest{record: rec.Copy()}
 
@@ -720,6 +794,25 @@ func (m *mainScreen) saveQSO() {
 	}
 }
 
+// validateSOTA warns about summit references that aren't in the summits list
+func (m *mainScreen) validateSOTA(rec adif.Record) {
+	if len(m.summits) == 0 {
+		return
+	}
+	date, err := rec.GetTimeOn()
+	if err != nil {
+		return
+	}
+	for _, ref := range []string{rec.Get(adif.SOTA_Reference), rec.Get(adif.MySOTARef)} {
+		if ref == "" {
+			continue
+		}
+		if err := m.summits.Validate(ref, date); err != nil {
+			m.logErrorf("invalid SOTA reference: %s", err)
+		}
+	}
+}
+
 func (m *mainScreen) showHelp() {
 	sb := strings.Builder{}
 	sb.WriteString("Ctrl+H - Show Help           Ctrl+Q - Quit\n")
@@ -738,6 +831,12 @@ func (m *mainScreen) showHelp() {
 	sb.WriteString("Misc\n")
 	sb.WriteString("Ctrl+E    - Display Custom Commands\n")
 	sb.WriteString("Ctrl+G    - Commit log file to git\n")
+	sb.WriteString("Ctrl+W    - Display Award Progress\n")
+	sb.WriteString("Ctrl+K    - Display VUCC Grid Map\n")
+	sb.WriteString("Ctrl+T    - Display DX Cluster Console\n")
+	sb.WriteString("Ctrl+P    - Spot Current QSO on the DX Cluster\n")
+	sb.WriteString("Ctrl+F    - Turn Spot Filters On/Off\n")
+	sb.WriteString("Ctrl+A    - Display Band Map\n")
 	sb.WriteString("Ctrl+R    - Force Screen Redraw\n")
 	sb.WriteString("ALt+Left  - Tune Down\n")
 	sb.WriteString("ALt+Right - Tune Up\n")
@@ -747,10 +846,198 @@ func (m *mainScreen) showHelp() {
 
 }
 
+func (m *mainScreen) showAwards() {
+	at, err := loadAwards(m.cfg, m.alog, m.parks, m.summits)
+	if err != nil {
+		m.logErrorf("error computing awards: %s", err)
+		return
+	}
+	ui.Splash("Awards", at.Summary()+"\nPress ESC to close")
+}
+
+func (m *mainScreen) showGridMap() {
+	if m.cfg.Operator.Grid == "" {
+		m.logErrorf("an operator grid must be configured to display the grid map")
+		return
+	}
+	at, err := loadAwards(m.cfg, m.alog, m.parks, m.summits)
+	if err != nil {
+		m.logErrorf("error computing awards: %s", err)
+		return
+	}
+	bands := at.vucc.Bands()
+	if len(bands) == 0 {
+		bands = []string{"6m", "2m", awards.VUCCSatellite}
+	}
+	band, ok := ui.InputChoice(m.controller, "Band", bands)
+	if !ok {
+		return
+	}
+	lines, err := at.vucc.Map(band, m.cfg.Operator.Grid, 5)
+	if err != nil {
+		m.logErrorf("error drawing grid map: %s", err)
+		return
+	}
+	lines = append(lines, "", "# confirmed  + worked  . needed", "Press ESC to close")
+	ui.Splash(fmt.Sprintf("VUCC %s", band), strings.Join(lines, "\n"))
+}
+
+func (m *mainScreen) showClusterConsole() {
+	if m.dxclient == nil {
+		m.logErrorf("the DX cluster must be enabled to use the console")
+		return
+	}
+	pc := ui.NewPanelController(m.cfg.Theme)
+	pc.AddWidget(m.console)
+	pc.Focus(m.console)
+	m.console.OnCommand(func(cmd string) {
+		if err := m.dxclient.Send(cmd); err != nil {
+			m.console.AddLine(fmt.Sprintf("error sending %s: %s", cmd, err))
+		}
+	})
+	for {
+		// redrawn for each key and on the refresh interval, so cluster output
+		// is displayed as it arrives
+		pc.Redraw()
+		termbox.Flush()
+		ev := input.ReadKeyEvent()
+		if ev == input.KeyEscape {
+			break
+		}
+		pc.HandleEvent(ev)
+	}
+	termbox.HideCursor()
+	// the main screen widgets only redraw their own areas
+	w, h := termbox.Size()
+	ui.Clear(0, 0, w, h, termbox.ColorDefault, termbox.ColorDefault)
+}
+
+// showBandMap displays the spots around the rig frequency by frequency.
+func (m *mainScreen) showBandMap() {
+	if m.bandmap == nil {
+		m.logErrorf("spotting must be enabled to use the band map")
+		return
+	}
+	pc := ui.NewPanelController(m.cfg.Theme)
+	pc.AddWidget(m.bandmap)
+	pc.Focus(m.bandmap)
+	for {
+		// redrawn on the refresh interval, so it follows the rig and new
+		// spots are displayed as they arrive
+		pc.Redraw()
+		termbox.Flush()
+		ev := input.ReadKeyEvent()
+		if ev == input.KeyEscape {
+			break
+		}
+		pc.HandleEvent(ev)
+	}
+	m.bandmap.Focus(false)
+	w, h := termbox.Size()
+	ui.Clear(0, 0, w, h, termbox.ColorDefault, termbox.ColorDefault)
+}
+
+// showSpotFilters lists the spot filter rules so they can be turned on and
+// off.
+func (m *mainScreen) showSpotFilters() {
+	sfl := ui.NewSpotFilterList(5, m.spotFilter, 15, m.cfg.Theme)
+	pc := ui.NewPanelController(m.cfg.Theme)
+	pc.AddWidget(sfl)
+	pc.Focus(sfl)
+lfor:
+	for {
+		pc.Redraw()
+		termbox.Flush()
+		ev := input.ReadKeyEvent()
+		switch ev {
+		case input.KeyEscape:
+			break lfor
+		case input.KeyEnter, input.Key(' '):
+			sfl.Toggle()
+		default:
+			pc.HandleEvent(ev)
+		}
+	}
+	w, h := termbox.Size()
+	ui.Clear(0, 0, w, h, termbox.ColorDefault, termbox.ColorDefault)
+}
+
+// spotQSO posts a spot of the station in the QSO editor to the DX cluster.
+func (m *mainScreen) spotQSO() {
+	if m.dxclient == nil {
+		m.logErrorf("the DX cluster must be enabled to post spots")
+		return
+	}
+	call := m.qso.Call()
+	freq := m.qso.FrequencyValue()
+	if call == "" || freq == 0 {
+		m.logErrorf("a call and frequency are required to post a spot")
+		return
+	}
+	comment, ok := ui.InputString(m.controller, fmt.Sprintf("Comment for spot of %s on %s", call, m.qso.Frequency()))
+	if !ok {
+		return
+	}
+	if err := m.dxclient.SendSpot(freq*1e3, call, comment); err != nil {
+		m.logErrorf("error posting spot: %s", err)
+		return
+	}
+	m.logInfo("spotted %s on %.1f", call, freq*1e3)
+}
+
+// potaProgress tracks the QSOs made from the operator's parks for the status
+// bar.
+type potaProgress struct {
+	mu    sync.Mutex
+	parks []string
+	act   *pota.Activator
+}
+
+// newPOTAProgress constructs a tracker from the QSOs already in the log.
+func newPOTAProgress(alog *adif.Log, parks []string) *potaProgress {
+	p := &potaProgress{parks: parks}
+	p.Reset(alog)
+	return p
+}
+
+// Add adds a newly logged QSO.
+func (p *potaProgress) Add(rec adif.Record) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.act.Add(rec)
+}
+
+// Reset recounts the QSOs in the log, e.g. after a QSO is edited.
+func (p *potaProgress) Reset(alog *adif.Log) {
+	act := pota.NewActivator()
+	for _, rec := range alog.Records() {
+		act.Add(rec)
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.act = act
+}
+
+// String returns the number of valid QSOs made today from each park.
+func (p *potaProgress) String() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	today := adif.NowUTCDate()
+	sb := strings.Builder{}
+	for _, park := range p.parks {
+		if sb.Len() > 0 {
+			sb.WriteByte(' ')
+		}
+		fmt.Fprintf(&sb, "%s %d/%d", park, p.act.Activation(park, today).QSOs(), pota.RequiredQSOs)
+	}
+	return sb.String()
+}
+
 func (m *mainScreen) Tick() bool {
 	m.controller.Redraw()
 
 	m.pollForLogs()
+	m.pollForAlerts()
 
 	if !m.controller.HandleEvent(input.ReadKeyEvent()) {
 		// shutting down the UI, so turn the console logger back on
@@ -768,6 +1055,9 @@ func (m *mainScreen) Tick() bool {
 		if m.js8log != nil {
 			m.js8log.Close()
 		}
+		for _, src := range m.sources {
+			src.Close()
+		}
 		if m.cfg.Operator.GitCommitOnExit {
 			m.commitLogWithMessage("auto-commit on exit")
 		}
@@ -777,6 +1067,40 @@ func (m *mainScreen) Tick() bool {
 	return true
 }
 
+// pollForAlerts shows any alerts raised by spots, ringing the terminal bell
+// and running the alert's command.
+func (m *mainScreen) pollForAlerts() {
+	for {
+		select {
+		case alert := <-m.alerts:
+			m.messages.AddAlert(alert.Message())
+			fmt.Fprint(os.Stdout, "\a")
+			if alert.Rule.Command != "" {
+				go m.runAlertCommand(alert)
+			}
+		default:
+			return
+		}
+	}
+}
+
+// runAlertCommand runs the command of an alert, with the details of the spot
+// in the environment.
+func (m *mainScreen) runAlertCommand(alert spotting.Alert) {
+	ec := exec.Command("bash", "-c", alert.Rule.Command)
+	ec.Env = os.Environ()
+	ec.Env = append(ec.Env, fmt.Sprintf("LOGFILE=%s", m.alog.Filename))
+	ec.Env = append(ec.Env, alert.Env()...)
+	op, err := ec.CombinedOutput()
+	if err != nil {
+		if len(op) > 0 {
+			m.logErrorf("error executing alert %s [%s]: %s", alert.Rule.Name, err, string(op))
+		} else {
+			m.logErrorf("error executing alert %s [%s]", alert.Rule.Name, err)
+		}
+	}
+}
+
 func (m *mainScreen) pollForLogs() {
 	if m.cfg.WSJTX.Enabled {
 		select {
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"log"
+	"sync"
+
+	"github.com/tzneal/ham-go/adif"
+	"github.com/tzneal/ham-go/awards"
+	"github.com/tzneal/ham-go/db"
+	"github.com/tzneal/ham-go/spotting"
+)
+
+// neededTracker determines if a spotted station is needed, using the DXCC
+// entities worked in the logs and the QSO index.
+type neededTracker struct {
+	mu   sync.Mutex
+	dxcc *awards.DXCC
+	d    *db.Database
+	dir  string
+
+	// QSOs logged while the logs are being reread
+	reloads int
+	added   []adif.Record
+}
+
+// newNeededTracker constructs a tracker from the current log, and reads the
+// rest of the logs in the log directory in the background.
+func newNeededTracker(c *Config, current *adif.Log, d *db.Database) *neededTracker {
+	n := &neededTracker{
+		dxcc: awards.NewDXCC(),
+		d:    d,
+		dir:  expandPath(c.Operator.Logdir),
+	}
+	skip := ""
+	if current != nil {
+		skip = current.Filename
+		for _, rec := range current.Records() {
+			if adif.IsValid(rec) {
+				n.dxcc.Add(rec)
+			}
+		}
+	}
+	go func() {
+		if err := walkLogs(n.dir, skip, n.Add); err != nil {
+			log.Printf("error reading logs for needed spots: %s", err)
+		}
+	}()
+	return n
+}
+
+// Add adds a newly logged QSO.
+func (n *neededTracker) Add(rec adif.Record) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.dxcc.Add(rec)
+	if n.reloads > 0 {
+		n.added = append(n.added, rec)
+	}
+}
+
+// Reload rereads all of the logs in the background, as QSOs can't be removed
+// from the tracker when they're edited.  done is called once it's finished.
+func (n *neededTracker) Reload(done func()) {
+	n.mu.Lock()
+	n.reloads++
+	n.mu.Unlock()
+	go func() {
+		dxcc := awards.NewDXCC()
+		if err := walkLogs(n.dir, "", dxcc.Add); err != nil {
+			log.Printf("error reading logs for needed spots: %s", err)
+		}
+		n.mu.Lock()
+		// the logs may have been read before the latest QSOs were saved
+		for _, rec := range n.added {
+			dxcc.Add(rec)
+		}
+		n.dxcc = dxcc
+		n.reloads--
+		if n.reloads == 0 {
+			n.added = nil
+		}
+		n.mu.Unlock()
+		done()
+	}()
+}
+
+// IsNeeded returns true if the spotted station is needed, it's a
+// spotting.NeededFunc.
+func (n *neededTracker) IsNeeded(spot spotting.Spot, kind string) bool {
+	if kind == spotting.NeededCall {
+		if n.d == nil {
+			return false
+		}
+		res, err := n.d.Search(spot.DXStation)
+		return err == nil && len(res) == 0
+	}
+
+	ent, ok := spot.DXEntity()
+	if !ok {
+		return false
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	switch kind {
+	case spotting.NeededBand:
+		return n.dxcc.IsNeeded(ent.DXCC, awards.BandMode{Band: spot.Band()})
+	case spotting.NeededMode:
+		// DXCC is awarded by mode category, and the spot's mode may only
+		// be the band plan's category
+		category := adif.ModeCategory(spot.InferredMode())
+		if category == "" {
+			return false
+		}
+		for _, bm := range n.dxcc.BandModes() {
+			if bm.Band == "" && adif.ModeCategory(bm.Mode) == category && !n.dxcc.IsNeeded(ent.DXCC, bm) {
+				return false
+			}
+		}
+		return true
+	}
+	return n.dxcc.IsNeeded(ent.DXCC, awards.BandMode{})
+}
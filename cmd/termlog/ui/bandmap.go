@@ -0,0 +1,397 @@
+package ui
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dh1tw/goHamlib"
+	termbox "github.com/nsf/termbox-go"
+
+	"github.com/tzneal/ham-go/adif"
+	"github.com/tzneal/ham-go/cmd/termlog/input"
+	"github.com/tzneal/ham-go/rig"
+	"github.com/tzneal/ham-go/spotting"
+)
+
+// SpotStatus is whether a spotted station has been worked before.
+type SpotStatus int
+
+// SpotStatus constants
+const (
+	SpotNew    SpotStatus = iota // the call hasn't been worked
+	SpotWorked                   // the call has been worked
+	SpotNeeded                   // the entity hasn't been worked on the band
+)
+
+// colors in the 256 color palette, which is offset by one
+const (
+	bandMapGrey     termbox.Attribute = 245
+	bandMapDarkGrey termbox.Attribute = 240
+)
+
+// bandMapSteps are the kHz per line the band map can be zoomed to.
+var bandMapSteps = []float64{0.1, 0.2, 0.5, 1, 2, 5, 10}
+
+// bandMapSpot is a spot and its status, which is determined when the spot is
+// added rather than on every redraw as it may search the logs.
+type bandMapSpot struct {
+	spotting.Spot
+	status SpotStatus
+}
+
+// BandMap shows the spots around the rig frequency laid out by frequency, with
+// the band plan segments alongside.
+type BandMap struct {
+	yPos       int
+	maxLines   int
+	theme      Theme
+	rig        *rig.RigCache
+	focused    bool
+	controller Controller
+	onTune     func(freq float64)
+	status     func(spot spotting.Spot) SpotStatus
+	expiration time.Duration
+	step       int     // index into bandMapSteps
+	offset     float64 // kHz the map is scrolled from the rig frequency
+	centre     float64 // kHz, the centre if there's no rig
+
+	// the selected spot
+	selCall string
+	selFreq float64
+
+	mu    sync.Mutex
+	spots []bandMapSpot
+}
+
+// NewBandMap constructs a new band map.  If rig is nil, it's centred on the
+// latest spot.
+func NewBandMap(yPos int, maxLines int, expiration time.Duration, rig *rig.RigCache, theme Theme) *BandMap {
+	return &BandMap{
+		yPos:       yPos,
+		maxLines:   maxLines,
+		theme:      theme,
+		rig:        rig,
+		expiration: expiration,
+		step:       3,
+	}
+}
+
+// AddSpot adds a spot, it's safe to call from any goroutine.  A station's
+// earlier spot on the same band is replaced.
+func (b *BandMap) AddSpot(spot spotting.Spot) {
+	added := bandMapSpot{Spot: spot, status: b.spotStatus(spot)}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.rig == nil {
+		b.centre = spot.Frequency
+	}
+
+	now := time.Now()
+	spots := b.spots[:0]
+	for _, s := range b.spots {
+		if now.Sub(s.Time) > b.expiration {
+			continue
+		}
+		if s.DXStation == spot.DXStation && s.Band() == spot.Band() {
+			if s.Time.After(spot.Time) {
+				added.Spot = s.Spot
+			}
+			continue
+		}
+		spots = append(spots, s)
+	}
+	b.spots = append(spots, added)
+	sort.Slice(b.spots, func(i, j int) bool {
+		return b.spots[i].Frequency < b.spots[j].Frequency
+	})
+}
+
+// OnTune sets the function called with the frequency in MHz of the spot
+// selected with Enter.
+func (b *BandMap) OnTune(fn func(freq float64)) {
+	b.onTune = fn
+}
+
+// OnStatus sets the function used to color spots by whether they've been
+// worked or are needed.
+func (b *BandMap) OnStatus(fn func(spot spotting.Spot) SpotStatus) {
+	b.status = fn
+}
+
+// spotStatus returns the status of a spot.
+func (b *BandMap) spotStatus(spot spotting.Spot) SpotStatus {
+	if b.status == nil {
+		return SpotNew
+	}
+	return b.status(spot)
+}
+
+// UpdateStatus determines the status of the spots again, e.g. after a QSO is
+// logged.  It's safe to call from any goroutine.
+func (b *BandMap) UpdateStatus() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for i := range b.spots {
+		b.spots[i].status = b.spotStatus(b.spots[i].Spot)
+	}
+}
+
+// tunedFreq returns the rig frequency in kHz, or the frequency of the latest
+// spot and false if there's no rig.
+func (b *BandMap) tunedFreq() (float64, bool) {
+	if b.rig != nil {
+		if freq, err := b.rig.GetFreq(goHamlib.VFOCurrent); err == nil && freq > 0 {
+			return freq / 1e3, true
+		}
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.centre, false
+}
+
+// rows returns the number of lines that show frequencies, the last two lines
+// describe the selected spot and the map.
+func (b *BandMap) rows() int {
+	return b.maxLines - 2
+}
+
+// window returns the frequency in kHz of the first line.
+func (b *BandMap) window() (start float64, step float64) {
+	step = bandMapSteps[b.step]
+	centre, _ := b.tunedFreq()
+	start = math.Floor((centre+clampOffset(centre, b.offset))/step)*step - float64(b.rows()/2)*step
+	return start, step
+}
+
+// clampOffset limits how far in kHz the map can be scrolled from a frequency,
+// so it can't be scrolled off the band the frequency is in.
+func clampOffset(freq float64, offset float64) float64 {
+	band, ok := adif.DetermineBand(freq / 1e3)
+	if !ok {
+		return offset
+	}
+	return math.Max(band.Min*1e3-freq, math.Min(band.Max*1e3-freq, offset))
+}
+
+// scroll scrolls the map by a number of kHz.
+func (b *BandMap) scroll(khz float64) {
+	centre, _ := b.tunedFreq()
+	b.offset = clampOffset(centre, clampOffset(centre, b.offset)+khz)
+}
+
+// visible returns the unexpired spots between two frequencies in kHz in order
+// of frequency.
+func (b *BandMap) visible(lo, hi float64) []bandMapSpot {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := time.Now()
+	var ret []bandMapSpot
+	for _, s := range b.spots {
+		if s.Frequency >= lo && s.Frequency < hi && now.Sub(s.Time) <= b.expiration {
+			ret = append(ret, s)
+		}
+	}
+	return ret
+}
+
+func (b *BandMap) isSelected(s spotting.Spot) bool {
+	return s.DXStation == b.selCall && s.Frequency == b.selFreq
+}
+
+// spotColor colors a spot by its status, with the newest spots in bold and the
+// oldest dimmed.
+func (b *BandMap) spotColor(s bandMapSpot, now time.Time) termbox.Attribute {
+	fg := termbox.ColorWhite
+	switch s.status {
+	case SpotNeeded:
+		fg = termbox.ColorGreen
+	case SpotWorked:
+		fg = bandMapGrey
+	}
+	age := now.Sub(s.Time)
+	switch {
+	case age < b.expiration/3:
+		fg |= termbox.AttrBold
+	case age > b.expiration*2/3 && s.status != SpotNeeded:
+		fg = bandMapDarkGrey
+	}
+	return fg
+}
+
+// segmentColor returns the color of the band plan marker for a frequency in
+// kHz.
+func segmentColor(freq float64) (termbox.Attribute, string) {
+	for _, s := range spotting.BandPlan {
+		if freq >= s.Start && freq < s.End {
+			switch s.Mode {
+			case "CW":
+				return termbox.ColorBlue, s.Mode
+			case "DATA":
+				return termbox.ColorMagenta, s.Mode
+			}
+			return termbox.ColorGreen, s.Mode
+		}
+	}
+	return termbox.ColorDefault, ""
+}
+
+func (b *BandMap) Redraw() {
+	w, _ := termbox.Size()
+	now := time.Now()
+	start, step := b.window()
+	rigFreq, tuned := b.tunedFreq()
+	spots := b.visible(start, start+float64(b.rows())*step)
+
+	for line := 0; line < b.rows(); line++ {
+		curLine := b.yPos + line
+		lo := start + float64(line)*step
+		hi := lo + step
+		Clear(0, curLine, w-1, curLine, termbox.ColorDefault, termbox.ColorDefault)
+
+		// the band plan marker, labelled where the segment starts
+		segColor, segMode := segmentColor(lo)
+		if segMode != "" {
+			Clear(0, curLine, 0, curLine, termbox.ColorDefault, segColor)
+			if prevColor, prevMode := segmentColor(lo - step); prevMode != segMode || prevColor != segColor || line == 0 {
+				DrawText(1, curLine, segMode, segColor, termbox.ColorDefault)
+			}
+		}
+
+		fg := termbox.ColorWhite
+		freqText := fmt.Sprintf("%9.1f", lo)
+		if tuned && rigFreq >= lo && rigFreq < hi {
+			fg = termbox.ColorBlack
+			DrawText(6, curLine, freqText+" >", fg, termbox.ColorYellow)
+		} else {
+			DrawText(6, curLine, freqText, fg, termbox.ColorDefault)
+		}
+
+		xPos := 19
+		for _, s := range spots {
+			if s.Frequency < lo || s.Frequency >= hi {
+				continue
+			}
+			text := fmt.Sprintf("%s %s", s.DXStation, humanizeAge(now.Sub(s.Time)))
+			if xPos+len(text) >= w {
+				DrawText(w-1, curLine, "+", termbox.ColorWhite, termbox.ColorDefault)
+				break
+			}
+			sfg, sbg := b.spotColor(s, now), termbox.ColorDefault
+			if b.focused && b.isSelected(s.Spot) {
+				sfg, sbg = termbox.ColorBlack, termbox.ColorWhite
+			}
+			DrawText(xPos, curLine, text, sfg, sbg)
+			xPos += len(text) + 2
+		}
+	}
+
+	info := b.yPos + b.rows()
+	Clear(0, info, w-1, info, termbox.ColorDefault, termbox.ColorDefault)
+	if sel := b.selectedSpot(spots); b.focused && sel != nil {
+		text := fmt.Sprintf("%s %.1f %s %s %s", sel.DXStation, sel.Frequency, sel.Source, sel.Comment, sel.Location)
+		DrawText(0, info, trunc(strings.TrimSpace(text), w-1), termbox.ColorWhite, termbox.ColorDefault)
+	}
+
+	header := info + 1
+	Clear(0, header, w-1, header, b.theme.QSOListHeaderFG, b.theme.QSOListHeaderBG)
+	band, _ := adif.DetermineBand(rigFreq / 1e3)
+	label := fmt.Sprintf("Band Map %s  %.1f kHz  %g kHz/line  %d spots", band.Name, rigFreq, step, len(spots))
+	if b.focused {
+		label += "  (Enter to tune, +/- to zoom, PgUp/PgDn to scroll, ESC to close)"
+	}
+	DrawText(0, header, label, b.theme.QSOListHeaderFG, b.theme.QSOListHeaderBG)
+}
+
+// humanizeAge formats how old a spot is in minutes.
+func humanizeAge(age time.Duration) string {
+	if age < time.Minute {
+		return "<1m"
+	}
+	return fmt.Sprintf("%dm", int(age.Minutes()))
+}
+
+// selectedSpot returns the selected spot, or nil if it's no longer visible.
+func (b *BandMap) selectedSpot(spots []bandMapSpot) *spotting.Spot {
+	for i := range spots {
+		if b.isSelected(spots[i].Spot) {
+			return &spots[i].Spot
+		}
+	}
+	return nil
+}
+
+func (b *BandMap) SetController(c Controller) {
+	b.controller = c
+}
+
+func (b *BandMap) Focus(f bool) {
+	b.focused = f
+	if f {
+		termbox.HideCursor()
+	}
+}
+
+// moveSelection selects the next spot up or down the band.
+func (b *BandMap) moveSelection(dir int) {
+	start, step := b.window()
+	spots := b.visible(start, start+float64(b.rows())*step)
+	if len(spots) == 0 {
+		return
+	}
+	idx := -1
+	for i, s := range spots {
+		if b.isSelected(s.Spot) {
+			idx = i
+		}
+	}
+	switch {
+	case idx == -1 && dir > 0:
+		idx = 0
+	case idx == -1:
+		idx = len(spots) - 1
+	default:
+		idx += dir
+	}
+	if idx < 0 || idx >= len(spots) {
+		return
+	}
+	b.selCall = spots[idx].DXStation
+	b.selFreq = spots[idx].Frequency
+}
+
+func (b *BandMap) HandleEvent(key input.Key) {
+	switch key {
+	case input.KeyTab:
+		b.controller.FocusNext()
+	case input.KeyShiftTab:
+		b.controller.FocusPrevious()
+	case input.KeyArrowUp:
+		b.moveSelection(-1)
+	case input.KeyArrowDown:
+		b.moveSelection(1)
+	case input.KeyPageUp:
+		b.scroll(-float64(b.rows()/2) * bandMapSteps[b.step])
+	case input.KeyPageDown:
+		b.scroll(float64(b.rows()/2) * bandMapSteps[b.step])
+	case input.Key('+'), input.Key('='):
+		if b.step > 0 {
+			b.step--
+		}
+	case input.Key('-'):
+		if b.step < len(bandMapSteps)-1 {
+			b.step++
+		}
+	case input.KeyEnter:
+		start, step := b.window()
+		sel := b.selectedSpot(b.visible(start, start+float64(b.rows())*step))
+		if sel != nil && b.onTune != nil {
+			b.onTune(sel.Frequency / 1e3)
+			// follow the rig to the spot
+			b.offset = 0
+		}
+	}
+}
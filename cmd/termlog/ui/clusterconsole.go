@@ -0,0 +1,129 @@
+package ui
+
+import (
+	"strings"
+	"sync"
+
+	termbox "github.com/nsf/termbox-go"
+	"github.com/tzneal/ham-go/cmd/termlog/input"
+)
+
+// ClusterConsole displays the output of a DX cluster session, and has a
+// command line for sending commands (sh/dx, set/filter, etc.) to it.
+type ClusterConsole struct {
+	yPos       int
+	maxLines   int
+	maxEntries int // maximum number of lines to keep
+	theme      Theme
+	offset     int // lines scrolled back from the latest
+	controller Controller
+	input      *TextEdit
+	onCommand  func(cmd string)
+
+	mu    sync.Mutex
+	lines []string
+}
+
+// NewClusterConsole constructs a new cluster console.
+func NewClusterConsole(yPos int, maxLines int, theme Theme) *ClusterConsole {
+	return &ClusterConsole{
+		yPos:       yPos,
+		maxLines:   maxLines,
+		maxEntries: 500,
+		theme:      theme,
+		input:      NewTextEdit(3, yPos+maxLines+2),
+	}
+}
+
+// AddLine adds a line of cluster output, it's safe to call from any goroutine.
+func (c *ClusterConsole) AddLine(line string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lines = append(c.lines, line)
+	if len(c.lines) > c.maxEntries {
+		c.lines = c.lines[len(c.lines)-c.maxEntries:]
+	}
+}
+
+// OnCommand sets the function called with a command when it's entered.
+func (c *ClusterConsole) OnCommand(fn func(cmd string)) {
+	c.onCommand = fn
+}
+
+func (c *ClusterConsole) SetController(cn Controller) {
+	c.controller = cn
+	c.input.SetController(cn)
+}
+
+func (c *ClusterConsole) Redraw() {
+	w, _ := termbox.Size()
+	c.input.SetWidth(w - 4)
+	fg, bg := c.theme.QSOListHeaderFG, c.theme.QSOListHeaderBG
+
+	// outline with the command line at the bottom
+	bottom := c.yPos + c.maxLines + 3
+	Clear(0, c.yPos, w-1, c.yPos, fg, bg)
+	Clear(0, c.yPos, 0, bottom, fg, bg)
+	Clear(w-1, c.yPos, w-1, bottom, fg, bg)
+	Clear(0, c.yPos+c.maxLines+1, w-1, c.yPos+c.maxLines+1, fg, bg)
+	Clear(0, bottom, w-1, bottom, fg, bg)
+	DrawText(1, c.yPos, "DX Cluster (Enter to send, Up/Down to scroll, ESC to close)", fg, bg)
+
+	c.mu.Lock()
+	end := len(c.lines) - c.offset
+	if end < 0 {
+		end = 0
+	}
+	start := end - c.maxLines
+	if start < 0 {
+		start = 0
+	}
+	visible := c.lines[start:end]
+	c.mu.Unlock()
+
+	for line := 0; line < c.maxLines; line++ {
+		curLine := c.yPos + line + 1
+		Clear(1, curLine, w-2, curLine, termbox.ColorDefault, termbox.ColorDefault)
+		if line < len(visible) {
+			text := strings.Replace(visible[line], "\t", " ", -1)
+			if len(text) > w-3 {
+				text = text[0 : w-3]
+			}
+			DrawText(1, curLine, text, termbox.ColorWhite, termbox.ColorDefault)
+		}
+	}
+	Clear(1, c.yPos+c.maxLines+2, 2, c.yPos+c.maxLines+2, termbox.ColorDefault, termbox.ColorDefault)
+	DrawText(1, c.yPos+c.maxLines+2, ">", termbox.ColorWhite, termbox.ColorDefault)
+	c.input.Redraw()
+}
+
+func (c *ClusterConsole) Focus(b bool) {
+	c.input.Focus(b)
+}
+
+func (c *ClusterConsole) HandleEvent(key input.Key) {
+	switch key {
+	case input.KeyEnter:
+		cmd := strings.TrimSpace(c.input.Value())
+		c.input.SetValue("")
+		c.offset = 0
+		if cmd != "" && c.onCommand != nil {
+			c.AddLine("> " + cmd)
+			c.onCommand(cmd)
+		}
+	case input.KeyArrowUp:
+		c.mu.Lock()
+		if c.offset+c.maxLines < len(c.lines) {
+			c.offset++
+		}
+		c.mu.Unlock()
+	case input.KeyArrowDown:
+		if c.offset > 0 {
+			c.offset--
+		}
+	case input.KeyTab, input.KeyShiftTab:
+		// there's nothing else to focus
+	default:
+		c.input.HandleEvent(key)
+	}
+}
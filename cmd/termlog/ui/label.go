@@ -29,6 +29,10 @@ func (l *Label) Redraw() {
 }
 func (l *Label) SetText(text string) {
 	l.text = text
+	// grow so that a longer text is cleared when it's replaced
+	if len(text) > l.width {
+		l.width = len(text)
+	}
 }
 
 func (l *Label) GetText() string {
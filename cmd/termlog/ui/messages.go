@@ -25,6 +25,7 @@ type msgType byte
 const (
 	infoMsgType msgType = iota
 	errorMsgType
+	alertMsgType
 )
 
 type msg struct {
@@ -50,6 +51,12 @@ func (m *Messages) AddError(text string) {
 func (m *Messages) AddMessage(text string) {
 	m.messages = append(m.messages, msg{time.Now(), text, infoMsgType})
 }
+
+// AddAlert adds a message that's highlighted to draw attention to it.
+func (m *Messages) AddAlert(text string) {
+	m.messages = append(m.messages, msg{time.Now(), text, alertMsgType})
+}
+
 func (m *Messages) SetController(cn Controller) {
 	m.controller = cn
 }
@@ -72,6 +79,9 @@ func (m *Messages) Redraw() {
 			case infoMsgType:
 			case errorMsgType:
 				fg = termbox.ColorRed
+			case alertMsgType:
+				fg = termbox.ColorBlack
+				bg = termbox.ColorYellow
 			}
 			if m.focused && m.selected == pos {
 				fg = termbox.ColorBlack
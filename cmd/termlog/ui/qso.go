@@ -1,17 +1,23 @@
 package ui
 
 import (
+	"context"
+	"fmt"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/tzneal/ham-go/cmd/termlog/input"
 	"github.com/tzneal/ham-go/rig"
 
 	"github.com/dh1tw/goHamlib"
-	"github.com/pd0mz/go-maidenhead"
+	termbox "github.com/nsf/termbox-go"
 
 	"github.com/tzneal/ham-go/adif"
 	"github.com/tzneal/ham-go/callsigns"
 	"github.com/tzneal/ham-go/dxcc"
+	"github.com/tzneal/ham-go/geo"
 )
 
 // QSO is the qso editor
@@ -39,7 +45,21 @@ type QSO struct {
 	notes            *TextEdit
 	grid             *TextEdit
 	entity           *ComboBox
-	operatorLocation *maidenhead.Point
+	operatorLocation *geo.Point
+
+	// filled in from the callsign lookup, but not displayed
+	hidden         adif.Record
+	lookupLocation *geo.Point
+
+	lookupInfo *Label // where the looked up fields came from
+	dxInfo     *Label // details of the DX station's location
+	sunInfo    *Label // sunrise and sunset at both ends
+
+	// lookups run in the background, the latest result is applied on redraw
+	lookupMu      sync.Mutex
+	lookupGen     int // incremented for each lookup, so stale results are discarded
+	lookupCancel  context.CancelFunc
+	lookupPending *lookupResult
 
 	rig    *rig.RigCache
 	custom []CustomField
@@ -183,6 +203,13 @@ func NewQSO(yPos int, theme Theme, lookup callsigns.Lookup, customFields []Custo
 		x += f.Width + 1
 	}
 
+	lookupInfo := NewLabel(0, yPos+6, "")
+	pc.AddWidget(lookupInfo)
+	dxInfo := NewLabel(0, yPos+7, "")
+	pc.AddWidget(dxInfo)
+	sunInfo := NewLabel(0, yPos+8, "")
+	pc.AddWidget(sunInfo)
+
 	qso := &QSO{
 		yPos:      yPos,
 		panel:     pc,
@@ -205,6 +232,10 @@ func NewQSO(yPos int, theme Theme, lookup callsigns.Lookup, customFields []Custo
 		time:      time,
 		notes:     notes,
 		custom:    customFields,
+
+		lookupInfo: lookupInfo,
+		dxInfo:     dxInfo,
+		sunInfo:    sunInfo,
 	}
 
 	if freq != nil {
@@ -220,15 +251,81 @@ func (q *QSO) HasRig() bool {
 }
 
 func (q *QSO) Height() int {
-	return 7
+	return 9
 }
 
+type lookupResult struct {
+	call string
+	rsp  *callsigns.Response
+	err  error
+}
+
+// lookupTimeout is the longest a lookup can take
+const lookupTimeout = 15 * time.Second
+
+// lookupCallsign starts a background lookup of the current call sign,
+// cancelling any lookup that is already running.
 func (q *QSO) lookupCallsign() {
-	if len(q.Call()) < 2 {
+	call := q.Call()
+	if len(call) < 2 {
+		return
+	}
+
+	q.lookupMu.Lock()
+	q.cancelLookupLocked()
+	ctx, cancel := context.WithTimeout(context.Background(), lookupTimeout)
+	q.lookupCancel = cancel
+	gen := q.lookupGen
+	q.lookupMu.Unlock()
+
+	q.lookupInfo.SetText("looking up " + call + "…")
+	go func() {
+		rsp, err := q.lookup.Lookup(ctx, call)
+		cancel()
+		q.lookupMu.Lock()
+		if gen == q.lookupGen {
+			q.lookupPending = &lookupResult{call: call, rsp: rsp, err: err}
+			q.lookupCancel = nil
+		}
+		q.lookupMu.Unlock()
+		// redraw to display the result
+		termbox.Interrupt()
+	}()
+}
+
+// cancelLookup cancels any running lookup and discards its result.
+func (q *QSO) cancelLookup() {
+	q.lookupMu.Lock()
+	q.cancelLookupLocked()
+	q.lookupMu.Unlock()
+}
+
+func (q *QSO) cancelLookupLocked() {
+	if q.lookupCancel != nil {
+		q.lookupCancel()
+		q.lookupCancel = nil
+	}
+	q.lookupGen++
+	q.lookupPending = nil
+}
+
+// applyPendingLookup applies the result of a completed background lookup.  It
+// is called from Redraw so that the editor is only modified by the UI.
+func (q *QSO) applyPendingLookup() {
+	q.lookupMu.Lock()
+	res := q.lookupPending
+	q.lookupPending = nil
+	q.lookupMu.Unlock()
+	if res == nil {
+		return
+	}
+	// the call was edited while the lookup was running
+	if res.call != q.Call() {
+		q.lookupInfo.SetText("")
 		return
 	}
 
-	rsp, err := q.lookup.Lookup(q.Call())
+	rsp, err := res.rsp, res.err
 	if err == nil {
 		if rsp.Name != nil && q.Name() == "" {
 			q.name.SetValue(*rsp.Name)
@@ -236,12 +333,46 @@ func (q *QSO) lookupCallsign() {
 		if rsp.Grid != nil && q.Grid() == "" {
 			q.grid.SetValue(*rsp.Grid)
 		}
-		if rsp.Country != nil {
+		// providers don't all use the same country names, so prefer the DXCC
+		// entity code
+		selected := false
+		if rsp.DXCC != nil {
+			if ent, err := dxcc.LookupEntityCode(int64(*rsp.DXCC)); err == nil {
+				q.entity.SetSelected(ent.Entity)
+				selected = true
+			}
+		}
+		if !selected && rsp.Country != nil {
 			q.entity.SetSelected(*rsp.Country)
 		}
+		// not user editable, so always reflect the latest lookup
+		q.hidden = hiddenFields(rsp.FillRecord(adif.Record{}))
+		q.lookupLocation = nil
+		if rsp.Latitude != nil && rsp.Longitude != nil {
+			pt := geo.NewPoint(*rsp.Latitude, *rsp.Longitude)
+			q.lookupLocation = &pt
+		}
+		q.lookupInfo.SetText(lookupSources(rsp))
+	} else {
+		q.lookupInfo.SetText("")
 	}
 }
 
+// lookupSources describes which lookup provider supplied the fields that are
+// displayed in the editor.
+func lookupSources(rsp *callsigns.Response) string {
+	sources := []string{}
+	for _, f := range []string{"Name", "Grid", "Country", "County"} {
+		if src := rsp.Source(f); src != "" {
+			sources = append(sources, fmt.Sprintf("%s: %s", strings.ToLower(f), src))
+		}
+	}
+	if len(sources) == 0 {
+		return ""
+	}
+	return "Lookup " + strings.Join(sources, ", ")
+}
+
 func (q *QSO) syncBandWithFreqText(t string) {
 	freq, err := strconv.ParseFloat(t, 64)
 	if err == nil {
@@ -284,6 +415,10 @@ func (q *QSO) SetDefaults() {
 	q.srx.SetValue("")
 	q.stx.SetValue("")
 	q.entity.SetSelected("")
+	q.hidden = nil
+	q.lookupLocation = nil
+	q.cancelLookup()
+	q.lookupInfo.SetText("")
 	q.notes.SetValue("")
 	for _, f := range q.custom {
 		f.editor.SetValue(f.Default)
@@ -293,6 +428,8 @@ func (q *QSO) SetDefaults() {
 }
 
 func (q *QSO) Redraw() {
+	q.applyPendingLookup()
+	q.updateDXInfo()
 	if q.rig != nil {
 		freq, err := q.rig.GetFreq(goHamlib.VFOCurrent)
 		freq /= 1e6
@@ -309,6 +446,90 @@ func (q *QSO) Redraw() {
 	q.panel.Redraw()
 }
 
+// dxEntity returns the entity of the DX station.  The call sign's own entry is
+// used if it's in the selected entity, as it may have a more precise location
+// (e.g. an island) than the entity as a whole.
+func (q *QSO) dxEntity() (dxcc.Entity, bool) {
+	selected := q.entity.Value()
+	if selected == "" {
+		return dxcc.Entity{}, false
+	}
+	if ent, ok := dxcc.Lookup(q.Call()); ok && ent.Entity == selected {
+		return ent, true
+	}
+	ent, err := dxcc.LookupEntity(selected)
+	return ent, err == nil
+}
+
+// stationLocation returns the location of the DX station from the lookup or
+// its grid, if either is known.
+func (q *QSO) stationLocation() (geo.Point, bool) {
+	bounds, err := geo.GridBounds(q.grid.Value())
+	// the looked up location is more precise than the grid, unless the grid
+	// has been changed to somewhere else
+	if q.lookupLocation != nil && (err != nil || bounds.Contains(*q.lookupLocation)) {
+		return *q.lookupLocation, true
+	}
+	if err == nil {
+		return bounds.Center(), true
+	}
+	return geo.Point{}, false
+}
+
+// dxLocation returns the location of the DX station, or otherwise the center
+// of its entity.
+func (q *QSO) dxLocation() (geo.Point, bool) {
+	if pt, ok := q.stationLocation(); ok {
+		return pt, true
+	}
+	if ent, ok := q.dxEntity(); ok {
+		return geo.NewPoint(ent.Latitude, ent.Longitude), true
+	}
+	return geo.Point{}, false
+}
+
+// updateDXInfo shows the local time at the DX station and the beam heading and
+// distance to it.
+func (q *QSO) updateDXInfo() {
+	var info []string
+	if ent, ok := q.dxEntity(); ok {
+		local := ent.LocalTime(time.Now())
+		info = append(info, fmt.Sprintf("Local time %s (%s)", local.Format("15:04"), dxcc.FormatUTCOffset(ent.UTCOffset)))
+	}
+	if pt, ok := q.dxLocation(); ok && q.operatorLocation != nil {
+		op := *q.operatorLocation
+		info = append(info, fmt.Sprintf("Bearing %.0f° (LP %.0f°)  Distance %.0f km",
+			op.Bearing(pt), op.LongPathBearing(pt), op.Distance(pt)))
+	}
+	q.dxInfo.SetText(strings.Join(info, "  "))
+
+	now := time.Now()
+	var sun []string
+	if pt, ok := q.dxLocation(); ok {
+		sun = append(sun, "DX "+describeSun(pt, now))
+	}
+	if q.operatorLocation != nil {
+		sun = append(sun, "Home "+describeSun(*q.operatorLocation, now))
+	}
+	q.sunInfo.SetText(strings.Join(sun, "  "))
+}
+
+// describeSun describes the UTC sunrise and sunset times and whether it's
+// currently day, night or greyline at a location.
+func describeSun(pt geo.Point, now time.Time) string {
+	st := pt.SunTimes(now)
+	var times string
+	switch {
+	case st.AlwaysUp:
+		times = "sun always up"
+	case st.AlwaysDown:
+		times = "sun always down"
+	default:
+		times = fmt.Sprintf("rise %s set %s", st.Sunrise.Format("15:04"), st.Sunset.Format("15:04"))
+	}
+	return fmt.Sprintf("%s (%s)", times, pt.Daylight(now))
+}
+
 func (q *QSO) SetController(c Controller) {
 	q.controller = c
 }
@@ -458,6 +679,18 @@ func (q *QSO) GetRecord() adif.Record {
 			})
 	}
 
+	// the location fields only apply if the entity wasn't changed from the
+	// looked up one
+	sameEntity := q.hidden.Get(adif.DXCC) == record.Get(adif.DXCC)
+	for _, f := range q.hidden {
+		if entityFields[f.Name] && !sameEntity {
+			continue
+		}
+		if record.Get(f.Name) == "" {
+			record = append(record, f)
+		}
+	}
+
 	record = append(record,
 		adif.Field{
 			Name:  adif.Notes,
@@ -473,17 +706,10 @@ func (q *QSO) GetRecord() adif.Record {
 			})
 	}
 
-	// add a distance value computed from the grid locations
-	if q.grid.Value() != "" && q.operatorLocation != nil {
-		otherLoc, err := maidenhead.ParseLocator(q.grid.Value())
-		if err == nil {
-			distance := q.operatorLocation.Distance(otherLoc)
-			record = append(record,
-				adif.Field{
-					Name:  adif.Distance,
-					Value: strconv.FormatFloat(distance, 'f', 1, 64),
-				})
-		}
+	// add the distance and beam heading to the station, the entity's
+	// location is too imprecise to log
+	if otherLoc, ok := q.stationLocation(); ok && q.operatorLocation != nil {
+		record = geo.FillRecord(record, *q.operatorLocation, otherLoc)
 	}
 
 	return record
@@ -504,6 +730,8 @@ func (q *QSO) SetRecord(r adif.Record) {
 	q.srx.SetValue(r.Get(adif.SRXString))
 	q.stx.SetValue(r.Get(adif.STXString))
 	q.grid.SetValue(r.Get(adif.GridSquare))
+	q.hidden = hiddenFields(r)
+	q.lookupLocation = nil
 	ent, err := dxcc.LookupEntityCode(r.GetInt(adif.DXCC))
 	if err == nil {
 		q.entity.SetSelected(ent.Entity)
@@ -516,9 +744,34 @@ func (q *QSO) SetRecord(r adif.Record) {
 	}
 }
 
+// entityFields are the hidden fields that are determined by the DXCC entity
+var entityFields = map[adif.Identifier]bool{
+	adif.DXCC:      true,
+	adif.Country:   true,
+	adif.Continent: true,
+	adif.CQZone:    true,
+	adif.ITUZone:   true,
+}
+
+// hiddenFields returns the fields of a record that are kept with the QSO but
+// aren't displayed in the editor.
+func hiddenFields(r adif.Record) adif.Record {
+	hidden := adif.Record{}
+	for _, f := range r {
+		switch f.Name {
+		case adif.DXCC, adif.Country, adif.Continent, adif.CQZone, adif.ITUZone,
+			adif.QTH, adif.State, adif.County, adif.IOTA, adif.QSLVia, adif.Email:
+			if f.Value != "" {
+				hidden = append(hidden, f)
+			}
+		}
+	}
+	return hidden
+}
+
 func (q *QSO) SetOperatorGrid(grid string) {
 	if len(grid) > 0 {
-		pt, err := maidenhead.ParseLocator(grid)
+		pt, err := geo.ParseGrid(grid)
 		if err == nil {
 			q.operatorLocation = &pt
 		}
@@ -8,8 +8,8 @@ import (
 	"github.com/tzneal/ham-go/cmd/termlog/input"
 
 	termbox "github.com/nsf/termbox-go"
-	"github.com/pd0mz/go-maidenhead"
 	"github.com/tzneal/ham-go/adif"
+	"github.com/tzneal/ham-go/geo"
 )
 
 type QSOList struct {
@@ -22,7 +22,7 @@ type QSOList struct {
 	maxLines   int
 	theme      Theme
 
-	operatorLocation *maidenhead.Point
+	operatorLocation *geo.Point
 	onSelect         func(r adif.Record)
 }
 
@@ -164,7 +164,7 @@ func (q *QSOList) Redraw() {
 				fieldValue := rec.Get(f.field)
 				if f.field == adif.Distance && fieldValue == "" &&
 					q.operatorLocation != nil {
-					otherLoc, err := maidenhead.ParseLocator(rec.Get(adif.GridSquare))
+					otherLoc, err := geo.ParseGrid(rec.Get(adif.GridSquare))
 					if err == nil {
 						distance := q.operatorLocation.Distance(otherLoc)
 						fieldValue = strconv.FormatFloat(distance, 'f', 1, 64)
@@ -274,7 +274,7 @@ func (q *QSOList) OnSelect(fn func(r adif.Record)) {
 
 func (q *QSOList) SetOperatorGrid(grid string) {
 	if len(grid) > 0 {
-		pt, err := maidenhead.ParseLocator(grid)
+		pt, err := geo.ParseGrid(grid)
 		if err == nil {
 			q.operatorLocation = &pt
 		}
@@ -0,0 +1,69 @@
+package ui
+
+import (
+	"fmt"
+
+	termbox "github.com/nsf/termbox-go"
+	"github.com/tzneal/ham-go/spotting"
+)
+
+// SpotFilterList lists the spot filter rules so they can be turned on and
+// off.  The first item turns the whole filter on and off.
+type SpotFilterList struct {
+	filter *spotting.Filter
+	List
+}
+
+func NewSpotFilterList(yPos int, filter *spotting.Filter, maxLines int, theme Theme) *SpotFilterList {
+	s := &SpotFilterList{}
+	s.theme = theme
+	s.maxLines = maxLines
+	s.yPos = yPos
+	s.xPos = 20
+	s.width = 50
+	s.src = s
+	s.filter = filter
+	s.drawOutline = true
+	s.reverse = true
+	s.title = "Spot Filters (Enter to toggle, ESC to close)"
+	return s
+}
+
+func (s *SpotFilterList) Length() int {
+	return len(s.filter.Rules()) + 1
+}
+
+// Toggle turns the selected rule, or the filter, on or off.
+func (s *SpotFilterList) Toggle() {
+	idx := s.Selected()
+	if idx == 0 {
+		s.filter.SetEnabled(!s.filter.Enabled())
+		return
+	}
+	rules := s.filter.Rules()
+	if idx-1 < len(rules) {
+		s.filter.SetRuleEnabled(idx-1, rules[idx-1].Disabled)
+	}
+}
+
+func (s *SpotFilterList) DrawItem(idx, yPos int, fg, bg termbox.Attribute) {
+	Clear(s.xPos, yPos, s.xPos+s.width, yPos, fg, bg)
+	check := func(b bool) string {
+		if b {
+			return "[x]"
+		}
+		return "[ ]"
+	}
+
+	var text string
+	if idx == 0 {
+		text = fmt.Sprintf("%s Filter spots", check(s.filter.Enabled()))
+	} else {
+		rule := s.filter.Rules()[idx-1]
+		text = fmt.Sprintf("    %s %s (%s)", check(!rule.Disabled), rule.Name, rule.Action)
+	}
+	if len(text) > s.width {
+		text = text[0:s.width]
+	}
+	DrawText(s.xPos, yPos, text, fg, bg)
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/tzneal/ham-go/uls"
+)
+
+// ImportULS builds the offline US license database used by the uls lookup
+// from the FCC ULS data files in dir.
+func ImportULS(c *Config, dir string) error {
+	path := uls.DefaultFile
+	if lc, ok := c.Lookup["uls"]; ok && lc["Path"] != "" {
+		path = lc["Path"]
+	}
+	path = expandPath(path)
+
+	fmt.Printf("importing licenses from %s into %s\n", dir, path)
+	n, err := uls.Import(dir, path)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("imported %d active licenses\n", n)
+	if _, ok := c.Lookup["uls"]; !ok {
+		fmt.Println("add a [Lookup.uls] section to the configuration file to use them")
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+// Package contest computes contest scores from logged QSOs.
+package contest
+
+import (
+	"strings"
+
+	"github.com/tzneal/ham-go/adif"
+	"github.com/tzneal/ham-go/callsigns"
+	"github.com/tzneal/ham-go/dxcc"
+)
+
+// wpxBands are the bands used in the CQ WPX contest and whether they are the
+// low bands which score double points.
+var wpxBands = map[string]bool{
+	"160m": true, "80m": true, "40m": true,
+	"20m": false, "15m": false, "10m": false,
+}
+
+// WPX computes a CQ WPX contest score.  Each prefix counts as a multiplier
+// once, regardless of band, and QSO points depend on the continent and
+// country of the two stations.
+type WPX struct {
+	my       dxcc.Entity
+	myOK     bool
+	worked   map[wpxDupe]struct{}
+	prefixes map[string]struct{}
+	qsos     int
+	points   int
+}
+
+type wpxDupe struct {
+	call string
+	band string
+}
+
+// NewWPX constructs a new WPX contest scorer for the station with the given
+// call sign.
+func NewWPX(myCall string) *WPX {
+	ent, ok := dxcc.Lookup(myCall)
+	return &WPX{
+		my:       ent,
+		myOK:     ok,
+		worked:   map[wpxDupe]struct{}{},
+		prefixes: map[string]struct{}{},
+	}
+}
+
+// Add adds a QSO, returning the points it earned and whether it was a new
+// multiplier.  Duplicate QSOs and QSOs outside of the contest bands earn
+// nothing.
+func (w *WPX) Add(rec adif.Record) (points int, newMult bool) {
+	call := strings.ToUpper(strings.TrimSpace(rec.Get(adif.Call)))
+	band := rec.GetBand()
+	lowBand, ok := wpxBands[band]
+	if call == "" || !ok {
+		return 0, false
+	}
+	key := wpxDupe{call, band}
+	if _, dupe := w.worked[key]; dupe {
+		return 0, false
+	}
+	w.worked[key] = struct{}{}
+	w.qsos++
+
+	pfx := callsigns.WPXPrefix(call)
+	if _, ok := w.prefixes[pfx]; !ok {
+		w.prefixes[pfx] = struct{}{}
+		newMult = true
+	}
+
+	their, ok := dxcc.LookupRecord(rec)
+	if ok && w.myOK {
+		points = wpxPoints(w.my, their, lowBand)
+	}
+	w.points += points
+	return points, newMult
+}
+
+func wpxPoints(my, their dxcc.Entity, lowBand bool) int {
+	mul := 1
+	if lowBand {
+		mul = 2
+	}
+	switch {
+	case my.DXCC == their.DXCC:
+		// same country is always one point
+		return 1
+	case my.Continent != their.Continent:
+		return 3 * mul
+	case my.Continent == "NA":
+		// different countries within North America
+		return 2 * mul
+	}
+	return 1 * mul
+}
+
+// QSOs returns the number of non-duplicate QSOs.
+func (w *WPX) QSOs() int {
+	return w.qsos
+}
+
+// Points returns the total QSO points.
+func (w *WPX) Points() int {
+	return w.points
+}
+
+// Multipliers returns the number of unique prefixes worked.
+func (w *WPX) Multipliers() int {
+	return len(w.prefixes)
+}
+
+// Score returns the claimed score, QSO points multiplied by prefixes.
+func (w *WPX) Score() int {
+	return w.points * w.Multipliers()
+}
@@ -0,0 +1,49 @@
+package contest_test
+
+import (
+	"testing"
+
+	"github.com/tzneal/ham-go/adif"
+	"github.com/tzneal/ham-go/contest"
+)
+
+func TestWPX(t *testing.T) {
+	w := contest.NewWPX("KN4LHY")
+	td := []struct {
+		Record  adif.Record
+		Points  int
+		NewMult bool
+	}{
+		{adif.Record{{Name: adif.Call, Value: "W1AW"}, {Name: adif.ABand, Value: "20m"}}, 1, true},     // same country
+		{adif.Record{{Name: adif.Call, Value: "W1AW"}, {Name: adif.ABand, Value: "20m"}}, 0, false},    // dupe
+		{adif.Record{{Name: adif.Call, Value: "W1AW"}, {Name: adif.ABand, Value: "40m"}}, 1, false},    // same country, low band
+		{adif.Record{{Name: adif.Call, Value: "VE3ABC"}, {Name: adif.ABand, Value: "20m"}}, 2, true},   // North America
+		{adif.Record{{Name: adif.Call, Value: "VE3XYZ"}, {Name: adif.ABand, Value: "80m"}}, 4, false},  // North America, low band
+		{adif.Record{{Name: adif.Call, Value: "JA1ABC"}, {Name: adif.ABand, Value: "15m"}}, 3, true},   // different continent
+		{adif.Record{{Name: adif.Call, Value: "JA1XYZ"}, {Name: adif.ABand, Value: "160m"}}, 6, false}, // different continent, low band
+		{adif.Record{{Name: adif.Call, Value: "VK2ABC"}, {Name: adif.ABand, Value: "10m"}}, 3, true},   // Oceania
+		{adif.Record{{Name: adif.Call, Value: "G4ABC"}, {Name: adif.ABand, Value: "17m"}}, 0, false},   // not a contest band
+	}
+	for _, tc := range td {
+		call, band := tc.Record.Get(adif.Call), tc.Record.Get(adif.ABand)
+		points, newMult := w.Add(tc.Record)
+		if points != tc.Points {
+			t.Errorf("expected %d points for %s on %s, got %d", tc.Points, call, band, points)
+		}
+		if newMult != tc.NewMult {
+			t.Errorf("expected new multiplier = %v for %s, got %v", tc.NewMult, call, newMult)
+		}
+	}
+	if w.QSOs() != 7 {
+		t.Errorf("expected 7 QSOs, got %d", w.QSOs())
+	}
+	if w.Points() != 20 {
+		t.Errorf("expected 20 points, got %d", w.Points())
+	}
+	if w.Multipliers() != 4 {
+		t.Errorf("expected 4 multipliers, got %d", w.Multipliers())
+	}
+	if w.Score() != 80 {
+		t.Errorf("expected a score of 80, got %d", w.Score())
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/tzneal/ham-go/adif"
@@ -11,6 +13,13 @@ import (
 	bolt "go.etcd.io/bbolt"
 )
 
+// indexVersion is the version of the index layout.  Version 1 indexes QSOs
+// under the base call rather than the call as logged.
+const indexVersion = 1
+
+// metaBucket holds the index version, it's lower case so it can't be a call.
+var metaBucket = []byte("meta")
+
 type Database struct {
 	db *bolt.DB
 }
@@ -20,9 +29,68 @@ func Open(filename string) (*Database, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Database{
+	d := &Database{
 		db: db,
-	}, nil
+	}
+	if err := d.upgrade(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("upgrading index: %s", err)
+	}
+	return d, nil
+}
+
+// upgrade re-indexes QSOs indexed by an earlier version under the call as
+// logged (e.g. W1AW/P) in the bucket for the base call.
+func (d *Database) upgrade() error {
+	return d.db.Update(func(tx *bolt.Tx) error {
+		meta, err := tx.CreateBucketIfNotExists(metaBucket)
+		if err != nil {
+			return fmt.Errorf("create bucket: %s", err)
+		}
+		if v, _ := strconv.Atoi(string(meta.Get([]byte("version")))); v >= indexVersion {
+			return nil
+		}
+
+		// buckets can't be changed while iterating over them
+		var calls []string
+		tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			if string(name) != string(metaBucket) {
+				calls = append(calls, string(name))
+			}
+			return nil
+		})
+		for _, call := range calls {
+			var records []Record
+			err := tx.Bucket([]byte(call)).ForEach(func(k, v []byte) error {
+				r := Record{}
+				if err := json.Unmarshal(v, &r); err != nil {
+					return fmt.Errorf("error unmarshaling record: %s", err)
+				}
+				records = append(records, r)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			if err := tx.DeleteBucket([]byte(call)); err != nil {
+				return err
+			}
+			b, err := tx.CreateBucketIfNotExists([]byte(NormalizeCall(call)))
+			if err != nil {
+				return fmt.Errorf("create bucket: %s", err)
+			}
+			for _, r := range records {
+				val, err := r.value()
+				if err != nil {
+					return err
+				}
+				if err := b.Put(r.key(), val); err != nil {
+					return err
+				}
+			}
+		}
+		return meta.Put([]byte("version"), []byte(strconv.Itoa(indexVersion)))
+	})
 }
 
 func (d *Database) Close() error {
@@ -33,13 +101,13 @@ func (d *Database) AddRecord(r Record) error {
 	if r.Call == "" {
 		return fmt.Errorf("record must have a callsign")
 	}
-	r.Call = NormalizeCall(r.Call)
+	r.Call = strings.ToUpper(strings.TrimSpace(r.Call))
 	if r.Date.IsZero() {
 		return fmt.Errorf("date must be non-zero")
 	}
 	return d.db.Update(func(tx *bolt.Tx) error {
-		// create the callsign bucket
-		b, err := tx.CreateBucketIfNotExists([]byte(r.Call))
+		// create the bucket for the base call
+		b, err := tx.CreateBucketIfNotExists([]byte(NormalizeCall(r.Call)))
 		if err != nil {
 			return fmt.Errorf("create bucket: %s", err)
 		}
@@ -1,12 +1,15 @@
 package db_test
 
 import (
+	"fmt"
 	"io/ioutil"
 	"os"
 	"testing"
 	"time"
 
 	"github.com/tzneal/ham-go/db"
+
+	bolt "go.etcd.io/bbolt"
 )
 
 func TestCreate(t *testing.T) {
@@ -77,3 +80,87 @@ func TestAddRecord(t *testing.T) {
 	}
 
 }
+
+func TestSearchPortable(t *testing.T) {
+	d := newEmptyDB(t)
+	defer d.Close()
+
+	// QSOs in the same minute with the home and portable calls are both kept
+	now := time.Now()
+	for i, call := range []string{"W1AW", "w1aw/p", "KH6/W1AW/M"} {
+		r := db.Record{
+			Call:      call,
+			Date:      now.Add(time.Duration(i/2) * time.Minute),
+			Frequency: 14.025,
+			Mode:      "CW",
+		}
+		if err := d.AddRecord(r); err != nil {
+			t.Fatalf("error adding record: %s", err)
+		}
+	}
+	for _, call := range []string{"w1aw", "W1AW/QRP"} {
+		results, err := d.Search(call)
+		if err != nil {
+			t.Fatalf("error searching for callsign: %s", err)
+		}
+		if len(results) != 3 {
+			t.Fatalf("expected 3 results for %s, got %d", call, len(results))
+		}
+		calls := map[string]bool{}
+		for _, r := range results {
+			calls[r.Call] = true
+		}
+		if !calls["W1AW"] || !calls["W1AW/P"] || !calls["KH6/W1AW/M"] {
+			t.Errorf("expected the calls as logged, got %v", results)
+		}
+	}
+}
+
+func TestUpgrade(t *testing.T) {
+	f, err := ioutil.TempFile("", "dbtest")
+	if err != nil {
+		t.Fatalf("error creating temp file: %s", err)
+	}
+	fn := f.Name()
+	f.Close()
+	os.Remove(fn)
+	defer os.Remove(fn)
+
+	// the original index is keyed by the call as logged and the time
+	old, err := bolt.Open(fn, 0600, nil)
+	if err != nil {
+		t.Fatalf("error creating DB: %s", err)
+	}
+	err = old.Update(func(tx *bolt.Tx) error {
+		for _, call := range []string{"W1AW", "W1AW/P"} {
+			b, err := tx.CreateBucket([]byte(call))
+			if err != nil {
+				return err
+			}
+			val := fmt.Sprintf(`{"Call":%q,"Date":"2020-06-01T12:00:00Z","Frequency":14.025,"Mode":"CW"}`, call)
+			if err := b.Put([]byte("20200601 12:00"), []byte(val)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	old.Close()
+	if err != nil {
+		t.Fatalf("error creating records: %s", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		d, err := db.Open(fn)
+		if err != nil {
+			t.Fatalf("error opening DB: %s", err)
+		}
+		results, err := d.Search("W1AW")
+		d.Close()
+		if err != nil {
+			t.Fatalf("error searching for callsign: %s", err)
+		}
+		if len(results) != 2 || results[0].Call != "W1AW" || results[1].Call != "W1AW/P" {
+			t.Errorf("expected the W1AW and W1AW/P QSOs, got %v", results)
+		}
+	}
+}
@@ -13,8 +13,10 @@ type Record struct {
 	Mode      string
 }
 
+// key is the time and call as logged, as QSOs with portable and home calls
+// share a bucket.
 func (r Record) key() []byte {
-	return []byte(TimeToUTCString(r.Date))
+	return []byte(TimeToUTCString(r.Date) + " " + r.Call)
 }
 func (r Record) value() ([]byte, error) {
 	b, err := json.Marshal(r)
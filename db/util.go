@@ -1,8 +1,9 @@
 package db
 
 import (
-	"strings"
 	"time"
+
+	"github.com/tzneal/ham-go/callsigns"
 )
 
 const timestampFormat = "20060102 15:04"
@@ -12,9 +13,11 @@ func TimeToUTCString(t time.Time) string {
 	return t.Format(timestampFormat)
 }
 
+// NormalizeCall returns the key that QSOs with a call sign are indexed under,
+// which is the upper case base call so that portable and mobile operations
+// (e.g. W1AW/P, KH6/W1AW) are grouped with the operator's home call.
 func NormalizeCall(call string) string {
-	call = strings.TrimSpace(call)
-	return strings.ToUpper(call)
+	return callsigns.ParseCallsign(call).Base
 }
 
 func UTCStringToTime(s string) (time.Time, error) {
@@ -0,0 +1,57 @@
+package dxcc
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/tzneal/ham-go/adif"
+)
+
+// recordTime returns the time of a QSO, or the current time if it doesn't
+// have a valid date.
+func recordTime(rec adif.Record) time.Time {
+	if t, err := rec.GetTimeOn(); err == nil {
+		return t
+	}
+	if t, err := time.Parse("20060102", rec.Get(adif.QSODateStart)); err == nil {
+		return t
+	}
+	return time.Now()
+}
+
+// LookupRecord returns the DXCC entity for the call of a QSO on the QSO's
+// date.
+func LookupRecord(rec adif.Record) (Entity, bool) {
+	return LookupAt(rec.Get(adif.Call), recordTime(rec))
+}
+
+// FillRecord adds the DXCC entity, country, continent and zones for the call
+// of a QSO on the QSO's date, skipping any fields that the record already has
+// a value for.
+func FillRecord(rec adif.Record) adif.Record {
+	ent, ok := LookupRecord(rec)
+	if !ok {
+		return rec
+	}
+	add := func(name adif.Identifier, value string) {
+		for i := range rec {
+			if rec[i].Name == name {
+				if rec[i].Value == "" {
+					rec[i].Value = value
+				}
+				return
+			}
+		}
+		rec = append(rec, adif.Field{Name: name, Value: value})
+	}
+	add(adif.DXCC, strconv.Itoa(ent.DXCC))
+	add(adif.Country, ent.Entity)
+	add(adif.Continent, ent.Continent)
+	if ent.CQZone != 0 {
+		add(adif.CQZone, strconv.Itoa(ent.CQZone))
+	}
+	if ent.ITUZone != 0 {
+		add(adif.ITUZone, strconv.Itoa(ent.ITUZone))
+	}
+	return rec
+}
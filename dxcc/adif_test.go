@@ -0,0 +1,36 @@
+package dxcc_test
+
+import (
+	"testing"
+
+	"github.com/tzneal/ham-go/adif"
+	"github.com/tzneal/ham-go/dxcc"
+)
+
+func TestFillRecord(t *testing.T) {
+	rec := adif.Record{
+		{Name: adif.Call, Value: "KH6/W1AW"},
+		{Name: adif.QSODateStart, Value: "20200115"},
+		{Name: adif.TimeOn, Value: "1200"},
+		{Name: adif.Country, Value: ""},
+		{Name: adif.CQZone, Value: "31"},
+	}
+	rec = dxcc.FillRecord(rec)
+	exp := map[adif.Identifier]string{
+		adif.DXCC:      "110",
+		adif.Country:   "Hawaii",
+		adif.Continent: "OC",
+		adif.CQZone:    "31", // already set
+		adif.ITUZone:   "61",
+	}
+	for k, v := range exp {
+		if got := rec.Get(k); got != v {
+			t.Errorf("expected %s = %s, got %s", k, v, got)
+		}
+	}
+
+	mm := adif.Record{{Name: adif.Call, Value: "W1AW/MM"}}
+	if got := dxcc.FillRecord(mm); len(got) != 1 {
+		t.Errorf("expected nothing added for a maritime mobile station, got %v", got)
+	}
+}
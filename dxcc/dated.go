@@ -0,0 +1,81 @@
+package dxcc
+
+import (
+	"time"
+
+	"github.com/tzneal/ham-go/callsigns"
+)
+
+// validity is the range of time a record applies for, where a zero start or
+// end is unbounded.
+type validity struct {
+	start time.Time
+	end   time.Time
+}
+
+// bounded returns true if the record only applies for a limited time.
+func (v validity) bounded() bool {
+	return !v.start.IsZero() || !v.end.IsZero()
+}
+
+// contains returns true if the record applies at time t.
+func (v validity) contains(t time.Time) bool {
+	if !v.start.IsZero() && t.Before(v.start) {
+		return false
+	}
+	if !v.end.IsZero() && t.After(v.end) {
+		return false
+	}
+	return true
+}
+
+// datedTables are the Club Log style records that only apply for a range of
+// dates, e.g. prefixes of deleted entities, DXpeditions using a home call and
+// operations that aren't accepted for DXCC credit.
+type datedTables struct {
+	// prefixes and exceptions map a prefix or exact call (with any override
+	// markers) to an entity
+	prefixes   []datedPrefix
+	exceptions []datedPrefix
+	// invalid are calls whose operations don't count for any entity
+	invalid []datedCall
+	// zones are calls with a CQ zone that differs from their entity's
+	zones []datedZone
+	// entities is the entity table the records were loaded with, they're
+	// ignored if it's replaced
+	entities []Entity
+}
+
+type datedPrefix struct {
+	prefix string
+	ent    Entity
+	validity
+}
+
+type datedCall struct {
+	call string
+	validity
+}
+
+type datedZone struct {
+	call string
+	zone int
+	validity
+}
+
+// dated holds the date dependent records for the entity table, if the country
+// file that was loaded has them
+var dated *datedTables
+
+// LookupAt returns the DXCC entity for a call sign at a point in time, such as
+// the date of a QSO.  If the country file that was loaded has date ranges
+// (Club Log's cty.xml), calls are resolved with the prefixes and exceptions
+// that applied at that time and operations that weren't valid return false.
+// Otherwise the time makes no difference.
+func LookupAt(callsign string, t time.Time) (Entity, bool) {
+	cs := callsigns.ParseCallsign(callsign)
+	if !cs.HasEntity() {
+		return Entity{}, false
+	}
+	return currentIndex().lookupAt(cs.String(), cs.DXCCPrefix(), t)
+}
@@ -10,6 +10,7 @@ type Entity struct {
 	ITUZone      int
 	Latitude     float64
 	Longitude    float64
+	UTCOffset    float64
 	Prefixes     []string
 	Score        int
 	PrefixRegexp *regexp.Regexp
@@ -24,6 +25,7 @@ var Entities = []Entity{
 		ITUZone:      28,
 		Latitude:     41.900000,
 		Longitude:    12.430000,
+		UTCOffset:    1,
 		Prefixes:     []string{"1A"},
 		PrefixRegexp: regexp.MustCompile("^[1]"),
 	}, {
@@ -34,6 +36,7 @@ var Entities = []Entity{
 		ITUZone:      50,
 		Latitude:     9.880000,
 		Longitude:    114.230000,
+		UTCOffset:    8,
 		Prefixes:     []string{"9M0", "BM9S", "BN9S", "BO9S", "BP9S", "BQ9S", "BU9S", "BV9S", "BW9S", "BX9S"},
 		PrefixRegexp: regexp.MustCompile("^[9B]"),
 	}, {
@@ -44,6 +47,7 @@ var Entities = []Entity{
 		ITUZone:      27,
 		Latitude:     43.730000,
 		Longitude:    7.400000,
+		UTCOffset:    1,
 		Prefixes:     []string{"3A"},
 		PrefixRegexp: regexp.MustCompile("^[3]"),
 	}, {
@@ -54,6 +58,7 @@ var Entities = []Entity{
 		ITUZone:      53,
 		Latitude:     -10.450000,
 		Longitude:    56.670000,
+		UTCOffset:    4,
 		Prefixes:     []string{"3B6", "3B7"},
 		PrefixRegexp: regexp.MustCompile("^[3]"),
 	}, {
@@ -64,6 +69,7 @@ var Entities = []Entity{
 		ITUZone:      53,
 		Latitude:     -20.350000,
 		Longitude:    57.500000,
+		UTCOffset:    4,
 		Prefixes:     []string{"3B8"},
 		PrefixRegexp: regexp.MustCompile("^[3]"),
 	}, {
@@ -74,6 +80,7 @@ var Entities = []Entity{
 		ITUZone:      53,
 		Latitude:     -19.700000,
 		Longitude:    63.420000,
+		UTCOffset:    4,
 		Prefixes:     []string{"3B9"},
 		PrefixRegexp: regexp.MustCompile("^[3]"),
 	}, {
@@ -84,6 +91,7 @@ var Entities = []Entity{
 		ITUZone:      47,
 		Latitude:     1.700000,
 		Longitude:    10.330000,
+		UTCOffset:    1,
 		Prefixes:     []string{"3C"},
 		PrefixRegexp: regexp.MustCompile("^[3]"),
 	}, {
@@ -94,6 +102,7 @@ var Entities = []Entity{
 		ITUZone:      52,
 		Latitude:     -1.430000,
 		Longitude:    5.620000,
+		UTCOffset:    1,
 		Prefixes:     []string{"3C0"},
 		PrefixRegexp: regexp.MustCompile("^[3]"),
 	}, {
@@ -104,6 +113,7 @@ var Entities = []Entity{
 		ITUZone:      56,
 		Latitude:     -17.780000,
 		Longitude:    177.920000,
+		UTCOffset:    12,
 		Prefixes:     []string{"3D2"},
 		PrefixRegexp: regexp.MustCompile("^[3]"),
 	}, {
@@ -114,6 +124,7 @@ var Entities = []Entity{
 		ITUZone:      56,
 		Latitude:     -22.000000,
 		Longitude:    175.000000,
+		UTCOffset:    12,
 		Prefixes:     []string{"=3D2CR"},
 		PrefixRegexp: regexp.MustCompile("^[3]"),
 	}, {
@@ -124,6 +135,7 @@ var Entities = []Entity{
 		ITUZone:      56,
 		Latitude:     -12.480000,
 		Longitude:    177.080000,
+		UTCOffset:    12,
 		Prefixes:     []string{"=3D2RI"},
 		PrefixRegexp: regexp.MustCompile("^[3]"),
 	}, {
@@ -134,6 +146,7 @@ var Entities = []Entity{
 		ITUZone:      57,
 		Latitude:     -26.650000,
 		Longitude:    31.480000,
+		UTCOffset:    2,
 		Prefixes:     []string{"3DA"},
 		PrefixRegexp: regexp.MustCompile("^[3]"),
 	}, {
@@ -144,6 +157,7 @@ var Entities = []Entity{
 		ITUZone:      37,
 		Latitude:     35.400000,
 		Longitude:    9.320000,
+		UTCOffset:    1,
 		Prefixes:     []string{"3V", "TS"},
 		PrefixRegexp: regexp.MustCompile("^[3T]"),
 	}, {
@@ -154,6 +168,7 @@ var Entities = []Entity{
 		ITUZone:      49,
 		Latitude:     15.800000,
 		Longitude:    107.900000,
+		UTCOffset:    7,
 		Prefixes:     []string{"3W", "XV"},
 		PrefixRegexp: regexp.MustCompile("^[3X]"),
 	}, {
@@ -164,6 +179,7 @@ var Entities = []Entity{
 		ITUZone:      46,
 		Latitude:     11.000000,
 		Longitude:    -10.680000,
+		UTCOffset:    0,
 		Prefixes:     []string{"3X"},
 		PrefixRegexp: regexp.MustCompile("^[3]"),
 	}, {
@@ -174,6 +190,7 @@ var Entities = []Entity{
 		ITUZone:      67,
 		Latitude:     -54.420000,
 		Longitude:    3.380000,
+		UTCOffset:    1,
 		Prefixes:     []string{"=3Y0E"},
 		PrefixRegexp: regexp.MustCompile("^[3]"),
 	}, {
@@ -184,6 +201,7 @@ var Entities = []Entity{
 		ITUZone:      72,
 		Latitude:     -68.770000,
 		Longitude:    -90.580000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"=3Y0X"},
 		PrefixRegexp: regexp.MustCompile("^[3]"),
 	}, {
@@ -194,6 +212,7 @@ var Entities = []Entity{
 		ITUZone:      29,
 		Latitude:     40.450000,
 		Longitude:    47.370000,
+		UTCOffset:    4,
 		Prefixes:     []string{"4J", "4K"},
 		PrefixRegexp: regexp.MustCompile("^[4]"),
 	}, {
@@ -204,6 +223,7 @@ var Entities = []Entity{
 		ITUZone:      29,
 		Latitude:     42.000000,
 		Longitude:    45.000000,
+		UTCOffset:    4,
 		Prefixes:     []string{"4L"},
 		PrefixRegexp: regexp.MustCompile("^[4]"),
 	}, {
@@ -214,6 +234,7 @@ var Entities = []Entity{
 		ITUZone:      28,
 		Latitude:     42.500000,
 		Longitude:    19.280000,
+		UTCOffset:    1,
 		Prefixes:     []string{"4O"},
 		PrefixRegexp: regexp.MustCompile("^[4]"),
 	}, {
@@ -224,6 +245,7 @@ var Entities = []Entity{
 		ITUZone:      41,
 		Latitude:     7.600000,
 		Longitude:    80.700000,
+		UTCOffset:    5.5,
 		Prefixes:     []string{"4P", "4Q", "4R", "4S"},
 		PrefixRegexp: regexp.MustCompile("^[4]"),
 	}, {
@@ -234,6 +256,7 @@ var Entities = []Entity{
 		ITUZone:      28,
 		Latitude:     46.170000,
 		Longitude:    6.050000,
+		UTCOffset:    1,
 		Prefixes:     []string{"=4U1ITU", "=4U1WRC"},
 		PrefixRegexp: regexp.MustCompile("^[4]"),
 	}, {
@@ -244,6 +267,7 @@ var Entities = []Entity{
 		ITUZone:      8,
 		Latitude:     40.750000,
 		Longitude:    -73.970000,
+		UTCOffset:    -5,
 		Prefixes:     []string{"=4U1UN"},
 		PrefixRegexp: regexp.MustCompile("^[4]"),
 	}, {
@@ -254,6 +278,7 @@ var Entities = []Entity{
 		ITUZone:      28,
 		Latitude:     48.200000,
 		Longitude:    16.300000,
+		UTCOffset:    1,
 		Prefixes:     []string{"=4U0R", "=4U1A", "=4U1VIC", "=4U2STAYHOME", "=4U2U", "=4Y1A", "=C7A"},
 		PrefixRegexp: regexp.MustCompile("^[4C]"),
 	}, {
@@ -264,6 +289,7 @@ var Entities = []Entity{
 		ITUZone:      54,
 		Latitude:     -8.800000,
 		Longitude:    126.050000,
+		UTCOffset:    9,
 		Prefixes:     []string{"4W"},
 		PrefixRegexp: regexp.MustCompile("^[4]"),
 	}, {
@@ -274,6 +300,7 @@ var Entities = []Entity{
 		ITUZone:      39,
 		Latitude:     31.320000,
 		Longitude:    34.820000,
+		UTCOffset:    2,
 		Prefixes:     []string{"4X", "4Z"},
 		PrefixRegexp: regexp.MustCompile("^[4]"),
 	}, {
@@ -284,6 +311,7 @@ var Entities = []Entity{
 		ITUZone:      38,
 		Latitude:     27.200000,
 		Longitude:    16.600000,
+		UTCOffset:    2,
 		Prefixes:     []string{"5A"},
 		PrefixRegexp: regexp.MustCompile("^[5]"),
 	}, {
@@ -294,6 +322,7 @@ var Entities = []Entity{
 		ITUZone:      39,
 		Latitude:     35.000000,
 		Longitude:    33.000000,
+		UTCOffset:    2,
 		Prefixes:     []string{"5B", "C4", "H2", "P3"},
 		PrefixRegexp: regexp.MustCompile("^[5CHP]"),
 	}, {
@@ -304,6 +333,7 @@ var Entities = []Entity{
 		ITUZone:      53,
 		Latitude:     -5.750000,
 		Longitude:    33.920000,
+		UTCOffset:    3,
 		Prefixes:     []string{"5H", "5I"},
 		PrefixRegexp: regexp.MustCompile("^[5]"),
 	}, {
@@ -314,6 +344,7 @@ var Entities = []Entity{
 		ITUZone:      46,
 		Latitude:     9.870000,
 		Longitude:    7.550000,
+		UTCOffset:    1,
 		Prefixes:     []string{"5N", "5O"},
 		PrefixRegexp: regexp.MustCompile("^[5]"),
 	}, {
@@ -324,6 +355,7 @@ var Entities = []Entity{
 		ITUZone:      53,
 		Latitude:     -19.000000,
 		Longitude:    46.580000,
+		UTCOffset:    3,
 		Prefixes:     []string{"5R", "5S", "6X"},
 		PrefixRegexp: regexp.MustCompile("^[56]"),
 	}, {
@@ -334,6 +366,7 @@ var Entities = []Entity{
 		ITUZone:      46,
 		Latitude:     20.600000,
 		Longitude:    -10.500000,
+		UTCOffset:    0,
 		Prefixes:     []string{"5T"},
 		PrefixRegexp: regexp.MustCompile("^[5]"),
 	}, {
@@ -344,6 +377,7 @@ var Entities = []Entity{
 		ITUZone:      46,
 		Latitude:     17.630000,
 		Longitude:    9.430000,
+		UTCOffset:    1,
 		Prefixes:     []string{"5U"},
 		PrefixRegexp: regexp.MustCompile("^[5]"),
 	}, {
@@ -354,6 +388,7 @@ var Entities = []Entity{
 		ITUZone:      46,
 		Latitude:     8.400000,
 		Longitude:    1.280000,
+		UTCOffset:    0,
 		Prefixes:     []string{"5V"},
 		PrefixRegexp: regexp.MustCompile("^[5]"),
 	}, {
@@ -364,6 +399,7 @@ var Entities = []Entity{
 		ITUZone:      62,
 		Latitude:     -13.930000,
 		Longitude:    -171.700000,
+		UTCOffset:    13,
 		Prefixes:     []string{"5W"},
 		PrefixRegexp: regexp.MustCompile("^[5]"),
 	}, {
@@ -374,6 +410,7 @@ var Entities = []Entity{
 		ITUZone:      48,
 		Latitude:     1.920000,
 		Longitude:    32.600000,
+		UTCOffset:    3,
 		Prefixes:     []string{"5X"},
 		PrefixRegexp: regexp.MustCompile("^[5]"),
 	}, {
@@ -384,6 +421,7 @@ var Entities = []Entity{
 		ITUZone:      48,
 		Latitude:     0.320000,
 		Longitude:    38.150000,
+		UTCOffset:    3,
 		Prefixes:     []string{"5Y", "5Z"},
 		PrefixRegexp: regexp.MustCompile("^[5]"),
 	}, {
@@ -394,6 +432,7 @@ var Entities = []Entity{
 		ITUZone:      46,
 		Latitude:     15.200000,
 		Longitude:    -14.630000,
+		UTCOffset:    0,
 		Prefixes:     []string{"6V", "6W"},
 		PrefixRegexp: regexp.MustCompile("^[6]"),
 	}, {
@@ -404,6 +443,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     18.200000,
 		Longitude:    -77.470000,
+		UTCOffset:    -5,
 		Prefixes:     []string{"6Y"},
 		PrefixRegexp: regexp.MustCompile("^[6]"),
 	}, {
@@ -414,6 +454,7 @@ var Entities = []Entity{
 		ITUZone:      39,
 		Latitude:     15.650000,
 		Longitude:    48.120000,
+		UTCOffset:    3,
 		Prefixes:     []string{"7O"},
 		PrefixRegexp: regexp.MustCompile("^[7]"),
 	}, {
@@ -424,6 +465,7 @@ var Entities = []Entity{
 		ITUZone:      57,
 		Latitude:     -29.220000,
 		Longitude:    27.880000,
+		UTCOffset:    2,
 		Prefixes:     []string{"7P"},
 		PrefixRegexp: regexp.MustCompile("^[7]"),
 	}, {
@@ -434,6 +476,7 @@ var Entities = []Entity{
 		ITUZone:      53,
 		Latitude:     -14.000000,
 		Longitude:    34.000000,
+		UTCOffset:    2,
 		Prefixes:     []string{"7Q"},
 		PrefixRegexp: regexp.MustCompile("^[7]"),
 	}, {
@@ -444,6 +487,7 @@ var Entities = []Entity{
 		ITUZone:      37,
 		Latitude:     28.000000,
 		Longitude:    2.000000,
+		UTCOffset:    1,
 		Prefixes:     []string{"7R", "7T", "7U", "7V", "7W", "7X", "7Y"},
 		PrefixRegexp: regexp.MustCompile("^[7]"),
 	}, {
@@ -454,6 +498,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     13.180000,
 		Longitude:    -59.530000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"8P"},
 		PrefixRegexp: regexp.MustCompile("^[8]"),
 	}, {
@@ -464,6 +509,7 @@ var Entities = []Entity{
 		ITUZone:      41,
 		Latitude:     4.150000,
 		Longitude:    73.450000,
+		UTCOffset:    5,
 		Prefixes:     []string{"8Q"},
 		PrefixRegexp: regexp.MustCompile("^[8]"),
 	}, {
@@ -474,6 +520,7 @@ var Entities = []Entity{
 		ITUZone:      12,
 		Latitude:     6.020000,
 		Longitude:    -59.450000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"8R"},
 		PrefixRegexp: regexp.MustCompile("^[8]"),
 	}, {
@@ -484,6 +531,7 @@ var Entities = []Entity{
 		ITUZone:      28,
 		Latitude:     45.180000,
 		Longitude:    15.300000,
+		UTCOffset:    1,
 		Prefixes:     []string{"9A"},
 		PrefixRegexp: regexp.MustCompile("^[9]"),
 	}, {
@@ -494,6 +542,7 @@ var Entities = []Entity{
 		ITUZone:      46,
 		Latitude:     7.700000,
 		Longitude:    -1.570000,
+		UTCOffset:    0,
 		Prefixes:     []string{"9G"},
 		PrefixRegexp: regexp.MustCompile("^[9]"),
 	}, {
@@ -504,6 +553,7 @@ var Entities = []Entity{
 		ITUZone:      28,
 		Latitude:     35.880000,
 		Longitude:    14.420000,
+		UTCOffset:    1,
 		Prefixes:     []string{"9H"},
 		PrefixRegexp: regexp.MustCompile("^[9]"),
 	}, {
@@ -514,6 +564,7 @@ var Entities = []Entity{
 		ITUZone:      53,
 		Latitude:     -14.220000,
 		Longitude:    26.730000,
+		UTCOffset:    2,
 		Prefixes:     []string{"9I", "9J"},
 		PrefixRegexp: regexp.MustCompile("^[9]"),
 	}, {
@@ -524,6 +575,7 @@ var Entities = []Entity{
 		ITUZone:      39,
 		Latitude:     29.380000,
 		Longitude:    47.380000,
+		UTCOffset:    3,
 		Prefixes:     []string{"9K", "NLD"},
 		PrefixRegexp: regexp.MustCompile("^[9N]"),
 	}, {
@@ -534,6 +586,7 @@ var Entities = []Entity{
 		ITUZone:      46,
 		Latitude:     8.500000,
 		Longitude:    -13.250000,
+		UTCOffset:    0,
 		Prefixes:     []string{"9L"},
 		PrefixRegexp: regexp.MustCompile("^[9]"),
 	}, {
@@ -544,6 +597,7 @@ var Entities = []Entity{
 		ITUZone:      54,
 		Latitude:     3.950000,
 		Longitude:    102.230000,
+		UTCOffset:    8,
 		Prefixes:     []string{"9M", "9W"},
 		PrefixRegexp: regexp.MustCompile("^[9]"),
 	}, {
@@ -554,6 +608,7 @@ var Entities = []Entity{
 		ITUZone:      54,
 		Latitude:     2.680000,
 		Longitude:    113.320000,
+		UTCOffset:    8,
 		Prefixes:     []string{"9M6", "9M8", "9W6", "9W8", "=9M4CCB", "=9M4CKT", "=9M4CRB", "=9M4CRP", "=9M4SJSM"},
 		PrefixRegexp: regexp.MustCompile("^[9]"),
 	}, {
@@ -564,6 +619,7 @@ var Entities = []Entity{
 		ITUZone:      42,
 		Latitude:     27.700000,
 		Longitude:    85.330000,
+		UTCOffset:    5.75,
 		Prefixes:     []string{"9N"},
 		PrefixRegexp: regexp.MustCompile("^[9]"),
 	}, {
@@ -574,6 +630,7 @@ var Entities = []Entity{
 		ITUZone:      52,
 		Latitude:     -3.120000,
 		Longitude:    23.030000,
+		UTCOffset:    1,
 		Prefixes:     []string{"9O", "9P", "9Q", "9R", "9S", "9T"},
 		PrefixRegexp: regexp.MustCompile("^[9]"),
 	}, {
@@ -584,6 +641,7 @@ var Entities = []Entity{
 		ITUZone:      52,
 		Latitude:     -3.170000,
 		Longitude:    29.780000,
+		UTCOffset:    2,
 		Prefixes:     []string{"9U"},
 		PrefixRegexp: regexp.MustCompile("^[9]"),
 	}, {
@@ -594,6 +652,7 @@ var Entities = []Entity{
 		ITUZone:      54,
 		Latitude:     1.370000,
 		Longitude:    103.780000,
+		UTCOffset:    8,
 		Prefixes:     []string{"9V", "S6"},
 		PrefixRegexp: regexp.MustCompile("^[9S]"),
 	}, {
@@ -604,6 +663,7 @@ var Entities = []Entity{
 		ITUZone:      52,
 		Latitude:     -1.750000,
 		Longitude:    29.820000,
+		UTCOffset:    2,
 		Prefixes:     []string{"9X"},
 		PrefixRegexp: regexp.MustCompile("^[9]"),
 	}, {
@@ -614,6 +674,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     10.380000,
 		Longitude:    -61.280000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"9Y", "9Z"},
 		PrefixRegexp: regexp.MustCompile("^[9]"),
 	}, {
@@ -624,6 +685,7 @@ var Entities = []Entity{
 		ITUZone:      57,
 		Latitude:     -22.000000,
 		Longitude:    24.000000,
+		UTCOffset:    2,
 		Prefixes:     []string{"8O", "A2"},
 		PrefixRegexp: regexp.MustCompile("^[8A]"),
 	}, {
@@ -634,6 +696,7 @@ var Entities = []Entity{
 		ITUZone:      62,
 		Latitude:     -21.220000,
 		Longitude:    -175.130000,
+		UTCOffset:    13,
 		Prefixes:     []string{"A3", "=VERSION"},
 		PrefixRegexp: regexp.MustCompile("^[AV]"),
 	}, {
@@ -644,6 +707,7 @@ var Entities = []Entity{
 		ITUZone:      39,
 		Latitude:     23.600000,
 		Longitude:    58.550000,
+		UTCOffset:    4,
 		Prefixes:     []string{"A4"},
 		PrefixRegexp: regexp.MustCompile("^[A]"),
 	}, {
@@ -654,6 +718,7 @@ var Entities = []Entity{
 		ITUZone:      41,
 		Latitude:     27.400000,
 		Longitude:    90.180000,
+		UTCOffset:    6,
 		Prefixes:     []string{"A5"},
 		PrefixRegexp: regexp.MustCompile("^[A]"),
 	}, {
@@ -664,6 +729,7 @@ var Entities = []Entity{
 		ITUZone:      39,
 		Latitude:     24.000000,
 		Longitude:    54.000000,
+		UTCOffset:    4,
 		Prefixes:     []string{"A6"},
 		PrefixRegexp: regexp.MustCompile("^[A]"),
 	}, {
@@ -674,6 +740,7 @@ var Entities = []Entity{
 		ITUZone:      39,
 		Latitude:     25.250000,
 		Longitude:    51.130000,
+		UTCOffset:    3,
 		Prefixes:     []string{"A7"},
 		PrefixRegexp: regexp.MustCompile("^[A]"),
 	}, {
@@ -684,6 +751,7 @@ var Entities = []Entity{
 		ITUZone:      39,
 		Latitude:     26.030000,
 		Longitude:    50.530000,
+		UTCOffset:    3,
 		Prefixes:     []string{"A9"},
 		PrefixRegexp: regexp.MustCompile("^[A]"),
 	}, {
@@ -694,6 +762,7 @@ var Entities = []Entity{
 		ITUZone:      41,
 		Latitude:     30.000000,
 		Longitude:    70.000000,
+		UTCOffset:    5,
 		Prefixes:     []string{"6P", "6Q", "6R", "6S", "AP", "AQ", "AR", "AS"},
 		PrefixRegexp: regexp.MustCompile("^[6A]"),
 	}, {
@@ -704,6 +773,7 @@ var Entities = []Entity{
 		ITUZone:      50,
 		Latitude:     15.080000,
 		Longitude:    117.720000,
+		UTCOffset:    8,
 		Prefixes:     []string{"=BS7H"},
 		PrefixRegexp: regexp.MustCompile("^[B]"),
 	}, {
@@ -714,6 +784,7 @@ var Entities = []Entity{
 		ITUZone:      44,
 		Latitude:     23.720000,
 		Longitude:    120.880000,
+		UTCOffset:    8,
 		Prefixes:     []string{"BM", "BN", "BO", "BP", "BQ", "BU", "BV", "BW", "BX"},
 		PrefixRegexp: regexp.MustCompile("^[B]"),
 	}, {
@@ -724,6 +795,7 @@ var Entities = []Entity{
 		ITUZone:      44,
 		Latitude:     20.700000,
 		Longitude:    116.700000,
+		UTCOffset:    8,
 		Prefixes:     []string{"BM9P", "BN9P", "BO9P", "BP9P", "BQ9P", "BU9P", "BV9P", "BW9P", "BX9P"},
 		PrefixRegexp: regexp.MustCompile("^[B]"),
 	}, {
@@ -734,6 +806,7 @@ var Entities = []Entity{
 		ITUZone:      44,
 		Latitude:     36.000000,
 		Longitude:    102.000000,
+		UTCOffset:    8,
 		Prefixes:     []string{"3H", "3H0(23)[42]", "3H9(23)[43]", "3I", "3I0(23)[42]", "3I9(23)[43]", "3J", "3J0(23)[42]", "3J9(23)[43]", "3K", "3K0(23)[42]", "3K9(23)[43]", "3L", "3L0(23)[42]", "3L9(23)[43]", "3M", "3M0(23)[42]", "3M9(23)[43]", "3N", "3N0(23)[42]", "3N9(23)[43]", "3O", "3O0(23)[42]", "3O9(23)[43]", "3P", "3P0(23)[42]", "3P9(23)[43]", "3Q", "3Q0(23)[42]", "3Q9(23)[43]", "3R", "3R0(23)[42]", "3R9(23)[43]", "3S", "3S0(23)[42]", "3S9(23)[43]", "3T", "3T0(23)[42]", "3T9(23)[43]", "3U", "3U0(23)[42]", "3U9(23)[43]", "B0(23)[42]", "B2", "B3", "B4", "B5", "B6", "B7", "B8", "B9(23)[43]", "BA", "BA0(23)[42]", "BA9(23)[43]", "BD", "BD0(23)[42]", "BD9(23)[43]", "BG", "BG0(23)[42]", "BG9(23)[43]", "BH", "BH0(23)[42]", "BH9(23)[43]", "BI", "BI0(23)[42]", "BI9(23)[43]", "BJ", "BJ0(23)[42]", "BJ9(23)[43]", "BL", "BL0(23)[42]", "BL9(23)[43]", "BT", "BT0(23)[42]", "BT9(23)[43]", "BY", "BY0(23)[42]", "BY9(23)[43]", "BZ", "BZ0(23)[42]", "BZ9(23)[43]", "XS", "XS0(23)[42]", "XS9(23)[43]", "B1", "B2A[33]", "B2B[33]", "B2C[33]", "B2D[33]", "B2E[33]", "B2F[33]", "B2G[33]", "B2H[33]", "B2I[33]", "B2J[33]", "B2K[33]", "B2L[33]", "B2M[33]", "B2N[33]", "B2O[33]", "B2P[33]", "B3G(23)[33]", "B3H(23)[33]", "B3I(23)[33]", "B3J(23)[33]", "B3K(23)[33]", "B3L(23)[33]", "B6Q[43]", "B6R[43]", "B6S[43]", "B6T[43]", "B6U[43]", "B6V[43]", "B6W[43]", "B6X[43]", "B7A[43]", "B7B[43]", "B7C[43]", "B7D[43]", "B7E[43]", "B7F[43]", "B7G[43]", "B7H[43]", "B7Q[43]", "B7R[43]", "B7S[43]", "B7T[43]", "B7U[43]", "B7V[43]", "B7W[43]", "B7X[43]", "B8A[43]", "B8B[43]", "B8C[43]", "B8D[43]", "B8E[43]", "B8F[43]", "B8G[43]", "B8H[43]", "B8I[43]", "B8J[43]", "B8K[43]", "B8L[43]", "B8M[43]", "B8N[43]", "B8O[43]", "B8P[43]", "B8Q[43]", "B8R[43]", "B8S[43]", "B8T[43]", "B8U[43]", "B8V[43]", "B8W[43]", "B8X[43]", "B9A(24)[43]", "B9B(24)[43]", "B9C(24)[43]", "B9D(24)[43]", "B9E(24)[43]", "B9F(24)[43]", "B9S(23)[42]", "B9T(23)[42]", "B9U(23)[42]", "B9V(23)[42]", "B9W(23)[42]", "B9X(23)[42]", "BA2A[33]", "BA2B[33]", "BA2C[33]", "BA2D[33]", "BA2E[33]", "BA2F[33]", "BA2G[33]", "BA2H[33]", "BA2I[33]", "BA2J[33]", "BA2K[33]", "BA2L[33]", "BA2M[33]", "BA2N[33]", "BA2O[33]", "BA2P[33]", "BA3G(23)[33]", "BA3H(23)[33]", "BA3I(23)[33]", "BA3J(23)[33]", "BA3K(23)[33]", "BA3L(23)[33]", "BA6Q[43]", "BA6R[43]", "BA6S[43]", "BA6T[43]", "BA6U[43]", "BA6V[43]", "BA6W[43]", "BA6X[43]", "BA7A[43]", "BA7B[43]", "BA7C[43]", "BA7D[43]", "BA7E[43]", "BA7F[43]", "BA7G[43]", "BA7H[43]", "BA7Q[43]", "BA7R[43]", "BA7S[43]", "BA7T[43]", "BA7U[43]", "BA7V[43]", "BA7W[43]", "BA7X[43]", "BA8A[43]", "BA8B[43]", "BA8C[43]", "BA8D[43]", "BA8E[43]", "BA8F[43]", "BA8G[43]", "BA8H[43]", "BA8I[43]", "BA8J[43]", "BA8K[43]", "BA8L[43]", "BA8M[43]", "BA8N[43]", "BA8O[43]", "BA8P[43]", "BA8Q[43]", "BA8R[43]", "BA8S[43]", "BA8T[43]", "BA8U[43]", "BA8V[43]", "BA8W[43]", "BA8X[43]", "BA9A(24)[43]", "BA9B(24)[43]", "BA9C(24)[43]", "BA9D(24)[43]", "BA9E(24)[43]", "BA9F(24)[43]", "BA9S(23)[42]", "BA9T(23)[42]", "BA9U(23)[42]", "BA9V(23)[42]", "BA9W(23)[42]", "BA9X(23)[42]", "BD2A[33]", "BD2B[33]", "BD2C[33]", "BD2D[33]", "BD2E[33]", "BD2F[33]", "BD2G[33]", "BD2H[33]", "BD2I[33]", "BD2J[33]", "BD2K[33]", "BD2L[33]", "BD2M[33]", "BD2N[33]", "BD2O[33]", "BD2P[33]", "BD3G(23)[33]", "BD3H(23)[33]", "BD3I(23)[33]", "BD3J(23)[33]", "BD3K(23)[33]", "BD3L(23)[33]", "BD6Q[43]", "BD6R[43]", "BD6S[43]", "BD6T[43]", "BD6U[43]", "BD6V[43]", "BD6W[43]", "BD6X[43]", "BD7A[43]", "BD7B[43]", "BD7C[43]", "BD7D[43]", "BD7E[43]", "BD7F[43]", "BD7G[43]", "BD7H[43]", "BD7Q[43]", "BD7R[43]", "BD7S[43]", "BD7T[43]", "BD7U[43]", "BD7V[43]", "BD7W[43]", "BD7X[43]", "BD8A[43]", "BD8B[43]", "BD8C[43]", "BD8D[43]", "BD8E[43]", "BD8F[43]", "BD8G[43]", "BD8H[43]", "BD8I[43]", "BD8J[43]", "BD8K[43]", "BD8L[43]", "BD8M[43]", "BD8N[43]", "BD8O[43]", "BD8P[43]", "BD8Q[43]", "BD8R[43]", "BD8S[43]", "BD8T[43]", "BD8U[43]", "BD8V[43]", "BD8W[43]", "BD8X[43]", "BD9A(24)[43]", "BD9B(24)[43]", "BD9C(24)[43]", "BD9D(24)[43]", "BD9E(24)[43]", "BD9F(24)[43]", "BD9S(23)[42]", "BD9T(23)[42]", "BD9U(23)[42]", "BD9V(23)[42]", "BD9W(23)[42]", "BD9X(23)[42]", "BG2A[33]", "BG2B[33]", "BG2C[33]", "BG2D[33]", "BG2E[33]", "BG2F[33]", "BG2G[33]", "BG2H[33]", "BG2I[33]", "BG2J[33]", "BG2K[33]", "BG2L[33]", "BG2M[33]", "BG2N[33]", "BG2O[33]", "BG2P[33]", "BG3G(23)[33]", "BG3H(23)[33]", "BG3I(23)[33]", "BG3J(23)[33]", "BG3K(23)[33]", "BG3L(23)[33]", "BG6Q[43]", "BG6R[43]", "BG6S[43]", "BG6T[43]", "BG6U[43]", "BG6V[43]", "BG6W[43]", "BG6X[43]", "BG7A[43]", "BG7B[43]", "BG7C[43]", "BG7D[43]", "BG7E[43]", "BG7F[43]", "BG7G[43]", "BG7H[43]", "BG7Q[43]", "BG7R[43]", "BG7S[43]", "BG7T[43]", "BG7U[43]", "BG7V[43]", "BG7W[43]", "BG7X[43]", "BG8A[43]", "BG8B[43]", "BG8C[43]", "BG8D[43]", "BG8E[43]", "BG8F[43]", "BG8G[43]", "BG8H[43]", "BG8I[43]", "BG8J[43]", "BG8K[43]", "BG8L[43]", "BG8M[43]", "BG8N[43]", "BG8O[43]", "BG8P[43]", "BG8Q[43]", "BG8R[43]", "BG8S[43]", "BG8T[43]", "BG8U[43]", "BG8V[43]", "BG8W[43]", "BG8X[43]", "BG9A(24)[43]", "BG9B(24)[43]", "BG9C(24)[43]", "BG9D(24)[43]", "BG9E(24)[43]", "BG9F(24)[43]", "BG9S(23)[42]", "BG9T(23)[42]", "BG9U(23)[42]", "BG9V(23)[42]", "BG9W(23)[42]", "BG9X(23)[42]", "BH2A[33]", "BH2B[33]", "BH2C[33]", "BH2D[33]", "BH2E[33]", "BH2F[33]", "BH2G[33]", "BH2H[33]", "BH2I[33]", "BH2J[33]", "BH2K[33]", "BH2L[33]", "BH2M[33]", "BH2N[33]", "BH2O[33]", "BH2P[33]", "BH3G(23)[33]", "BH3H(23)[33]", "BH3I(23)[33]", "BH3J(23)[33]", "BH3K(23)[33]", "BH3L(23)[33]", "BH6Q[43]", "BH6R[43]", "BH6S[43]", "BH6T[43]", "BH6U[43]", "BH6V[43]", "BH6W[43]", "BH6X[43]", "BH7A[43]", "BH7B[43]", "BH7C[43]", "BH7D[43]", "BH7E[43]", "BH7F[43]", "BH7G[43]", "BH7H[43]", "BH7Q[43]", "BH7R[43]", "BH7S[43]", "BH7T[43]", "BH7U[43]", "BH7V[43]", "BH7W[43]", "BH7X[43]", "BH8A[43]", "BH8B[43]", "BH8C[43]", "BH8D[43]", "BH8E[43]", "BH8F[43]", "BH8G[43]", "BH8H[43]", "BH8I[43]", "BH8J[43]", "BH8K[43]", "BH8L[43]", "BH8M[43]", "BH8N[43]", "BH8O[43]", "BH8P[43]", "BH8Q[43]", "BH8R[43]", "BH8S[43]", "BH8T[43]", "BH8U[43]", "BH8V[43]", "BH8W[43]", "BH8X[43]", "BH9A(24)[43]", "BH9B(24)[43]", "BH9C(24)[43]", "BH9D(24)[43]", "BH9E(24)[43]", "BH9F(24)[43]", "BH9S(23)[42]", "BH9T(23)[42]", "BH9U(23)[42]", "BH9V(23)[42]", "BH9W(23)[42]", "BH9X(23)[42]", "BI2A[33]", "BI2B[33]", "BI2C[33]", "BI2D[33]", "BI2E[33]", "BI2F[33]", "BI2G[33]", "BI2H[33]", "BI2I[33]", "BI2J[33]", "BI2K[33]", "BI2L[33]", "BI2M[33]", "BI2N[33]", "BI2O[33]", "BI2P[33]", "BI3G(23)[33]", "BI3H(23)[33]", "BI3I(23)[33]", "BI3J(23)[33]", "BI3K(23)[33]", "BI3L(23)[33]", "BI6Q[43]", "BI6R[43]", "BI6S[43]", "BI6T[43]", "BI6U[43]", "BI6V[43]", "BI6W[43]", "BI6X[43]", "BI7A[43]", "BI7B[43]", "BI7C[43]", "BI7D[43]", "BI7E[43]", "BI7F[43]", "BI7G[43]", "BI7H[43]", "BI7Q[43]", "BI7R[43]", "BI7S[43]", "BI7T[43]", "BI7U[43]", "BI7V[43]", "BI7W[43]", "BI7X[43]", "BI8A[43]", "BI8B[43]", "BI8C[43]", "BI8D[43]", "BI8E[43]", "BI8F[43]", "BI8G[43]", "BI8H[43]", "BI8I[43]", "BI8J[43]", "BI8K[43]", "BI8L[43]", "BI8M[43]", "BI8N[43]", "BI8O[43]", "BI8P[43]", "BI8Q[43]", "BI8R[43]", "BI8S[43]", "BI8T[43]", "BI8U[43]", "BI8V[43]", "BI8W[43]", "BI8X[43]", "BI9A(24)[43]", "BI9B(24)[43]", "BI9C(24)[43]", "BI9D(24)[43]", "BI9E(24)[43]", "BI9F(24)[43]", "BI9S(23)[42]", "BI9T(23)[42]", "BI9U(23)[42]", "BI9V(23)[42]", "BI9W(23)[42]", "BI9X(23)[42]", "BJ2A[33]", "BJ2B[33]", "BJ2C[33]", "BJ2D[33]", "BJ2E[33]", "BJ2F[33]", "BJ2G[33]", "BJ2H[33]", "BJ2I[33]", "BJ2J[33]", "BJ2K[33]", "BJ2L[33]", "BJ2M[33]", "BJ2N[33]", "BJ2O[33]", "BJ2P[33]", "BJ3G(23)[33]", "BJ3H(23)[33]", "BJ3I(23)[33]", "BJ3J(23)[33]", "BJ3K(23)[33]", "BJ3L(23)[33]", "BJ6Q[43]", "BJ6R[43]", "BJ6S[43]", "BJ6T[43]", "BJ6U[43]", "BJ6V[43]", "BJ6W[43]", "BJ6X[43]", "BJ7A[43]", "BJ7B[43]", "BJ7C[43]", "BJ7D[43]", "BJ7E[43]", "BJ7F[43]", "BJ7G[43]", "BJ7H[43]", "BJ7Q[43]", "BJ7R[43]", "BJ7S[43]", "BJ7T[43]", "BJ7U[43]", "BJ7V[43]", "BJ7W[43]", "BJ7X[43]", "BJ8A[43]", "BJ8B[43]", "BJ8C[43]", "BJ8D[43]", "BJ8E[43]", "BJ8F[43]", "BJ8G[43]", "BJ8H[43]", "BJ8I[43]", "BJ8J[43]", "BJ8K[43]", "BJ8L[43]", "BJ8M[43]", "BJ8N[43]", "BJ8O[43]", "BJ8P[43]", "BJ8Q[43]", "BJ8R[43]", "BJ8S[43]", "BJ8T[43]", "BJ8U[43]", "BJ8V[43]", "BJ8W[43]", "BJ8X[43]", "BJ9A(24)[43]", "BJ9B(24)[43]", "BJ9C(24)[43]", "BJ9D(24)[43]", "BJ9E(24)[43]", "BJ9F(24)[43]", "BJ9S(23)[42]", "BJ9T(23)[42]", "BJ9U(23)[42]", "BJ9V(23)[42]", "BJ9W(23)[42]", "BJ9X(23)[42]", "BL2A[33]", "BL2B[33]", "BL2C[33]", "BL2D[33]", "BL2E[33]", "BL2F[33]", "BL2G[33]", "BL2H[33]", "BL2I[33]", "BL2J[33]", "BL2K[33]", "BL2L[33]", "BL2M[33]", "BL2N[33]", "BL2O[33]", "BL2P[33]", "BL3G(23)[33]", "BL3H(23)[33]", "BL3I(23)[33]", "BL3J(23)[33]", "BL3K(23)[33]", "BL3L(23)[33]", "BL6Q[43]", "BL6R[43]", "BL6S[43]", "BL6T[43]", "BL6U[43]", "BL6V[43]", "BL6W[43]", "BL6X[43]", "BL7A[43]", "BL7B[43]", "BL7C[43]", "BL7D[43]", "BL7E[43]", "BL7F[43]", "BL7G[43]", "BL7H[43]", "BL7Q[43]", "BL7R[43]", "BL7S[43]", "BL7T[43]", "BL7U[43]", "BL7V[43]", "BL7W[43]", "BL7X[43]", "BL8A[43]", "BL8B[43]", "BL8C[43]", "BL8D[43]", "BL8E[43]", "BL8F[43]", "BL8G[43]", "BL8H[43]", "BL8I[43]", "BL8J[43]", "BL8K[43]", "BL8L[43]", "BL8M[43]", "BL8N[43]", "BL8O[43]", "BL8P[43]", "BL8Q[43]", "BL8R[43]", "BL8S[43]", "BL8T[43]", "BL8U[43]", "BL8V[43]", "BL8W[43]", "BL8X[43]", "BL9A(24)[43]", "BL9B(24)[43]", "BL9C(24)[43]", "BL9D(24)[43]", "BL9E(24)[43]", "BL9F(24)[43]", "BL9S(23)[42]", "BL9T(23)[42]", "BL9U(23)[42]", "BL9V(23)[42]", "BL9W(23)[42]", "BL9X(23)[42]", "BT2A[33]", "BT2B[33]", "BT2C[33]", "BT2D[33]", "BT2E[33]", "BT2F[33]", "BT2G[33]", "BT2H[33]", "BT2I[33]", "BT2J[33]", "BT2K[33]", "BT2L[33]", "BT2M[33]", "BT2N[33]", "BT2O[33]", "BT2P[33]", "BT3G(23)[33]", "BT3H(23)[33]", "BT3I(23)[33]", "BT3J(23)[33]", "BT3K(23)[33]", "BT3L(23)[33]", "BT6Q[43]", "BT6R[43]", "BT6S[43]", "BT6T[43]", "BT6U[43]", "BT6V[43]", "BT6W[43]", "BT6X[43]", "BT7A[43]", "BT7B[43]", "BT7C[43]", "BT7D[43]", "BT7E[43]", "BT7F[43]", "BT7G[43]", "BT7H[43]", "BT7Q[43]", "BT7R[43]", "BT7S[43]", "BT7T[43]", "BT7U[43]", "BT7V[43]", "BT7W[43]", "BT7X[43]", "BT8A[43]", "BT8B[43]", "BT8C[43]", "BT8D[43]", "BT8E[43]", "BT8F[43]", "BT8G[43]", "BT8H[43]", "BT8I[43]", "BT8J[43]", "BT8K[43]", "BT8L[43]", "BT8M[43]", "BT8N[43]", "BT8O[43]", "BT8P[43]", "BT8Q[43]", "BT8R[43]", "BT8S[43]", "BT8T[43]", "BT8U[43]", "BT8V[43]", "BT8W[43]", "BT8X[43]", "BT9A(24)[43]", "BT9B(24)[43]", "BT9C(24)[43]", "BT9D(24)[43]", "BT9E(24)[43]", "BT9F(24)[43]", "BT9S(23)[42]", "BT9T(23)[42]", "BT9U(23)[42]", "BT9V(23)[42]", "BT9W(23)[42]", "BT9X(23)[42]", "BY2A[33]", "BY2B[33]", "BY2C[33]", "BY2D[33]", "BY2E[33]", "BY2F[33]", "BY2G[33]", "BY2H[33]", "BY2I[33]", "BY2J[33]", "BY2K[33]", "BY2L[33]", "BY2M[33]", "BY2N[33]", "BY2O[33]", "BY2P[33]", "BY3G(23)[33]", "BY3H(23)[33]", "BY3I(23)[33]", "BY3J(23)[33]", "BY3K(23)[33]", "BY3L(23)[33]", "BY6Q[43]", "BY6R[43]", "BY6S[43]", "BY6T[43]", "BY6U[43]", "BY6V[43]", "BY6W[43]", "BY6X[43]", "BY7A[43]", "BY7B[43]", "BY7C[43]", "BY7D[43]", "BY7E[43]", "BY7F[43]", "BY7G[43]", "BY7H[43]", "BY7Q[43]", "BY7R[43]", "BY7S[43]", "BY7T[43]", "BY7U[43]", "BY7V[43]", "BY7W[43]", "BY7X[43]", "BY8A[43]", "BY8B[43]", "BY8C[43]", "BY8D[43]", "BY8E[43]", "BY8F[43]", "BY8G[43]", "BY8H[43]", "BY8I[43]", "BY8J[43]", "BY8K[43]", "BY8L[43]", "BY8M[43]", "BY8N[43]", "BY8O[43]", "BY8P[43]", "BY8Q[43]", "BY8R[43]", "BY8S[43]", "BY8T[43]", "BY8U[43]", "BY8V[43]", "BY8W[43]", "BY8X[43]", "BY9A(24)[43]", "BY9B(24)[43]", "BY9C(24)[43]", "BY9D(24)[43]", "BY9E(24)[43]", "BY9F(24)[43]", "BY9S(23)[42]", "BY9T(23)[42]", "BY9U(23)[42]", "BY9V(23)[42]", "BY9W(23)[42]", "BY9X(23)[42]", "BZ2A[33]", "BZ2B[33]", "BZ2C[33]", "BZ2D[33]", "BZ2E[33]", "BZ2F[33]", "BZ2G[33]", "BZ2H[33]", "BZ2I[33]", "BZ2J[33]", "BZ2K[33]", "BZ2L[33]", "BZ2M[33]", "BZ2N[33]", "BZ2O[33]", "BZ2P[33]", "BZ3G(23)[33]", "BZ3H(23)[33]", "BZ3I(23)[33]", "BZ3J(23)[33]", "BZ3K(23)[33]", "BZ3L(23)[33]", "BZ6Q[43]", "BZ6R[43]", "BZ6S[43]", "BZ6T[43]", "BZ6U[43]", "BZ6V[43]", "BZ6W[43]", "BZ6X[43]", "BZ7A[43]", "BZ7B[43]", "BZ7C[43]", "BZ7D[43]", "BZ7E[43]", "BZ7F[43]", "BZ7G[43]", "BZ7H[43]", "BZ7Q[43]", "BZ7R[43]", "BZ7S[43]", "BZ7T[43]", "BZ7U[43]", "BZ7V[43]", "BZ7W[43]", "BZ7X[43]", "BZ8A[43]", "BZ8B[43]", "BZ8C[43]", "BZ8D[43]", "BZ8E[43]", "BZ8F[43]", "BZ8G[43]", "BZ8H[43]", "BZ8I[43]", "BZ8J[43]", "BZ8K[43]", "BZ8L[43]", "BZ8M[43]", "BZ8N[43]", "BZ8O[43]", "BZ8P[43]", "BZ8Q[43]", "BZ8R[43]", "BZ8S[43]", "BZ8T[43]", "BZ8U[43]", "BZ8V[43]", "BZ8W[43]", "BZ8X[43]", "BZ9A(24)[43]", "BZ9B(24)[43]", "BZ9C(24)[43]", "BZ9D(24)[43]", "BZ9E(24)[43]", "BZ9F(24)[43]", "BZ9S(23)[42]", "BZ9T(23)[42]", "BZ9U(23)[42]", "BZ9V(23)[42]", "BZ9W(23)[42]", "BZ9X(23)[42]"},
 		PrefixRegexp: regexp.MustCompile("^[3BX]"),
 	}, {
@@ -744,6 +817,7 @@ var Entities = []Entity{
 		ITUZone:      65,
 		Latitude:     -0.520000,
 		Longitude:    166.920000,
+		UTCOffset:    12,
 		Prefixes:     []string{"C2"},
 		PrefixRegexp: regexp.MustCompile("^[C]"),
 	}, {
@@ -754,6 +828,7 @@ var Entities = []Entity{
 		ITUZone:      27,
 		Latitude:     42.580000,
 		Longitude:    1.620000,
+		UTCOffset:    1,
 		Prefixes:     []string{"C3"},
 		PrefixRegexp: regexp.MustCompile("^[C]"),
 	}, {
@@ -764,6 +839,7 @@ var Entities = []Entity{
 		ITUZone:      46,
 		Latitude:     13.400000,
 		Longitude:    -16.380000,
+		UTCOffset:    0,
 		Prefixes:     []string{"C5"},
 		PrefixRegexp: regexp.MustCompile("^[C]"),
 	}, {
@@ -774,6 +850,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     24.250000,
 		Longitude:    -76.000000,
+		UTCOffset:    -5,
 		Prefixes:     []string{"C6"},
 		PrefixRegexp: regexp.MustCompile("^[C]"),
 	}, {
@@ -784,6 +861,7 @@ var Entities = []Entity{
 		ITUZone:      53,
 		Latitude:     -18.250000,
 		Longitude:    35.000000,
+		UTCOffset:    2,
 		Prefixes:     []string{"C8", "C9"},
 		PrefixRegexp: regexp.MustCompile("^[C]"),
 	}, {
@@ -794,6 +872,7 @@ var Entities = []Entity{
 		ITUZone:      14,
 		Latitude:     -30.000000,
 		Longitude:    -71.000000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"3G", "CA", "CB", "CC", "CD", "CE", "XQ", "XR", "3G7[16]", "3G8[16]", "CA7[16]", "CA8[16]", "CB7[16]", "CB8[16]", "CC7[16]", "CC8[16]", "CD7[16]", "CD8[16]", "CE7[16]", "CE8[16]", "XQ7[16]", "XQ8[16]", "XR7[16]", "XR8[16]", "=CE9/WW3TRG", "=XQ6CFX[16]", "=XQ6OA[16]"},
 		PrefixRegexp: regexp.MustCompile("^[3CX]"),
 	}, {
@@ -804,6 +883,7 @@ var Entities = []Entity{
 		ITUZone:      14,
 		Latitude:     -26.280000,
 		Longitude:    -80.070000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"3G0X", "CA0X", "CB0X", "CC0X", "CD0X", "CE0X", "XQ0X", "XR0X"},
 		PrefixRegexp: regexp.MustCompile("^[3CX]"),
 	}, {
@@ -814,6 +894,7 @@ var Entities = []Entity{
 		ITUZone:      63,
 		Latitude:     -27.100000,
 		Longitude:    -109.370000,
+		UTCOffset:    -6,
 		Prefixes:     []string{"3G0", "CA0", "CB0", "CC0", "CD0", "CE0", "XQ0", "XR0"},
 		PrefixRegexp: regexp.MustCompile("^[3CX]"),
 	}, {
@@ -824,6 +905,7 @@ var Entities = []Entity{
 		ITUZone:      14,
 		Latitude:     -33.600000,
 		Longitude:    -78.850000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"3G0Z", "CA0Z", "CB0Z", "CC0Z", "CD0Z", "CE0I", "CE0Z", "XQ0Z", "XR0Z"},
 		PrefixRegexp: regexp.MustCompile("^[3CX]"),
 	}, {
@@ -834,6 +916,7 @@ var Entities = []Entity{
 		ITUZone:      74,
 		Latitude:     -90.000000,
 		Longitude:    -0.000000,
+		UTCOffset:    0,
 		Prefixes:     []string{"3Y[73]", "AX0(39)[69]", "AY1Z[73]", "AY2Z[73]", "AY3Z[73]", "AY4Z[73]", "AY5Z[73]", "AY6Z[73]", "AY7Z[73]", "AY8Z[73]", "AY9Z[73]", "FT0Y(30)[70]", "FT1Y(30)[70]", "FT2Y(30)[70]", "FT3Y(30)[70]", "FT4Y(30)[70]", "FT5Y(30)[70]", "FT6Y(30)[70]", "FT7Y(30)[70]", "FT8Y(30)[70]", "LU1Z[73]", "LU2Z[73]", "LU3Z[73]", "LU4Z[73]", "LU5Z[73]", "LU6Z[73]", "LU7Z[73]", "LU8Z[73]", "LU9Z[73]", "RI1AN(29)[69]", "VI0(39)[69]", "VK0(39)[69]", "ZL5(30)[71]", "ZM5(30)[71]", "ZS7(38)[67]", "=IA0DC", "=8J1RL(39)[67]", "=DP0GVN(38)[67]", "=DP1POL(38)[67]", "=KC4AAA(39)", "=KC4AAC[73]", "=KC4USV(30)[71]", "=RI1ANC(29)[70]", "=VP8AL[73]"},
 		PrefixRegexp: regexp.MustCompile("^[38ADFIKLRVZ]"),
 	}, {
@@ -844,6 +927,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     21.500000,
 		Longitude:    -80.000000,
+		UTCOffset:    -5,
 		Prefixes:     []string{"CL", "CM", "CO", "T4"},
 		PrefixRegexp: regexp.MustCompile("^[CT]"),
 	}, {
@@ -854,6 +938,7 @@ var Entities = []Entity{
 		ITUZone:      37,
 		Latitude:     32.000000,
 		Longitude:    -5.000000,
+		UTCOffset:    1,
 		Prefixes:     []string{"5C", "5D", "5E", "5F", "5G", "CN"},
 		PrefixRegexp: regexp.MustCompile("^[5C]"),
 	}, {
@@ -864,6 +949,7 @@ var Entities = []Entity{
 		ITUZone:      12,
 		Latitude:     -17.000000,
 		Longitude:    -65.000000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"CP", "CP2[14]", "CP3[14]", "CP4[14]", "CP5[14]", "CP6[14]", "CP7[14]"},
 		PrefixRegexp: regexp.MustCompile("^[C]"),
 	}, {
@@ -874,6 +960,7 @@ var Entities = []Entity{
 		ITUZone:      37,
 		Latitude:     39.500000,
 		Longitude:    -8.000000,
+		UTCOffset:    0,
 		Prefixes:     []string{"CQ", "CR", "CS", "CT"},
 		PrefixRegexp: regexp.MustCompile("^[C]"),
 	}, {
@@ -884,6 +971,7 @@ var Entities = []Entity{
 		ITUZone:      36,
 		Latitude:     32.750000,
 		Longitude:    -16.950000,
+		UTCOffset:    0,
 		Prefixes:     []string{"CQ2", "CQ3", "CQ9", "CR3", "CR9", "CS3", "CS9", "CT3", "CT9"},
 		PrefixRegexp: regexp.MustCompile("^[C]"),
 	}, {
@@ -894,6 +982,7 @@ var Entities = []Entity{
 		ITUZone:      36,
 		Latitude:     38.700000,
 		Longitude:    -27.230000,
+		UTCOffset:    -1,
 		Prefixes:     []string{"CQ1", "CQ8", "CR1", "CR2", "CR8", "CS4", "CS8", "CT8", "CU"},
 		PrefixRegexp: regexp.MustCompile("^[C]"),
 	}, {
@@ -904,6 +993,7 @@ var Entities = []Entity{
 		ITUZone:      14,
 		Latitude:     -33.000000,
 		Longitude:    -56.000000,
+		UTCOffset:    -3,
 		Prefixes:     []string{"CV", "CW", "CX"},
 		PrefixRegexp: regexp.MustCompile("^[C]"),
 	}, {
@@ -914,6 +1004,7 @@ var Entities = []Entity{
 		ITUZone:      9,
 		Latitude:     43.930000,
 		Longitude:    -59.900000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"CY0"},
 		PrefixRegexp: regexp.MustCompile("^[C]"),
 	}, {
@@ -924,6 +1015,7 @@ var Entities = []Entity{
 		ITUZone:      9,
 		Latitude:     47.000000,
 		Longitude:    -60.000000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"CY9"},
 		PrefixRegexp: regexp.MustCompile("^[C]"),
 	}, {
@@ -934,6 +1026,7 @@ var Entities = []Entity{
 		ITUZone:      52,
 		Latitude:     -12.500000,
 		Longitude:    18.500000,
+		UTCOffset:    1,
 		Prefixes:     []string{"D2", "D3"},
 		PrefixRegexp: regexp.MustCompile("^[D]"),
 	}, {
@@ -944,6 +1037,7 @@ var Entities = []Entity{
 		ITUZone:      46,
 		Latitude:     16.000000,
 		Longitude:    -24.000000,
+		UTCOffset:    -1,
 		Prefixes:     []string{"D4"},
 		PrefixRegexp: regexp.MustCompile("^[D]"),
 	}, {
@@ -954,6 +1048,7 @@ var Entities = []Entity{
 		ITUZone:      53,
 		Latitude:     -11.630000,
 		Longitude:    43.300000,
+		UTCOffset:    3,
 		Prefixes:     []string{"D6"},
 		PrefixRegexp: regexp.MustCompile("^[D]"),
 	}, {
@@ -964,6 +1059,7 @@ var Entities = []Entity{
 		ITUZone:      28,
 		Latitude:     51.000000,
 		Longitude:    10.000000,
+		UTCOffset:    1,
 		Prefixes:     []string{"DA", "DB", "DC", "DD", "DE", "DF", "DG", "DH", "DI", "DJ", "DK", "DL", "DM", "DN", "DO", "DP", "DQ", "DR", "Y2", "Y3", "Y4", "Y5", "Y6", "Y7", "Y8", "Y9", "=DM19ERZ/BB", "=DM19ERZ/BEF", "=DM19ERZ/BHF", "=DM19ERZ/BP", "=DM19ERZ/BRB", "=DM19ERZ/HAM", "=DM19ERZ/HSD", "=DM19ERZ/MAZ", "=DM19ERZ/SG"},
 		PrefixRegexp: regexp.MustCompile("^[DY]"),
 	}, {
@@ -974,6 +1070,7 @@ var Entities = []Entity{
 		ITUZone:      50,
 		Latitude:     13.000000,
 		Longitude:    122.000000,
+		UTCOffset:    8,
 		Prefixes:     []string{"4D", "4E", "4F", "4G", "4H", "4I", "DU", "DV", "DW", "DX", "DY", "DZ"},
 		PrefixRegexp: regexp.MustCompile("^[4D]"),
 	}, {
@@ -984,6 +1081,7 @@ var Entities = []Entity{
 		ITUZone:      48,
 		Latitude:     15.000000,
 		Longitude:    39.000000,
+		UTCOffset:    3,
 		Prefixes:     []string{"E3"},
 		PrefixRegexp: regexp.MustCompile("^[E]"),
 	}, {
@@ -994,6 +1092,7 @@ var Entities = []Entity{
 		ITUZone:      39,
 		Latitude:     31.280000,
 		Longitude:    34.270000,
+		UTCOffset:    2,
 		Prefixes:     []string{"E4"},
 		PrefixRegexp: regexp.MustCompile("^[E]"),
 	}, {
@@ -1004,6 +1103,7 @@ var Entities = []Entity{
 		ITUZone:      62,
 		Latitude:     -10.020000,
 		Longitude:    -161.080000,
+		UTCOffset:    -10,
 		Prefixes:     []string{"=E51PT", "=E51WL[63]"},
 		PrefixRegexp: regexp.MustCompile("^[E]"),
 	}, {
@@ -1014,6 +1114,7 @@ var Entities = []Entity{
 		ITUZone:      63,
 		Latitude:     -21.900000,
 		Longitude:    -157.930000,
+		UTCOffset:    -10,
 		Prefixes:     []string{"E5"},
 		PrefixRegexp: regexp.MustCompile("^[E]"),
 	}, {
@@ -1024,6 +1125,7 @@ var Entities = []Entity{
 		ITUZone:      62,
 		Latitude:     -19.030000,
 		Longitude:    -169.850000,
+		UTCOffset:    -11,
 		Prefixes:     []string{"E6"},
 		PrefixRegexp: regexp.MustCompile("^[E]"),
 	}, {
@@ -1034,6 +1136,7 @@ var Entities = []Entity{
 		ITUZone:      28,
 		Latitude:     44.320000,
 		Longitude:    17.570000,
+		UTCOffset:    1,
 		Prefixes:     []string{"E7"},
 		PrefixRegexp: regexp.MustCompile("^[E]"),
 	}, {
@@ -1044,6 +1147,7 @@ var Entities = []Entity{
 		ITUZone:      37,
 		Latitude:     40.370000,
 		Longitude:    -4.880000,
+		UTCOffset:    1,
 		Prefixes:     []string{"AM", "AN", "AO", "EA", "EB", "EC", "ED", "EE", "EF", "EG", "EH", "=AM95WARD", "=EA2AU/URE", "=EA2EZ/P", "=EA2KU/EKA", "=EA3HSD/P", "=EA5CC/P", "=EA5EZ/P", "=EA5ZD/URE", "=EA9AA/7", "=EA9HU"},
 		PrefixRegexp: regexp.MustCompile("^[AE]"),
 	}, {
@@ -1054,6 +1158,7 @@ var Entities = []Entity{
 		ITUZone:      37,
 		Latitude:     39.600000,
 		Longitude:    2.950000,
+		UTCOffset:    1,
 		Prefixes:     []string{"AM6", "AN6", "AO6", "EA6", "EB6", "EC6", "ED6", "EE6", "EF6", "EG6", "EH6"},
 		PrefixRegexp: regexp.MustCompile("^[AE]"),
 	}, {
@@ -1064,6 +1169,7 @@ var Entities = []Entity{
 		ITUZone:      36,
 		Latitude:     28.320000,
 		Longitude:    -15.850000,
+		UTCOffset:    0,
 		Prefixes:     []string{"AM8", "AN8", "AO8", "EA8", "EB8", "EC8", "ED8", "EE8", "EF8", "EG8", "EH8", "=AO5DXX/8", "=EA8VK/URE"},
 		PrefixRegexp: regexp.MustCompile("^[AE]"),
 	}, {
@@ -1074,6 +1180,7 @@ var Entities = []Entity{
 		ITUZone:      37,
 		Latitude:     35.900000,
 		Longitude:    -5.270000,
+		UTCOffset:    1,
 		Prefixes:     []string{"AM9", "AN9", "AO9", "EA9", "EB9", "EC9", "ED9", "EE9", "EF9", "EG9", "EH9"},
 		PrefixRegexp: regexp.MustCompile("^[AE]"),
 	}, {
@@ -1084,6 +1191,7 @@ var Entities = []Entity{
 		ITUZone:      27,
 		Latitude:     53.130000,
 		Longitude:    -8.020000,
+		UTCOffset:    0,
 		Prefixes:     []string{"EI", "EJ"},
 		PrefixRegexp: regexp.MustCompile("^[E]"),
 	}, {
@@ -1094,6 +1202,7 @@ var Entities = []Entity{
 		ITUZone:      29,
 		Latitude:     40.400000,
 		Longitude:    44.900000,
+		UTCOffset:    4,
 		Prefixes:     []string{"EK"},
 		PrefixRegexp: regexp.MustCompile("^[E]"),
 	}, {
@@ -1104,6 +1213,7 @@ var Entities = []Entity{
 		ITUZone:      46,
 		Latitude:     6.500000,
 		Longitude:    -9.500000,
+		UTCOffset:    0,
 		Prefixes:     []string{"5L", "5M", "6Z", "A8", "D5", "EL"},
 		PrefixRegexp: regexp.MustCompile("^[56ADE]"),
 	}, {
@@ -1114,6 +1224,7 @@ var Entities = []Entity{
 		ITUZone:      40,
 		Latitude:     32.000000,
 		Longitude:    53.000000,
+		UTCOffset:    3.5,
 		Prefixes:     []string{"9B", "9C", "9D", "EP", "EQ"},
 		PrefixRegexp: regexp.MustCompile("^[9E]"),
 	}, {
@@ -1124,6 +1235,7 @@ var Entities = []Entity{
 		ITUZone:      29,
 		Latitude:     47.000000,
 		Longitude:    29.000000,
+		UTCOffset:    2,
 		Prefixes:     []string{"ER"},
 		PrefixRegexp: regexp.MustCompile("^[E]"),
 	}, {
@@ -1134,6 +1246,7 @@ var Entities = []Entity{
 		ITUZone:      29,
 		Latitude:     59.000000,
 		Longitude:    25.000000,
+		UTCOffset:    2,
 		Prefixes:     []string{"ES"},
 		PrefixRegexp: regexp.MustCompile("^[E]"),
 	}, {
@@ -1144,6 +1257,7 @@ var Entities = []Entity{
 		ITUZone:      48,
 		Latitude:     9.000000,
 		Longitude:    39.000000,
+		UTCOffset:    3,
 		Prefixes:     []string{"9E", "9F", "ET"},
 		PrefixRegexp: regexp.MustCompile("^[9E]"),
 	}, {
@@ -1154,6 +1268,7 @@ var Entities = []Entity{
 		ITUZone:      29,
 		Latitude:     54.000000,
 		Longitude:    28.000000,
+		UTCOffset:    3,
 		Prefixes:     []string{"EU", "EV", "EW"},
 		PrefixRegexp: regexp.MustCompile("^[E]"),
 	}, {
@@ -1164,6 +1279,7 @@ var Entities = []Entity{
 		ITUZone:      30,
 		Latitude:     41.700000,
 		Longitude:    74.130000,
+		UTCOffset:    6,
 		Prefixes:     []string{"EX", "EX0P[31]", "EX0Q[31]", "EX2P[31]", "EX2Q[31]", "EX6P[31]", "EX6Q[31]", "EX7P[31]", "EX7Q[31]", "EX8P[31]", "EX8Q[31]"},
 		PrefixRegexp: regexp.MustCompile("^[E]"),
 	}, {
@@ -1174,6 +1290,7 @@ var Entities = []Entity{
 		ITUZone:      30,
 		Latitude:     38.820000,
 		Longitude:    71.220000,
+		UTCOffset:    5,
 		Prefixes:     []string{"EY"},
 		PrefixRegexp: regexp.MustCompile("^[E]"),
 	}, {
@@ -1184,6 +1301,7 @@ var Entities = []Entity{
 		ITUZone:      30,
 		Latitude:     38.000000,
 		Longitude:    58.000000,
+		UTCOffset:    5,
 		Prefixes:     []string{"EZ"},
 		PrefixRegexp: regexp.MustCompile("^[E]"),
 	}, {
@@ -1194,6 +1312,7 @@ var Entities = []Entity{
 		ITUZone:      27,
 		Latitude:     46.000000,
 		Longitude:    2.000000,
+		UTCOffset:    1,
 		Prefixes:     []string{"F", "HW", "HX", "HY", "TH", "TM", "TP", "TQ", "TV"},
 		PrefixRegexp: regexp.MustCompile("^[FHT]"),
 	}, {
@@ -1204,6 +1323,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     16.130000,
 		Longitude:    -61.670000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"FG"},
 		PrefixRegexp: regexp.MustCompile("^[F]"),
 	}, {
@@ -1214,6 +1334,7 @@ var Entities = []Entity{
 		ITUZone:      53,
 		Latitude:     -12.880000,
 		Longitude:    45.150000,
+		UTCOffset:    3,
 		Prefixes:     []string{"FH"},
 		PrefixRegexp: regexp.MustCompile("^[F]"),
 	}, {
@@ -1224,6 +1345,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     17.900000,
 		Longitude:    -62.830000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"FJ"},
 		PrefixRegexp: regexp.MustCompile("^[F]"),
 	}, {
@@ -1234,6 +1356,7 @@ var Entities = []Entity{
 		ITUZone:      56,
 		Latitude:     -21.500000,
 		Longitude:    165.500000,
+		UTCOffset:    11,
 		Prefixes:     []string{"FK", "=TX8A"},
 		PrefixRegexp: regexp.MustCompile("^[FT]"),
 	}, {
@@ -1244,6 +1367,7 @@ var Entities = []Entity{
 		ITUZone:      56,
 		Latitude:     -19.870000,
 		Longitude:    158.320000,
+		UTCOffset:    11,
 		Prefixes:     []string{"=TX3X"},
 		PrefixRegexp: regexp.MustCompile("^[T]"),
 	}, {
@@ -1254,6 +1378,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     14.700000,
 		Longitude:    -61.030000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"FM", "=TO5A", "=TO5T", "=TO7A", "=TO7BP"},
 		PrefixRegexp: regexp.MustCompile("^[FT]"),
 	}, {
@@ -1264,6 +1389,7 @@ var Entities = []Entity{
 		ITUZone:      63,
 		Latitude:     -17.650000,
 		Longitude:    -149.400000,
+		UTCOffset:    -10,
 		Prefixes:     []string{"FO"},
 		PrefixRegexp: regexp.MustCompile("^[F]"),
 	}, {
@@ -1274,6 +1400,7 @@ var Entities = []Entity{
 		ITUZone:      63,
 		Latitude:     -23.370000,
 		Longitude:    -149.480000,
+		UTCOffset:    -10,
 		Prefixes:     []string{"=TX2A"},
 		PrefixRegexp: regexp.MustCompile("^[T]"),
 	}, {
@@ -1284,6 +1411,7 @@ var Entities = []Entity{
 		ITUZone:      10,
 		Latitude:     10.280000,
 		Longitude:    -109.220000,
+		UTCOffset:    -8,
 		Prefixes:     []string{"=TX5P"},
 		PrefixRegexp: regexp.MustCompile("^[T]"),
 	}, {
@@ -1294,6 +1422,7 @@ var Entities = []Entity{
 		ITUZone:      63,
 		Latitude:     -8.920000,
 		Longitude:    -140.070000,
+		UTCOffset:    -9.5,
 		Prefixes:     []string{"=FO/F6BCW"},
 		PrefixRegexp: regexp.MustCompile("^[F]"),
 	}, {
@@ -1304,6 +1433,7 @@ var Entities = []Entity{
 		ITUZone:      9,
 		Latitude:     46.770000,
 		Longitude:    -56.200000,
+		UTCOffset:    -3,
 		Prefixes:     []string{"FP"},
 		PrefixRegexp: regexp.MustCompile("^[F]"),
 	}, {
@@ -1314,6 +1444,7 @@ var Entities = []Entity{
 		ITUZone:      53,
 		Latitude:     -21.120000,
 		Longitude:    55.480000,
+		UTCOffset:    4,
 		Prefixes:     []string{"FR", "=TO7DL"},
 		PrefixRegexp: regexp.MustCompile("^[FT]"),
 	}, {
@@ -1324,6 +1455,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     18.080000,
 		Longitude:    -63.030000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"FS"},
 		PrefixRegexp: regexp.MustCompile("^[F]"),
 	}, {
@@ -1334,6 +1466,7 @@ var Entities = []Entity{
 		ITUZone:      53,
 		Latitude:     -11.550000,
 		Longitude:    47.280000,
+		UTCOffset:    3,
 		Prefixes:     []string{"FT0G", "FT1G", "FT2G", "FT3G", "FT4G", "FT5G", "FT6G", "FT7G", "FT8G", "FT9G"},
 		PrefixRegexp: regexp.MustCompile("^[F]"),
 	}, {
@@ -1344,6 +1477,7 @@ var Entities = []Entity{
 		ITUZone:      53,
 		Latitude:     -17.050000,
 		Longitude:    42.720000,
+		UTCOffset:    3,
 		Prefixes:     []string{"FT0E", "FT0J", "FT1E", "FT1J", "FT2E", "FT2J", "FT3E", "FT3J", "FT4E", "FT4J", "FT6E", "FT6J", "FT7E", "FT7J", "FT8E", "FT8J", "FT9E", "FT9J"},
 		PrefixRegexp: regexp.MustCompile("^[F]"),
 	}, {
@@ -1354,6 +1488,7 @@ var Entities = []Entity{
 		ITUZone:      53,
 		Latitude:     -15.880000,
 		Longitude:    54.500000,
+		UTCOffset:    4,
 		Prefixes:     []string{"FT0T", "FT1T", "FT2T", "FT3T", "FT4T", "FT5T", "FT6T", "FT7T", "FT8T", "FT9T"},
 		PrefixRegexp: regexp.MustCompile("^[F]"),
 	}, {
@@ -1364,6 +1499,7 @@ var Entities = []Entity{
 		ITUZone:      68,
 		Latitude:     -46.420000,
 		Longitude:    51.750000,
+		UTCOffset:    4,
 		Prefixes:     []string{"FT0W", "FT4W", "FT5W", "FT8W"},
 		PrefixRegexp: regexp.MustCompile("^[F]"),
 	}, {
@@ -1374,6 +1510,7 @@ var Entities = []Entity{
 		ITUZone:      68,
 		Latitude:     -49.000000,
 		Longitude:    69.270000,
+		UTCOffset:    5,
 		Prefixes:     []string{"FT0X", "FT2X", "FT4X", "FT5X", "FT8X"},
 		PrefixRegexp: regexp.MustCompile("^[F]"),
 	}, {
@@ -1384,6 +1521,7 @@ var Entities = []Entity{
 		ITUZone:      68,
 		Latitude:     -37.850000,
 		Longitude:    77.530000,
+		UTCOffset:    5,
 		Prefixes:     []string{"FT0Z", "FT1Z", "FT2Z", "FT3Z", "FT4Z", "FT5Z", "FT6Z", "FT7Z", "FT8Z"},
 		PrefixRegexp: regexp.MustCompile("^[F]"),
 	}, {
@@ -1394,6 +1532,7 @@ var Entities = []Entity{
 		ITUZone:      62,
 		Latitude:     -13.300000,
 		Longitude:    -176.200000,
+		UTCOffset:    12,
 		Prefixes:     []string{"FW", "TW"},
 		PrefixRegexp: regexp.MustCompile("^[FT]"),
 	}, {
@@ -1404,6 +1543,7 @@ var Entities = []Entity{
 		ITUZone:      12,
 		Latitude:     4.000000,
 		Longitude:    -53.000000,
+		UTCOffset:    -3,
 		Prefixes:     []string{"FY", "=TO1A"},
 		PrefixRegexp: regexp.MustCompile("^[FT]"),
 	}, {
@@ -1414,6 +1554,7 @@ var Entities = []Entity{
 		ITUZone:      27,
 		Latitude:     52.770000,
 		Longitude:    -1.470000,
+		UTCOffset:    0,
 		Prefixes:     []string{"2E", "G", "M", "NHS"},
 		PrefixRegexp: regexp.MustCompile("^[2GMN]"),
 	}, {
@@ -1424,6 +1565,7 @@ var Entities = []Entity{
 		ITUZone:      27,
 		Latitude:     54.200000,
 		Longitude:    -4.530000,
+		UTCOffset:    0,
 		Prefixes:     []string{"2D", "GD", "GT", "MD", "MT"},
 		PrefixRegexp: regexp.MustCompile("^[2GM]"),
 	}, {
@@ -1434,6 +1576,7 @@ var Entities = []Entity{
 		ITUZone:      27,
 		Latitude:     54.730000,
 		Longitude:    -6.680000,
+		UTCOffset:    0,
 		Prefixes:     []string{"2I", "GI", "GN", "MI", "MN", "=GB0BPM", "=GB0GLS", "=GB0PDY", "=GB1HSC", "=GB1SOS", "=GB2PDY", "=GB3NGI", "=GB4NHS", "=GB4UAS", "=GB5NHS", "=GB6SPD", "=GB75VEC", "=GB75VED", "=GB8NHS", "=GB8SJA", "=GB9SPD"},
 		PrefixRegexp: regexp.MustCompile("^[2GM]"),
 	}, {
@@ -1444,6 +1587,7 @@ var Entities = []Entity{
 		ITUZone:      27,
 		Latitude:     49.220000,
 		Longitude:    -2.180000,
+		UTCOffset:    0,
 		Prefixes:     []string{"2J", "GH", "GJ", "MH", "MJ", "=GH5DX/NHS"},
 		PrefixRegexp: regexp.MustCompile("^[2GM]"),
 	}, {
@@ -1454,6 +1598,7 @@ var Entities = []Entity{
 		ITUZone:      27,
 		Latitude:     60.500000,
 		Longitude:    -1.500000,
+		UTCOffset:    0,
 		Prefixes:     []string{"=2M0BDR", "=2M0BDT", "=2M0CPN", "=2M0GFC", "=2M0SEG", "=2M0SPX", "=2M0ZET", "=GB1COR", "=GB2ELH", "=GB3LER", "=GB3LER/B", "=GB4LER", "=GM0AVR", "=GM0CXQ", "=GM0EKM", "=GM0GFL", "=GM0ILB", "=GM0JDB", "=GM1FGN", "=GM1KKI", "=GM1ZNR", "=GM3WHT", "=GM3ZET", "=GM4IPK", "=GM4JPI", "=GM4LBE", "=GM4LER", "=GM4PXG", "=GM4SLV", "=GM4SSA", "=GM4WXQ", "=GM4ZHL", "=GM6RQW", "=GM6YQA", "=GM7AFE", "=GM7GWW", "=GM8LNH", "=GM8MMA", "=GM8YEC", "=GS3ZET", "=MM0LSM", "=MM0NQY", "=MM0VIK", "=MM0XAU", "=MM0ZAL", "=MM0ZCG", "=MM0ZRC", "=MM1FJM", "=MM3VQO", "=MM5PSL", "=MM5YLO", "=MM6BDU", "=MM6BZQ", "=MM6IKB", "=MM6IMB", "=MM6MFA", "=MM6PTE", "=MM6SJK", "=MM6YLO", "=MM6ZBG", "=MM6ZDW", "=MM8A", "=MS0ZCG", "=MS0ZET"},
 		PrefixRegexp: regexp.MustCompile("^[2GM]"),
 	}, {
@@ -1464,6 +1609,7 @@ var Entities = []Entity{
 		ITUZone:      27,
 		Latitude:     56.820000,
 		Longitude:    -4.180000,
+		UTCOffset:    0,
 		Prefixes:     []string{"2A", "2M", "GM", "GS", "MA", "MM", "MS", "=GB0SSB", "=GB1COR", "=GB2ELH", "=GB2FEA", "=GB2JCM", "=GB3ANG", "=GB3LER", "=GB3LER/B", "=GB4LER", "=GM0WED/NHS", "=GM3YDN/NHS", "=GM4SQM/NHS", "=GM4SQN/NHS", "=GM6JNJ/NHS", "=MM0DHQ/NHS", "=MM3AWD/NHS", "=MM3DDQ/NHS", "=MM7WAB/NHS"},
 		PrefixRegexp: regexp.MustCompile("^[2GM]"),
 	}, {
@@ -1474,6 +1620,7 @@ var Entities = []Entity{
 		ITUZone:      27,
 		Latitude:     49.450000,
 		Longitude:    -2.580000,
+		UTCOffset:    0,
 		Prefixes:     []string{"2U", "GP", "GU", "MP", "MU"},
 		PrefixRegexp: regexp.MustCompile("^[2GM]"),
 	}, {
@@ -1484,6 +1631,7 @@ var Entities = []Entity{
 		ITUZone:      27,
 		Latitude:     52.280000,
 		Longitude:    -3.730000,
+		UTCOffset:    0,
 		Prefixes:     []string{"2W", "GC", "GW", "MC", "MW", "=GB0SH", "=GB2IMD", "=GB2TD", "=GB2VK"},
 		PrefixRegexp: regexp.MustCompile("^[2GM]"),
 	}, {
@@ -1494,6 +1642,7 @@ var Entities = []Entity{
 		ITUZone:      51,
 		Latitude:     -9.000000,
 		Longitude:    160.000000,
+		UTCOffset:    11,
 		Prefixes:     []string{"H4"},
 		PrefixRegexp: regexp.MustCompile("^[H]"),
 	}, {
@@ -1504,6 +1653,7 @@ var Entities = []Entity{
 		ITUZone:      51,
 		Latitude:     -10.720000,
 		Longitude:    165.800000,
+		UTCOffset:    11,
 		Prefixes:     []string{"H40"},
 		PrefixRegexp: regexp.MustCompile("^[H]"),
 	}, {
@@ -1514,6 +1664,7 @@ var Entities = []Entity{
 		ITUZone:      28,
 		Latitude:     47.120000,
 		Longitude:    19.280000,
+		UTCOffset:    1,
 		Prefixes:     []string{"HA", "HG"},
 		PrefixRegexp: regexp.MustCompile("^[H]"),
 	}, {
@@ -1524,6 +1675,7 @@ var Entities = []Entity{
 		ITUZone:      28,
 		Latitude:     46.870000,
 		Longitude:    8.120000,
+		UTCOffset:    1,
 		Prefixes:     []string{"HB", "HE"},
 		PrefixRegexp: regexp.MustCompile("^[H]"),
 	}, {
@@ -1534,6 +1686,7 @@ var Entities = []Entity{
 		ITUZone:      28,
 		Latitude:     47.130000,
 		Longitude:    9.570000,
+		UTCOffset:    1,
 		Prefixes:     []string{"HB0", "HE0"},
 		PrefixRegexp: regexp.MustCompile("^[H]"),
 	}, {
@@ -1544,6 +1697,7 @@ var Entities = []Entity{
 		ITUZone:      12,
 		Latitude:     -1.400000,
 		Longitude:    -78.400000,
+		UTCOffset:    -5,
 		Prefixes:     []string{"HC", "HD"},
 		PrefixRegexp: regexp.MustCompile("^[H]"),
 	}, {
@@ -1554,6 +1708,7 @@ var Entities = []Entity{
 		ITUZone:      12,
 		Latitude:     -0.780000,
 		Longitude:    -91.030000,
+		UTCOffset:    -6,
 		Prefixes:     []string{"HC8", "HD8"},
 		PrefixRegexp: regexp.MustCompile("^[H]"),
 	}, {
@@ -1564,6 +1719,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     19.020000,
 		Longitude:    -72.180000,
+		UTCOffset:    -5,
 		Prefixes:     []string{"4V", "HH"},
 		PrefixRegexp: regexp.MustCompile("^[4H]"),
 	}, {
@@ -1574,6 +1730,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     19.130000,
 		Longitude:    -70.680000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"HI"},
 		PrefixRegexp: regexp.MustCompile("^[H]"),
 	}, {
@@ -1584,6 +1741,7 @@ var Entities = []Entity{
 		ITUZone:      12,
 		Latitude:     5.000000,
 		Longitude:    -74.000000,
+		UTCOffset:    -5,
 		Prefixes:     []string{"5J", "5K", "HJ", "HK"},
 		PrefixRegexp: regexp.MustCompile("^[5H]"),
 	}, {
@@ -1594,6 +1752,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     12.550000,
 		Longitude:    -81.720000,
+		UTCOffset:    -5,
 		Prefixes:     []string{"5J0", "5K0", "HJ0", "HK0"},
 		PrefixRegexp: regexp.MustCompile("^[5H]"),
 	}, {
@@ -1604,6 +1763,7 @@ var Entities = []Entity{
 		ITUZone:      12,
 		Latitude:     3.980000,
 		Longitude:    -81.580000,
+		UTCOffset:    -5,
 		Prefixes:     []string{"HJ0M", "HK0M", "=HK0TU"},
 		PrefixRegexp: regexp.MustCompile("^[H]"),
 	}, {
@@ -1614,6 +1774,7 @@ var Entities = []Entity{
 		ITUZone:      44,
 		Latitude:     36.230000,
 		Longitude:    127.900000,
+		UTCOffset:    9,
 		Prefixes:     []string{"6K", "6L", "6M", "6N", "D7", "D8", "D9", "DS", "DT", "HL", "KL9K"},
 		PrefixRegexp: regexp.MustCompile("^[6DHK]"),
 	}, {
@@ -1624,6 +1785,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     9.000000,
 		Longitude:    -80.000000,
+		UTCOffset:    -5,
 		Prefixes:     []string{"3E", "3F", "H3", "H8", "H9", "HO", "HP"},
 		PrefixRegexp: regexp.MustCompile("^[3H]"),
 	}, {
@@ -1634,6 +1796,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     15.000000,
 		Longitude:    -87.000000,
+		UTCOffset:    -6,
 		Prefixes:     []string{"HQ", "HR"},
 		PrefixRegexp: regexp.MustCompile("^[H]"),
 	}, {
@@ -1644,6 +1807,7 @@ var Entities = []Entity{
 		ITUZone:      49,
 		Latitude:     12.600000,
 		Longitude:    99.700000,
+		UTCOffset:    7,
 		Prefixes:     []string{"E2", "HS"},
 		PrefixRegexp: regexp.MustCompile("^[EH]"),
 	}, {
@@ -1654,6 +1818,7 @@ var Entities = []Entity{
 		ITUZone:      28,
 		Latitude:     41.900000,
 		Longitude:    12.470000,
+		UTCOffset:    1,
 		Prefixes:     []string{"HV"},
 		PrefixRegexp: regexp.MustCompile("^[H]"),
 	}, {
@@ -1664,6 +1829,7 @@ var Entities = []Entity{
 		ITUZone:      39,
 		Latitude:     24.200000,
 		Longitude:    43.830000,
+		UTCOffset:    3,
 		Prefixes:     []string{"7Z", "8Z", "HZ"},
 		PrefixRegexp: regexp.MustCompile("^[78H]"),
 	}, {
@@ -1674,6 +1840,7 @@ var Entities = []Entity{
 		ITUZone:      28,
 		Latitude:     42.820000,
 		Longitude:    12.580000,
+		UTCOffset:    1,
 		Prefixes:     []string{"I", "=4U0WFP", "=4U25B", "=4U5F", "=IK8IJN/I/US"},
 		PrefixRegexp: regexp.MustCompile("^[4I]"),
 	}, {
@@ -1684,6 +1851,7 @@ var Entities = []Entity{
 		ITUZone:      37,
 		Latitude:     35.670000,
 		Longitude:    12.670000,
+		UTCOffset:    1,
 		Prefixes:     []string{"IG9", "IH9"},
 		PrefixRegexp: regexp.MustCompile("^[I]"),
 	}, {
@@ -1694,6 +1862,7 @@ var Entities = []Entity{
 		ITUZone:      28,
 		Latitude:     40.150000,
 		Longitude:    9.270000,
+		UTCOffset:    1,
 		Prefixes:     []string{"IM0", "IS", "IW0U", "IW0V", "IW0W", "IW0X", "IW0Y", "IW0Z", "=II0ICH", "=II0IDP", "=II0M", "=IQ0AG", "=IQ0AH", "=IQ0AI", "=IQ0AK", "=IQ0AL", "=IQ0AM", "=IQ0EH", "=IQ0HO", "=IQ0ID", "=IQ0ID/P", "=IQ0JY", "=IQ0NU", "=IQ0NU/P", "=IQ0NV", "=IQ0NV/P", "=IQ0OG", "=IQ0OH", "=IQ0QP", "=IQ0SS", "=IY0GA"},
 		PrefixRegexp: regexp.MustCompile("^[I]"),
 	}, {
@@ -1704,6 +1873,7 @@ var Entities = []Entity{
 		ITUZone:      28,
 		Latitude:     37.500000,
 		Longitude:    14.000000,
+		UTCOffset:    1,
 		Prefixes:     []string{"IB9", "ID9", "IE9", "IF9", "II9", "IJ9", "IO9", "IQ9", "IR9", "IT9", "IU9", "IW9", "IY9"},
 		PrefixRegexp: regexp.MustCompile("^[I]"),
 	}, {
@@ -1714,6 +1884,7 @@ var Entities = []Entity{
 		ITUZone:      48,
 		Latitude:     11.750000,
 		Longitude:    42.350000,
+		UTCOffset:    3,
 		Prefixes:     []string{"J2"},
 		PrefixRegexp: regexp.MustCompile("^[J]"),
 	}, {
@@ -1724,6 +1895,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     12.130000,
 		Longitude:    -61.680000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"J3"},
 		PrefixRegexp: regexp.MustCompile("^[J]"),
 	}, {
@@ -1734,6 +1906,7 @@ var Entities = []Entity{
 		ITUZone:      46,
 		Latitude:     12.020000,
 		Longitude:    -14.800000,
+		UTCOffset:    0,
 		Prefixes:     []string{"J5"},
 		PrefixRegexp: regexp.MustCompile("^[J]"),
 	}, {
@@ -1744,6 +1917,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     13.870000,
 		Longitude:    -61.000000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"J6"},
 		PrefixRegexp: regexp.MustCompile("^[J]"),
 	}, {
@@ -1754,6 +1928,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     15.430000,
 		Longitude:    -61.350000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"J7"},
 		PrefixRegexp: regexp.MustCompile("^[J]"),
 	}, {
@@ -1764,6 +1939,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     13.230000,
 		Longitude:    -61.200000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"J8"},
 		PrefixRegexp: regexp.MustCompile("^[J]"),
 	}, {
@@ -1774,6 +1950,7 @@ var Entities = []Entity{
 		ITUZone:      45,
 		Latitude:     36.400000,
 		Longitude:    138.380000,
+		UTCOffset:    9,
 		Prefixes:     []string{"7J", "7K", "7L", "7M", "7N", "8J", "8K", "8L", "8M", "8N", "JA", "JE", "JF", "JG", "JH", "JI", "JJ", "JK", "JL", "JM", "JN", "JO", "JP", "JQ", "JR", "JS"},
 		PrefixRegexp: regexp.MustCompile("^[78J]"),
 	}, {
@@ -1784,6 +1961,7 @@ var Entities = []Entity{
 		ITUZone:      90,
 		Latitude:     24.280000,
 		Longitude:    153.970000,
+		UTCOffset:    10,
 		Prefixes:     []string{"=JG8NQJ/JD1"},
 		PrefixRegexp: regexp.MustCompile("^[J]"),
 	}, {
@@ -1794,6 +1972,7 @@ var Entities = []Entity{
 		ITUZone:      45,
 		Latitude:     27.050000,
 		Longitude:    142.200000,
+		UTCOffset:    10,
 		Prefixes:     []string{"JD1"},
 		PrefixRegexp: regexp.MustCompile("^[J]"),
 	}, {
@@ -1804,6 +1983,7 @@ var Entities = []Entity{
 		ITUZone:      32,
 		Latitude:     46.770000,
 		Longitude:    102.170000,
+		UTCOffset:    8,
 		Prefixes:     []string{"JT", "JU", "JV", "JT2[33]", "JT3[33]", "JU2[33]", "JU3[33]", "JV2[33]", "JV3[33]"},
 		PrefixRegexp: regexp.MustCompile("^[J]"),
 	}, {
@@ -1814,6 +1994,7 @@ var Entities = []Entity{
 		ITUZone:      18,
 		Latitude:     78.000000,
 		Longitude:    16.000000,
+		UTCOffset:    1,
 		Prefixes:     []string{"JW"},
 		PrefixRegexp: regexp.MustCompile("^[J]"),
 	}, {
@@ -1824,6 +2005,7 @@ var Entities = []Entity{
 		ITUZone:      18,
 		Latitude:     74.430000,
 		Longitude:    19.080000,
+		UTCOffset:    1,
 		Prefixes:     []string{"=JW/LB2PG"},
 		PrefixRegexp: regexp.MustCompile("^[J]"),
 	}, {
@@ -1834,6 +2016,7 @@ var Entities = []Entity{
 		ITUZone:      18,
 		Latitude:     71.050000,
 		Longitude:    -8.280000,
+		UTCOffset:    1,
 		Prefixes:     []string{"JX"},
 		PrefixRegexp: regexp.MustCompile("^[J]"),
 	}, {
@@ -1844,6 +2027,7 @@ var Entities = []Entity{
 		ITUZone:      39,
 		Latitude:     31.180000,
 		Longitude:    36.420000,
+		UTCOffset:    3,
 		Prefixes:     []string{"JY"},
 		PrefixRegexp: regexp.MustCompile("^[J]"),
 	}, {
@@ -1854,6 +2038,7 @@ var Entities = []Entity{
 		ITUZone:      8,
 		Latitude:     37.530000,
 		Longitude:    -91.670000,
+		UTCOffset:    -5,
 		Prefixes:     []string{"AA", "AB", "AC", "AD", "AE", "AF", "AG", "AI", "AJ", "AK", "K", "N", "W", "=AH3D", "=4U1WB(5)[8]", "=AH0AB(3)[6]", "=AH0AJ(4)[8]", "=AH0BR(5)[8]", "=AH0BV(5)[8]", "=AH0BZ(5)[8]", "=AH0C(3)[6]", "=AH0CN(3)[6]", "=AH0G(5)[8]", "=AH0U(3)[6]", "=AH0W(3)[6]", "=AH2A(3)[6]", "=AH2AJ(5)[8]", "=AH2AK(3)[6]", "=AH2AP(3)[6]", "=AH2AQ(4)[7]", "=AH2AR(4)[8]", "=AH2AV(4)[8]", "=AH2AZ(4)[7]", "=AH2BG(4)[8]", "=AH2BW(4)[7]", "=AH2BY(4)[7]", "=AH2CF(4)[8]", "=AH2DF(5)[8]", "=AH2DG(4)[7]", "=AH2DP(3)[6]", "=AH2DS(3)[6]", "=AH2DY(3)[6]", "=AH2EB(5)[8]", "=AH2EH(4)[7]", "=AH2H(4)[7]", "=AH2O(5)[8]", "=AH2S(3)[6]", "=AH2T(4)[7]", "=AH2V(5)[8]", "=AH2W(5)[8]", "=AH2X(5)[8]", "=AH6AF(4)[7]", "=AH6AL(5)[8]", "=AH6AO(4)[8]", "=AH6AT(5)[8]", "=AH6AU(5)[8]", "=AH6AX(5)[8]", "=AH6BJ(5)[8]", "=AH6BS(3)[6]", "=AH6BV(5)[8]", "=AH6C(5)[8]", "=AH6CY(3)[6]", "=AH6D(3)[6]", "=AH6DZ(4)[7]", "=AH6ES(4)[8]", "=AH6ET(3)[6]", "=AH6EZ(3)[6]", "=AH6FF(4)[8]", "=AH6FV(4)[7]", "=AH6FX(5)[8]", "=AH6FY(4)[7]", "=AH6GA(3)[6]", "=AH6HE(3)[6]", "=AH6HK(3)[6]", "=AH6HR(4)[8]", "=AH6HS(3)[6]", "=AH6HT(4)[7]", "=AH6I(3)[6]", "=AH6IJ(5)[8]", "=AH6IW(5)[8]", "=AH6JH(5)[8]", "=AH6JS(3)[6]", "=AH6K(5)[8]", "=AH6KB(4)[8]", "=AH6KG(3)[6]", "=AH6KS(5)[8]", "=AH6KT(5)[8]", "=AH6LE(3)[6]", "=AH6LS(5)[8]", "=AH6MD(4)[7]", "=AH6ML(3)[6]", "=AH6MQ(4)[8]", "=AH6N(4)[7]", "=AH6NL(3)[6]", "=AH6NP(3)[6]", "=AH6NR(3)[6]", "=AH6O(4)[7]", "=AH6OD(3)[6]", "=AH6OM(4)[8]", "=AH6OS(4)[7]", "=AH6OU(4)[7]", "=AH6PD(3)[6]", "=AH6PJ(3)[6]", "=AH6PW(3)[6]", "=AH6R(5)[8]", "=AH6RB(4)[7]", "=AH6S(3)[6]", "=AH6SU(3)[6]", "=AH6SV(3)[6]", "=AH6TS(4)[7]", "=AH6TX(3)[6]", "=AH6UD(4)[7]", "=AH6UK(3)[6]", "=AH6UN(3)[6]", "=AH6UX(3)[6]", "=AH6VM(3)[6]", "=AH6VP(3)[6]", "=AH6VQ(4)[7]", "=AH6Y(3)[6]", "=AH6YL(4)[8]", "=AH6Z(5)[8]", "=AH7A(3)[6]", "=AH7D(3)[6]", "=AH7DN(5)[8]", "=AH7F(3)[6]", "=AH7I(5)[8]", "=AH7J(5)[8]", "=AH7Q(3)[6]", "=AH8AC(3)[6]", "=AH8B(5)[8]", "=AH8C(3)[6]", "=AH8DX(3)[6]", "=AH8K(3)[6]", "=AH8M(5)[8]", "=AH8O(4)[7]", "=AH8P(5)[8]", "=AH9A(3)[6]", "=AH9B(4)[7]", "=AH9C(3)[6]", "=AL0A(5)[8]", "=AL0AA(3)[6]", "=AL0F(3)[6]", "=AL0FT(3)[6]", "=AL0G(4)[7]", "=AL0H(3)[6]", "=AL0I(5)[8]", "=AL0Q(5)[8]", "=AL0X(3)[6]", "=AL0Y(5)[8]", "=AL1A(5)[8]", "=AL1B(5)[8]", "=AL1CE(4)[8]", "=AL1F(4)[7]", "=AL1N(3)[6]", "=AL1O(5)[8]", "=AL1P(3)[6]", "=AL1VE(3)[6]", "=AL2B(3)[6]", "=AL2C(4)[8]", "=AL2F(4)[8]", "=AL2G(5)[8]", "=AL2N(3)[6]", "=AL2O(5)[8]", "=AL2S(4)[7]", "=AL3A(3)[6]", "=AL3E(4)[7]", "=AL3G(5)[8]", "=AL3L(3)[6]", "=AL3M(5)[8]", "=AL3V(4)[7]", "=AL4B(4)[8]", "=AL4E(4)[8]", "=AL4F(4)[7]", "=AL4R(3)[6]", "=AL4U(5)[8]", "=AL4V(5)[8]", "=AL4X(5)[8]", "=AL5A(5)[8]", "=AL5B(3)[6]", "=AL5J(4)[7]", "=AL5W(3)[6]", "=AL7A(3)[6]", "=AL7AA(3)[6]", "=AL7AB(5)[8]", "=AL7AH(4)[8]", "=AL7AK(4)[8]", "=AL7AL(5)[8]", "=AL7AM(5)[8]", "=AL7AN(3)[6]", "=AL7AU(4)[7]", "=AL7AW(3)[6]", "=AL7BA(5)[8]", "=AL7BN(3)[6]", "=AL7BQ(3)[6]", "=AL7BT(4)[8]", "=AL7BX(4)[7]", "=AL7C(4)[7]", "=AL7CC(3)[6]", "=AL7CG(3)[6]", "=AL7CJ(4)[7]", "=AL7CQ(4)[7]", "=AL7CR(3)[6]", "=AL7CS(3)[6]", "=AL7CV(4)[8]", "=AL7CX(4)[8]", "=AL7D(3)[6]", "=AL7DD(3)[6]", "=AL7DF(4)[7]", "=AL7DQ(3)[6]", "=AL7DR(4)[7]", "=AL7DS(4)[8]", "=AL7DU(3)[6]", "=AL7EI(3)[6]", "=AL7EK(4)[7]", "=AL7EL(5)[8]", "=AL7EM(3)[6]", "=AL7EP(3)[6]", "=AL7EU(4)[8]", "=AL7EW(3)[6]", "=AL7FA(3)[6]", "=AL7FB(3)[6]", "=AL7FN(3)[6]", "=AL7FU(4)[7]", "=AL7FZ(3)[6]", "=AL7GD(5)[8]", "=AL7GF(5)[8]", "=AL7GI(4)[8]", "=AL7GK(5)[8]", "=AL7GQ(4)[7]", "=AL7GS(3)[6]", "=AL7GY(4)[7]", "=AL7HD(3)[6]", "=AL7HG(5)[8]", "=AL7HH(4)[7]", "=AL7HS(3)[6]", "=AL7HU(4)[7]", "=AL7HW(5)[8]", "=AL7HY(3)[6]", "=AL7IG(3)[6]", "=AL7IH(4)[7]", "=AL7IM(4)[7]", "=AL7IT(3)[6]", "=AL7J(4)[7]", "=AL7JJ(3)[6]", "=AL7JN(4)[8]", "=AL7JP(4)[7]", "=AL7JS(3)[6]", "=AL7JU(3)[6]", "=AL7JV(3)[6]", "=AL7JW(3)[6]", "=AL7KE(3)[6]", "=AL7KF(3)[6]", "=AL7KG(3)[6]", "=AL7KK(3)[6]", "=AL7KN(4)[8]", "=AL7KT(5)[8]", "=AL7KV(3)[6]", "=AL7LH(5)[8]", "=AL7LI(3)[6]", "=AL7LP(4)[8]", "=AL7LV(5)[8]", "=AL7MH(3)[6]", "=AL7MQ(3)[6]", "=AL7MR(4)[8]", "=AL7ND(3)[6]", "=AL7NI(4)[7]", "=AL7NK(3)[6]", "=AL7NM(5)[8]", "=AL7NN(5)[8]", "=AL7NS(5)[8]", "=AL7NY(4)[7]", "=AL7NZ(3)[6]", "=AL7OC(4)[7]", "=AL7OL(4)[8]", "=AL7OP(4)[8]", "=AL7OW(3)[6]", "=AL7PB(4)[7]", "=AL7PL(5)[8]", "=AL7PM(4)[8]", "=AL7PR(3)[6]", "=AL7PS(3)[6]", "=AL7PV(3)[6]", "=AL7QI(5)[8]", "=AL7QL(3)[6]", "=AL7QO(4)[8]", "=AL7QQ(4)[7]", "=AL7QR(3)[6]", "=AL7QS(5)[8]", "=AL7R(3)[6]", "=AL7RD(4)[7]", "=AL7RE(5)[8]", "=AL7RF(3)[6]", "=AL7RG(5)[8]", "=AL7RH(4)[8]", "=AL7RI(4)[7]", "=AL7RM(3)[6]", "=AL7RR(3)[6]", "=AL7RS(5)[8]", "=AL7RT(4)[7]", "=AL7V(4)[7]", "=AL7W(3)[6]", "=AL9DB(4)[7]", "=G8ERJ(5)[8]", "=KH0AS(3)[6]", "=KH0BE(4)[8]", "=KH0BR(3)[6]", "=KH0BU(3)[6]", "=KH0BZ(4)[7]", "=KH0CA(3)[6]", "=KH0CG(3)[6]", "=KH0CU(4)[7]", "=KH0CW(5)[8]", "=KH0DH(3)[6]", "=KH0DJ(3)[6]", "=KH0DW(4)[7]", "=KH0EX(4)[7]", "=KH0H(3)[6]", "=KH0HQ(3)[6]", "=KH0HR(5)[8]", "=KH0JJ(3)[6]", "=KH0K(3)[6]", "=KH0NI(5)[8]", "=KH0SH(3)[6]", "=KH0UN(4)[8]", "=KH0V(3)[6]", "=KH0X(3)[6]", "=KH0XD(3)[6]", "=KH0ZZ(5)[8]", "=KH2AB(5)[8]", "=KH2AI(4)[7]", "=KH2AP(4)[8]", "=KH2AR(4)[8]", "=KH2BA(5)[8]", "=KH2BD(3)[6]", "=KH2BI(3)[6]", "=KH2BR(3)[6]", "=KH2BX(5)[8]", "=KH2C(3)[6]", "=KH2CH(3)[6]", "=KH2CW(5)[8]", "=KH2CZ(4)[7]", "=KH2D(5)[8]", "=KH2DF(4)[7]", "=KH2DN(4)[8]", "=KH2EE(3)[6]", "=KH2EI(5)[8]", "=KH2FI(3)[6]", "=KH2G(3)[6]", "=KH2GG(3)[6]", "=KH2GM(5)[8]", "=KH2H(3)[6]", "=KH2IW(3)[6]", "=KH2JA(3)[6]", "=KH2JK(4)[7]", "=KH2JX(5)[8]", "=KH2KD(5)[8]", "=KH2LU(3)[6]", "=KH2LZ(3)[6]", "=KH2NC(5)[8]", "=KH2OJ(3)[6]", "=KH2OP(4)[7]", "=KH2P(5)[8]", "=KH2PM(5)[8]", "=KH2QE(3)[6]", "=KH2QH(3)[6]", "=KH2QL(3)[6]", "=KH2QY(3)[6]", "=KH2R(5)[8]", "=KH2RK(3)[6]", "=KH2RL(5)[8]", "=KH2RP(4)[8]", "=KH2SK(3)[6]", "=KH2SL(4)[7]", "=KH2SR(3)[6]", "=KH2SX(5)[8]", "=KH2TB(4)[7]", "=KH2TI(5)[8]", "=KH2TJ(3)[6]", "=KH2UG(5)[8]", "=KH2UV(5)[8]", "=KH2UZ(5)[8]", "=KH2XD(4)[7]", "=KH2XO(4)[7]", "=KH2XP(3)[6]", "=KH2XW(3)[6]", "=KH2YJ(3)[6]", "=KH2YL(3)[6]", "=KH2YO(4)[7]", "=KH2Z(3)[6]", "=KH2ZM(3)[6]", "=KH3AC(5)[8]", "=KH3AD(3)[6]", "=KH3AG(5)[8]", "=KH4AF(4)[8]", "=KH4AG(5)[8]", "=KH6AB(3)[6]", "=KH6ABA(4)[7]", "=KH6AE(5)[8]", "=KH6AHQ(3)[6]", "=KH6ALN(5)[8]", "=KH6AME(5)[8]", "=KH6ARA(3)[6]", "=KH6AS(3)[6]", "=KH6BMD(3)[6]", "=KH6BRY(3)[6]", "=KH6BXZ(3)[6]", "=KH6CA(4)[7]", "=KH6CN(3)[6]", "=KH6COL(3)[6]", "=KH6COY(3)[6]", "=KH6CQG(3)[6]", "=KH6CQH(3)[6]", "=KH6CT(5)[8]", "=KH6CUJ(5)[8]", "=KH6DAN(4)[7]", "=KH6DB(3)[6]", "=KH6DDW(3)[6]", "=KH6DHK(4)[8]", "=KH6DM(4)[7]", "=KH6DOT(3)[6]", "=KH6DUT(3)[6]", "=KH6DZ(3)[6]", "=KH6EAM(3)[6]", "=KH6ED(5)[8]", "=KH6EE(3)[6]", "=KH6EHF(3)[6]", "=KH6EO(4)[8]", "=KH6FH(3)[6]", "=KH6FL(3)[6]", "=KH6FOX(3)[6]", "=KH6FQR(3)[6]", "=KH6FQY(3)[6]", "=KH6FU(3)[6]", "=KH6GB(3)[6]", "=KH6GBQ(3)[6]", "=KH6GC(3)[6]", "=KH6GDN(3)[6]", "=KH6GGC(4)[7]", "=KH6GJV(3)[6]", "=KH6GK(3)[6]", "=KH6GKR(3)[6]", "=KH6GN(4)[7]", "=KH6GR(5)[8]", "=KH6GRG(5)[8]", "=KH6HFO(5)[8]", "=KH6HJE(3)[6]", "=KH6HNL(4)[7]", "=KH6HO(5)[8]", "=KH6HOU(3)[6]", "=KH6HOW(5)[8]", "=KH6HPQ(4)[7]", "=KH6HTV(4)[7]", "=KH6HU(3)[6]", "=KH6HZ(5)[8]", "=KH6IA(3)[6]", "=KH6ICQ(3)[6]", "=KH6IDF(4)[7]", "=KH6II(4)[7]", "=KH6IK(4)[8]", "=KH6IKC(3)[6]", "=KH6IKH(3)[6]", "=KH6IKI(5)[8]", "=KH6IKL(3)[6]", "=KH6ILT(4)[8]", "=KH6IMN(3)[6]", "=KH6IPJ(3)[6]", "=KH6IQX(3)[6]", "=KH6ITI(5)[8]", "=KH6ITY(3)[6]", "=KH6JAU(5)[8]", "=KH6JCT(3)[6]", "=KH6JEM(4)[7]", "=KH6JFH(4)[7]", "=KH6JFL(3)[6]", "=KH6JGA(5)[8]", "=KH6JGD(3)[6]", "=KH6JIM(5)[8]", "=KH6JIQ(4)[7]", "=KH6JJD(5)[8]", "=KH6JJN(3)[6]", "=KH6JKQ(5)[8]", "=KH6JN(3)[6]", "=KH6JNK(3)[6]", "=KH6JNW(5)[8]", "=KH6JNY(4)[8]", "=KH6JPJ(3)[6]", "=KH6JPO(3)[6]", "=KH6JQW(4)[8]", "=KH6JR(3)[6]", "=KH6JRB(3)[6]", "=KH6JRC(3)[6]", "=KH6JRW(3)[6]", "=KH6JS(3)[6]", "=KH6JT(3)[6]", "=KH6JTE(4)[7]", "=KH6JTM(4)[7]", "=KH6JUA(5)[8]", "=KH6JUK(5)[8]", "=KH6JUQ(3)[6]", "=KH6JUZ(3)[6]", "=KH6JVF(4)[7]", "=KH6JVL(4)[7]", "=KH6JVS(3)[6]", "=KH6JWG(3)[6]", "=KH6KI(4)[8]", "=KH6KS(3)[6]", "=KH6KT(3)[6]", "=KH6KW(3)[6]", "=KH6KZ(5)[8]", "=KH6LEM(3)[6]", "=KH6LO(3)[6]", "=KH6LX(4)[7]", "=KH6M(5)[8]", "=KH6M/4(5)[8]", "=KH6ME(3)[6]", "=KH6MF(3)[6]", "=KH6MT(5)[8]", "=KH6MV(3)[6]", "=KH6N(3)[6]", "=KH6NA(3)[6]", "=KH6NC(5)[8]", "=KH6NG(3)[6]", "=KH6NI(5)[8]", "=KH6NM(4)[7]", "=KH6NR(4)[7]", "=KH6NU(3)[6]", "=KH6O(3)[6]", "=KH6OE(4)[8]", "=KH6OU(5)[8]", "=KH6OV(3)[6]", "=KH6OY(4)[7]", "=KH6OZ(4)[7]", "=KH6PG(3)[6]", "=KH6PM(3)[6]", "=KH6PR(3)[6]", "=KH6PX(5)[8]", "=KH6QAI(3)[6]", "=KH6QAJ(3)[6]", "=KH6RD(4)[8]", "=KH6RF(5)[8]", "=KH6RP(5)[8]", "=KH6RW(3)[6]", "=KH6SB(4)[7]", "=KH6SKY(4)[8]", "=KH6SM(4)[8]", "=KH6SS(3)[6]", "=KH6SZ(4)[7]", "=KH6TG(4)[7]", "=KH6TL(4)[7]", "=KH6TO(3)[6]", "=KH6TX(3)[6]", "=KH6TY(5)[8]", "=KH6UC(4)[7]", "=KH6UN(5)[8]", "=KH6UQ(3)[6]", "=KH6USA(3)[6]", "=KH6UX(4)[8]", "=KH6VC(3)[6]", "=KH6VHF(4)[7]", "=KH6VM(3)[6]", "=KH6VO(4)[7]", "=KH6VT(3)[6]", "=KH6VZ(3)[6]", "=KH6WL(3)[6]", "=KH6WX(3)[6]", "=KH6WZ(3)[6]", "=KH6XH(5)[8]", "=KH6XS(3)[6]", "=KH6YL(3)[6]", "=KH7AL(3)[6]", "=KH7AX(3)[6]", "=KH7BU(4)[7]", "=KH7CB(3)[6]", "=KH7CF(4)[7]", "=KH7CM(3)[6]", "=KH7CO(3)[6]", "=KH7CS(3)[6]", "=KH7CZ(3)[6]", "=KH7DK(4)[8]", "=KH7DM(5)[8]", "=KH7DR(4)[8]", "=KH7EI(4)[8]", "=KH7EM(3)[6]", "=KH7FC(5)[8]", "=KH7FJ(3)[6]", "=KH7FR(3)[6]", "=KH7FU(5)[8]", "=KH7GA(5)[8]", "=KH7GF(4)[7]", "=KH7GM(5)[8]", "=KH7HA(4)[7]", "=KH7HH(3)[6]", "=KH7HY(4)[7]", "=KH7I(3)[6]", "=KH7IC(4)[7]", "=KH7IP(3)[6]", "=KH7IZ(3)[6]", "=KH7JM(4)[8]", "=KH7JO(5)[8]", "=KH7JR(3)[6]", "=KH7LE(3)[6]", "=KH7ME(3)[6]", "=KH7MR(3)[6]", "=KH7MX(5)[8]", "=KH7NE(5)[8]", "=KH7NI(3)[6]", "=KH7NP(3)[6]", "=KH7NS(3)[6]", "=KH7OC(5)[8]", "=KH7OV(5)[8]", "=KH7OX(4)[7]", "=KH7PL(5)[8]", "=KH7QI(4)[7]", "=KH7QJ(4)[7]", "=KH7QL(4)[7]", "=KH7QO(4)[7]", "=KH7QS(3)[6]", "=KH7QU(3)[6]", "=KH7R(3)[6]", "=KH7RB(3)[6]", "=KH7RD(3)[6]", "=KH7RT(3)[6]", "=KH7SP(4)[8]", "=KH7SQ(3)[6]", "=KH7SR(3)[6]", "=KH7TJ(3)[6]", "=KH7TR(3)[6]", "=KH7TW(3)[6]", "=KH7VB(3)[6]", "=KH7VC(3)[6]", "=KH7VD(3)[6]", "=KH7VE(3)[6]", "=KH7WK(5)[8]", "=KH7WN(3)[6]", "=KH7WO(3)[6]", "=KH7WP(3)[6]", "=KH7WR(3)[6]", "=KH7WS(3)[6]", "=KH7XT(5)[8]", "=KH7Y(3)[6]", "=KH7YD(3)[6]", "=KH7ZC(5)[8]", "=KH7ZT(4)[8]", "=KH8AB(3)[6]", "=KH8AC(5)[8]", "=KH8AF(3)[6]", "=KH8AH(3)[6]", "=KH8BG(3)[6]", "=KH8CG(4)[7]", "=KH8CN(5)[8]", "=KH8CW(4)[7]", "=KH8DO(5)[8]", "=KH8E(3)[6]", "=KH8FL(3)[6]", "=KH8U(5)[8]", "=KH8ZK(5)[8]", "=KH9AE(4)[7]", "=KL0AA(3)[6]", "=KL0AF(3)[6]", "=KL0AG(5)[8]", "=KL0AH(4)[8]", "=KL0AI(3)[6]", "=KL0AL(3)[6]", "=KL0AN(3)[6]", "=KL0AP(3)[6]", "=KL0CA(3)[6]", "=KL0CM(3)[6]", "=KL0CP(4)[8]", "=KL0CW(3)[6]", "=KL0DF(3)[6]", "=KL0DG(3)[6]", "=KL0DN(4)[8]", "=KL0DR(3)[6]", "=KL0DT(3)[6]", "=KL0DW(4)[7]", "=KL0EQ(4)[7]", "=KL0ET(4)[8]", "=KL0EU(3)[6]", "=KL0EX(4)[7]", "=KL0EY(4)[8]", "=KL0FF(4)[8]", "=KL0FOX(4)[7]", "=KL0GI(4)[8]", "=KL0GP(4)[7]", "=KL0GQ(4)[7]", "=KL0HU(4)[7]", "=KL0HZ(3)[6]", "=KL0IF(3)[6]", "=KL0IP(5)[8]", "=KL0IR(3)[6]", "=KL0IS(3)[6]", "=KL0IW(3)[6]", "=KL0IX(3)[6]", "=KL0L(5)[8]", "=KL0LB(4)[8]", "=KL0LF(3)[6]", "=KL0LN(4)[8]", "=KL0MG(5)[8]", "=KL0MO(3)[6]", "=KL0MP(5)[8]", "=KL0MW(4)[7]", "=KL0N(4)[7]", "=KL0NM(3)[6]", "=KL0NP(3)[6]", "=KL0NP/P(3)[6]", "=KL0NR(4)[8]", "=KL0NT(4)[7]", "=KL0NV(4)[7]", "=KL0NY(4)[8]", "=KL0PC(3)[6]", "=KL0PD(4)[8]", "=KL0PG(4)[7]", "=KL0PM(4)[8]", "=KL0PP(3)[6]", "=KL0QD(3)[6]", "=KL0RA(3)[6]", "=KL0RN(4)[7]", "=KL0S(5)[8]", "=KL0SA(3)[6]", "=KL0SS(5)[8]", "=KL0ST(5)[8]", "=KL0SV(4)[7]", "=KL0SZ(3)[6]", "=KL0TF(4)[7]", "=KL0TR(3)[6]", "=KL0TU(3)[6]", "=KL0TV(5)[8]", "=KL0UA(5)[8]", "=KL0UD(5)[8]", "=KL0UP(4)[7]", "=KL0VB(3)[6]", "=KL0VD(5)[8]", "=KL0VE(5)[8]", "=KL0VH(4)[8]", "=KL0VM(4)[7]", "=KL0VU(5)[8]", "=KL0VZ(3)[6]", "=KL0WF(5)[8]", "=KL0WIZ(4)[7]", "=KL0WV(5)[8]", "=KL0XI(4)[7]", "=KL0XN(4)[7]", "=KL0ZL(3)[6]", "=KL1AA(3)[6]", "=KL1AE(3)[6]", "=KL1AK(3)[6]", "=KL1DA(4)[7]", "=KL1DJ(4)[7]", "=KL1DN(4)[8]", "=KL1DO(3)[6]", "=KL1DW(3)[6]", "=KL1DY(4)[7]", "=KL1ED(3)[6]", "=KL1HA(5)[8]", "=KL1HS(3)[6]", "=KL1IF(4)[7]", "=KL1J(4)[7]", "=KL1JF(3)[6]", "=KL1K(3)[6]", "=KL1KM(5)[8]", "=KL1KP(5)[8]", "=KL1KU(3)[6]", "=KL1LA(5)[8]", "=KL1LD(4)[7]", "=KL1LE(3)[6]", "=KL1LV(4)[8]", "=KL1LZ(3)[6]", "=KL1MF(3)[6]", "=KL1MM(4)[7]", "=KL1MW(4)[7]", "=KL1NER(3)[6]", "=KL1NK(5)[8]", "=KL1NO(4)[8]", "=KL1NR(4)[8]", "=KL1NS(5)[8]", "=KL1OC(5)[8]", "=KL1OH(3)[6]", "=KL1OK(5)[8]", "=KL1PA(5)[8]", "=KL1PV(4)[7]", "=KL1QL(3)[6]", "=KL1QN(4)[8]", "=KL1RH(3)[6]", "=KL1RV(3)[6]", "=KL1RX(4)[7]", "=KL1SE(4)[8]", "=KL1SO(3)[6]", "=KL1SP(3)[6]", "=KL1SS(5)[8]", "=KL1TS(4)[7]", "=KL1TU(4)[7]", "=KL1TV(4)[7]", "=KL1U(3)[6]", "=KL1UA(3)[6]", "=KL1UM(3)[6]", "=KL1UR(4)[7]", "=KL1US(4)[8]", "=KL1VN(4)[7]", "=KL1WD(5)[8]", "=KL1WG(4)[7]", "=KL1WO(4)[7]", "=KL1XI(3)[6]", "=KL1XK(4)[7]", "=KL1Y(4)[7]", "=KL1YO(3)[6]", "=KL1ZA(4)[8]", "=KL1ZN(3)[6]", "=KL1ZP(3)[6]", "=KL1ZR(3)[6]", "=KL1ZW(4)[7]", "=KL2AK(5)[8]", "=KL2AX(4)[7]", "=KL2BG(3)[6]", "=KL2BO(3)[6]", "=KL2BP(3)[6]", "=KL2BW(3)[6]", "=KL2BY(3)[6]", "=KL2BZ(3)[6]", "=KL2CD(4)[7]", "=KL2CX(5)[8]", "=KL2DM(5)[8]", "=KL2EY(5)[8]", "=KL2FD(3)[6]", "=KL2FL(3)[6]", "=KL2GA(5)[8]", "=KL2GB(4)[8]", "=KL2GG(5)[8]", "=KL2GP(5)[8]", "=KL2GR(4)[7]", "=KL2HC(4)[7]", "=KL2HK(4)[8]", "=KL2HN(4)[7]", "=KL2HV(5)[8]", "=KL2IC(5)[8]", "=KL2JY(3)[6]", "=KL2K(3)[6]", "=KL2KL(5)[8]", "=KL2KP(4)[8]", "=KL2KY(3)[6]", "=KL2LA(3)[6]", "=KL2LK(4)[8]", "=KL2LN(3)[6]", "=KL2LT(3)[6]", "=KL2LU(4)[8]", "=KL2MA(3)[6]", "=KL2MI(4)[7]", "=KL2MP(3)[6]", "=KL2MQ(5)[8]", "=KL2MU(5)[8]", "=KL2NI(4)[8]", "=KL2NJ(3)[6]", "=KL2NN(5)[8]", "=KL2NP(5)[8]", "=KL2NS(4)[7]", "=KL2NU(3)[6]", "=KL2NW(3)[6]", "=KL2NZ(4)[7]", "=KL2OH(3)[6]", "=KL2OJ(3)[6]", "=KL2P(3)[6]", "=KL2PS(4)[8]", "=KL2QE(3)[6]", "=KL2QO(4)[7]", "=KL2RA(4)[7]", "=KL2RB(4)[7]", "=KL2TD(4)[8]", "=KL2TP(5)[8]", "=KL2TR(3)[6]", "=KL2TV(4)[7]", "=KL2TZ(3)[6]", "=KL2UM(5)[8]", "=KL2UO(4)[7]", "=KL2UP(4)[7]", "=KL2UQ(5)[8]", "=KL2UR(5)[8]", "=KL2UY(4)[8]", "=KL2VA(4)[7]", "=KL2VK(3)[6]", "=KL2WE(3)[6]", "=KL2WF(4)[8]", "=KL2WL(3)[6]", "=KL2XF(5)[8]", "=KL2XI(5)[8]", "=KL2XQ(3)[6]", "=KL2YD(4)[8]", "=KL2YH(3)[6]", "=KL2YI(4)[7]", "=KL2YU(4)[8]", "=KL2ZJ(4)[7]", "=KL2ZK(4)[7]", "=KL2ZL(4)[8]", "=KL3DA(4)[8]", "=KL3DL(3)[6]", "=KL3DP(4)[7]", "=KL3ET(5)[8]", "=KL3EV(5)[8]", "=KL3EZ(3)[6]", "=KL3FE(3)[6]", "=KL3HG(5)[8]", "=KL3HK(4)[7]", "=KL3HQ(4)[8]", "=KL3HZ(4)[7]", "=KL3IA(5)[8]", "=KL3IC(3)[6]", "=KL3IM(3)[6]", "=KL3IO(3)[6]", "=KL3IW(3)[6]", "=KL3JL(4)[7]", "=KL3KB(5)[8]", "=KL3KG(5)[8]", "=KL3KH(4)[7]", "=KL3KI(4)[7]", "=KL3LY(4)[7]", "=KL3MA(4)[7]", "=KL3MB(4)[7]", "=KL3MC(4)[7]", "=KL3ML(3)[6]", "=KL3MW(4)[7]", "=KL3MZ(3)[6]", "=KL3NE(3)[6]", "=KL3NO(3)[6]", "=KL3NR(5)[8]", "=KL3OQ(3)[6]", "=KL3PD(3)[6]", "=KL3PV(4)[8]", "=KL3QS(4)[7]", "=KL3RA(4)[8]", "=KL3SM(4)[7]", "=KL3TB(4)[7]", "=KL3TW(3)[6]", "=KL3UX(5)[8]", "=KL3VA(5)[8]", "=KL3VJ(3)[6]", "=KL3VN(4)[7]", "=KL3WM(5)[8]", "=KL3X(5)[8]", "=KL3XB(5)[8]", "=KL3XS(3)[6]", "=KL3YH(3)[6]", "=KL3ZC(5)[8]", "=KL4BQ(3)[6]", "=KL4BS(3)[6]", "=KL4CX(4)[8]", "=KL4CZ(4)[7]", "=KL4DD(5)[8]", "=KL4GW(3)[6]", "=KL4H(5)[8]", "=KL4IY(4)[7]", "=KL4J(5)[8]", "=KL4JN(4)[7]", "=KL4JQ(4)[7]", "=KL4KA(4)[8]", "=KL4LV(3)[6]", "=KL4NG(3)[6]", "=KL4PQ(4)[8]", "=KL4QJ(3)[6]", "=KL4RKH(3)[6]", "=KL4XK(5)[8]", "=KL4YFD(3)[6]", "=KL5A(4)[8]", "=KL5L(4)[7]", "=KL5X(5)[8]", "=KL5YJ(5)[8]", "=KL5Z(4)[7]", "=KL7A(5)[8]", "=KL7AB(3)[6]", "=KL7AD(3)[6]", "=KL7AH(4)[7]", "=KL7AK(3)[6]", "=KL7AL(4)[8]", "=KL7AR(4)[7]", "=KL7AU(4)[7]", "=KL7AW(3)[6]", "=KL7AX(4)[7]", "=KL7BCD(4)[7]", "=KL7BD(3)[6]", "=KL7BDC(3)[6]", "=KL7BGR(4)[8]", "=KL7BH(3)[6]", "=KL7BL(4)[7]", "=KL7BR(3)[6]", "=KL7BS(3)[6]", "=KL7BT(3)[6]", "=KL7BUR(3)[6]", "=KL7BX(4)[7]", "=KL7BXP(3)[6]", "=KL7BZL(4)[7]", "=KL7C(3)[6]", "=KL7CD(4)[7]", "=KL7CE(4)[8]", "=KL7CM(3)[6]", "=KL7CN(3)[6]", "=KL7CPO(3)[6]", "=KL7CR(3)[6]", "=KL7CT(3)[6]", "=KL7CX(3)[6]", "=KL7CY(3)[6]", "=KL7DB(4)[7]", "=KL7DC(3)[6]", "=KL7DF(3)[6]", "=KL7DI(3)[6]", "=KL7DJ(3)[6]", "=KL7DK(3)[6]", "=KL7DL(5)[8]", "=KL7DLG(3)[6]", "=KL7DS(4)[8]", "=KL7DTJ(4)[7]", "=KL7DZQ(3)[6]", "=KL7EAE(3)[6]", "=KL7EAL(3)[6]", "=KL7EBE(4)[7]", "=KL7EF(3)[6]", "=KL7EH(3)[6]", "=KL7EIN(3)[6]", "=KL7EMH(4)[7]", "=KL7EP(4)[7]", "=KL7EU(3)[6]", "=KL7F(4)[7]", "=KL7FB(4)[7]", "=KL7FD(5)[8]", "=KL7FDQ(3)[6]", "=KL7FHI(4)[8]", "=KL7FHK(4)[8]", "=KL7FHX(4)[7]", "=KL7FLY(4)[7]", "=KL7FO(5)[8]", "=KL7FOZ(3)[6]", "=KL7FQQ(4)[7]", "=KL7FQR(4)[7]", "=KL7FRQ(3)[6]", "=KL7GB(5)[8]", "=KL7GCS(3)[6]", "=KL7GF(4)[8]", "=KL7GKY(3)[6]", "=KL7GLK(4)[7]", "=KL7GLL(5)[8]", "=KL7GNW(4)[7]", "=KL7GRF(3)[6]", "=KL7GT(3)[6]", "=KL7H(5)[8]", "=KL7HB(3)[6]", "=KL7HBV(3)[6]", "=KL7HF(4)[7]", "=KL7HFV(3)[6]", "=KL7HH(4)[7]", "=KL7HI(3)[6]", "=KL7HIM(5)[8]", "=KL7HJR(3)[6]", "=KL7HJZ(4)[7]", "=KL7HLF(3)[6]", "=KL7HM(3)[6]", "=KL7HMK(3)[6]", "=KL7HNY(5)[8]", "=KL7HOT(5)[8]", "=KL7HQL(3)[6]", "=KL7HQR(3)[6]", "=KL7HQW(5)[8]", "=KL7HSG(4)[7]", "=KL7HSR(3)[6]", "=KL7HSY(3)[6]", "=KL7HV(5)[8]", "=KL7HX(5)[8]", "=KL7I(5)[8]", "=KL7IAL(3)[6]", "=KL7IBV(4)[8]", "=KL7ID(3)[6]", "=KL7IDM(4)[7]", "=KL7IEI(3)[6]", "=KL7IEK(5)[8]", "=KL7IFK(3)[6]", "=KL7IGB(3)[6]", "=KL7IIK(3)[6]", "=KL7IKP(4)[8]", "=KL7IKR(4)[8]", "=KL7IKV(3)[6]", "=KL7IKZ(5)[8]", "=KL7IL(3)[6]", "=KL7IME(3)[6]", "=KL7IOP(5)[8]", "=KL7IOW(3)[6]", "=KL7IPS(4)[8]", "=KL7ISE(3)[6]", "=KL7ISN(3)[6]", "=KL7ITF(4)[7]", "=KL7IUQ(4)[8]", "=KL7IV(5)[8]", "=KL7IVK(4)[8]", "=KL7IVY(5)[8]", "=KL7IWF(5)[8]", "=KL7IWT(4)[7]", "=KL7IWU(4)[7]", "=KL7IXI(4)[7]", "=KL7IXX(5)[8]", "=KL7IZC(3)[6]", "=KL7IZH(3)[6]", "=KL7IZW(4)[7]", "=KL7JAB(4)[8]", "=KL7JAR(4)[7]", "=KL7JBB(3)[6]", "=KL7JBE(3)[6]", "=KL7JCQ(5)[8]", "=KL7JDQ(3)[6]", "=KL7JDS(5)[8]", "=KL7JFR(5)[8]", "=KL7JGS(4)[7]", "=KL7JHM(5)[8]", "=KL7JIE(4)[7]", "=KL7JIJ(3)[6]", "=KL7JIM(4)[7]", "=KL7JIU(4)[7]", "=KL7JJE(3)[6]", "=KL7JJN(5)[8]", "=KL7JKC(4)[8]", "=KL7JKV(3)[6]", "=KL7JM(4)[7]", "=KL7JO(5)[8]", "=KL7JR(5)[8]", "=KL7JT(5)[8]", "=KL7JUL(4)[7]", "=KL7JW(4)[7]", "=KL7KA(3)[6]", "=KL7KX(3)[6]", "=KL7LG(3)[6]", "=KL7LH(4)[7]", "=KL7LI(3)[6]", "=KL7LJ(4)[7]", "=KL7LS(5)[8]", "=KL7LX(3)[6]", "=KL7LY(4)[7]", "=KL7LZ(3)[6]", "=KL7M(3)[6]", "=KL7MA(4)[7]", "=KL7ME(4)[7]", "=KL7MF(3)[6]", "=KL7MH(4)[7]", "=KL7MJ(5)[8]", "=KL7ML(4)[7]", "=KL7MU(4)[8]", "=KL7MV(4)[7]", "=KL7MY(3)[6]", "=KL7MZ(3)[6]", "=KL7NA(3)[6]", "=KL7NCO(5)[8]", "=KL7NE(4)[7]", "=KL7NI(4)[7]", "=KL7NL(5)[8]", "=KL7NT(5)[8]", "=KL7NW(4)[7]", "=KL7OF(3)[6]", "=KL7OG(4)[8]", "=KL7OI(4)[7]", "=KL7OL(3)[6]", "=KL7OO(5)[8]", "=KL7OQ(5)[8]", "=KL7OS(3)[6]", "=KL7OY(3)[6]", "=KL7PC(3)[6]", "=KL7PO(3)[6]", "=KL7PS(5)[8]", "=KL7QA(3)[6]", "=KL7QC(4)[7]", "=KL7QK(3)[6]", "=KL7QR(3)[6]", "=KL7QR/7(3)[6]", "=KL7QU(5)[8]", "=KL7QW(4)[7]", "=KL7R(3)[6]", "=KL7RC(3)[6]", "=KL7RF(4)[8]", "=KL7RF/8(4)[8]", "=KL7RH(4)[7]", "=KL7RK(3)[6]", "=KL7RM(3)[6]", "=KL7RS(3)[6]", "=KL7RT(3)[6]", "=KL7S(3)[6]", "=KL7SFD(4)[7]", "=KL7SG(4)[7]", "=KL7SK(3)[6]", "=KL7SL(3)[6]", "=KL7SP(3)[6]", "=KL7SR(5)[8]", "=KL7SW(4)[8]", "=KL7SY(3)[6]", "=KL7T(3)[6]", "=KL7TD(4)[8]", "=KL7TJZ(5)[8]", "=KL7TU(3)[6]", "=KL7TZ(5)[8]", "=KL7UP(3)[6]", "=KL7UT(3)[6]", "=KL7UV(4)[7]", "=KL7VK(3)[6]", "=KL7VL(3)[6]", "=KL7VN(3)[6]", "=KL7VQ(3)[6]", "=KL7VU(3)[6]", "=KL7W(3)[6]", "=KL7WA(5)[8]", "=KL7WC(3)[6]", "=KL7WM(3)[6]", "=KL7WN(3)[6]", "=KL7WT(3)[6]", "=KL7WW(4)[8]", "=KL7XA(5)[8]", "=KL7XH(4)[7]", "=KL7XL(3)[6]", "=KL7XP(4)[7]", "=KL7XS(4)[7]", "=KL7YL(4)[7]", "=KL7YN(4)[8]", "=KL7YQ(3)[6]", "=KL7YT(4)[8]", "=KL7ZD(4)[7]", "=KL7ZH(3)[6]", "=KL7ZW(3)[6]", "=KL8RV(3)[6]", "=KL8SU(3)[6]", "=KL8X(4)[8]", "=KL9A(4)[7]", "=KL9ER(5)[8]", "=KL9MEK(4)[8]", "=KL9PC(3)[6]", "=KP2AF(5)[8]", "=KP2AV(5)[8]", "=KP2AV/4(5)[8]", "=KP2BK(3)[6]", "=KP2BX(3)[6]", "=KP2CB(3)[6]", "=KP2CH(5)[8]", "=KP2CR(5)[8]", "=KP2CT(3)[6]", "=KP2L(5)[8]", "=KP2N(5)[8]", "=KP2NP(5)[8]", "=KP2R(5)[8]", "=KP2RF(4)[8]", "=KP2U(5)[8]", "=KP2US(5)[8]", "=KP2V(5)[8]", "=KP2X(3)[6]", "=KP2XX(4)[8]", "=KP2Y(3)[6]", "=KP3AK(5)[8]", "=KP3AMG(5)[8]", "=KP3BL(5)[8]", "=KP3BN(3)[6]", "=KP3BP(5)[8]", "=KP3J(5)[8]", "=KP3JOS(4)[8]", "=KP3LM(5)[8]", "=KP3M(5)[8]", "=KP3RC(4)[8]", "=KP3U(5)[8]", "=KP3Y(5)[8]", "=KP3YL(3)[6]", "=KP4AD(5)[8]", "=KP4AK(5)[8]", "=KP4AKB(4)[8]", "=KP4AMC(5)[8]", "=KP4AMZ(4)[8]", "=KP4ANG(5)[8]", "=KP4AOD(5)[8]", "=KP4AQI(4)[8]", "=KP4ATV(4)[7]", "=KP4AYI(5)[8]", "=KP4BBN(5)[8]", "=KP4BEC(5)[8]", "=KP4BEP(5)[8]", "=KP4BLS(5)[8]", "=KP4BM(5)[8]", "=KP4BPR(5)[8]", "=KP4CAM(5)[8]", "=KP4CBP(5)[8]", "=KP4CH(5)[8]", "=KP4CI(4)[8]", "=KP4CML(5)[8]", "=KP4CSJ(5)[8]", "=KP4CSZ(5)[8]", "=KP4CV(4)[7]", "=KP4CW(5)[8]", "=KP4CZ(5)[8]", "=KP4DAC(5)[8]", "=KP4DDS(5)[8]", "=KP4DGF(5)[8]", "=KP4DJT(4)[7]", "=KP4DPQ(5)[8]", "=KP4DQS(5)[8]", "=KP4DSO(3)[6]", "=KP4E(4)[8]", "=KP4EDL(5)[8]", "=KP4EMY(5)[8]", "=KP4ENK(5)[8]", "=KP4ENM(3)[6]", "=KP4EOR(5)[8]", "=KP4ERR(3)[6]", "=KP4ERT(5)[8]", "=KP4FBS(5)[8]", "=KP4FBT(3)[6]", "=KP4FCF(5)[8]", "=KP4FFW(4)[7]", "=KP4FGI(5)[8]", "=KP4FIR(5)[8]", "=KP4FJE(5)[8]", "=KP4FLP(5)[8]", "=KP4FOF(5)[8]", "=KP4G(5)[8]", "=KP4GEG(5)[8]", "=KP4GMC(4)[7]", "=KP4GVT(5)[8]", "=KP4HE(5)[8]", "=KP4HN(5)[8]", "=KP4HR(5)[8]", "=KP4I(5)[8]", "=KP4II(5)[8]", "=KP4IP(5)[8]", "=KP4IRI(5)[8]", "=KP4IT(5)[8]", "=KP4JB(5)[8]", "=KP4JC(5)[8]", "=KP4JCC(5)[8]", "=KP4JDR(5)[8]", "=KP4JE(4)[7]", "=KP4JG(4)[7]", "=KP4JLD(5)[8]", "=KP4JMP(5)[8]", "=KP4JOS(5)[8]", "=KP4JWR(5)[8]", "=KP4JY(4)[7]", "=KP4KA(5)[8]", "=KP4KD(5)[8]", "=KP4KWB(5)[8]", "=KP4LEU(5)[8]", "=KP4LF(5)[8]", "=KP4LUV(5)[8]", "=KP4LX(5)[8]", "=KP4MA(5)[8]", "=KP4MAS(4)[8]", "=KP4MD(3)[6]", "=KP4MHC(5)[8]", "=KP4MHG(5)[8]", "=KP4MPR(5)[8]", "=KP4MR(5)[8]", "=KP4MSP(5)[8]", "=KP4N(5)[8]", "=KP4NBI(5)[8]", "=KP4ND(3)[6]", "=KP4NI(5)[8]", "=KP4NKE(4)[8]", "=KP4NPL(5)[8]", "=KP4NW(5)[8]", "=KP4OO(5)[8]", "=KP4PC(5)[8]", "=KP4PF(5)[8]", "=KP4PRI(5)[8]", "=KP4Q(5)[8]", "=KP4QT(5)[8]", "=KP4R(5)[8]", "=KP4RCD(5)[8]", "=KP4REY(5)[8]", "=KP4RGT(5)[8]", "=KP4ROP(5)[8]", "=KP4RRC(5)[8]", "=KP4RT(5)[8]", "=KP4RZ(5)[8]", "=KP4SU(5)[8]", "=KP4TL(5)[8]", "=KP4TR(5)[8]", "=KP4UB(3)[6]", "=KP4UFO(5)[8]", "=KP4USA(5)[8]", "=KP4UV(5)[8]", "=KP4UZ(3)[6]", "=KP4VZ(4)[8]", "=KP4WG(4)[8]", "=KP4WK(5)[8]", "=KP4WR(5)[8]", "=KP4WW(5)[8]", "=KP4WY(5)[8]", "=KP4X(3)[6]", "=KP4XO(5)[8]", "=KP4XP(5)[8]", "=KP4XX(5)[8]", "=KP4XZ(4)[7]", "=KP4Y(5)[8]", "=KP4YH(5)[8]", "=KP4YLV(5)[8]", "=KP4YP(4)[7]", "=KP4YY(4)[7]", "=KP4ZD(4)[8]", "=KP4ZEM(5)[8]", "=KP4ZX(5)[8]", "=NH0C(3)[6]", "=NH0F(3)[6]", "=NH0H(5)[8]", "=NH0K(3)[6]", "=NH0O(3)[6]", "=NH0X(3)[6]", "=NH2A(5)[8]", "=NH2AR(3)[6]", "=NH2BD(3)[6]", "=NH2BQ(5)[8]", "=NH2BV(3)[6]", "=NH2CM(3)[6]", "=NH2DB(5)[8]", "=NH2DC(5)[8]", "=NH2DM(3)[6]", "=NH2E(4)[8]", "=NH2F(5)[8]", "=NH2FX(3)[6]", "=NH2JE(3)[6]", "=NH2KR(3)[6]", "=NH2LA(5)[8]", "=NH2LH(4)[7]", "=NH2R(3)[6]", "=NH2S(3)[6]", "=NH2W(4)[8]", "=NH6AC(3)[6]", "=NH6AE(3)[6]", "=NH6AF(3)[6]", "=NH6AU(5)[8]", "=NH6AX(5)[8]", "=NH6AZ(4)[7]", "=NH6B(3)[6]", "=NH6BD(5)[8]", "=NH6BF(3)[6]", "=NH6BK(5)[8]", "=NH6CF(4)[7]", "=NH6DQ(3)[6]", "=NH6DX(3)[6]", "=NH6E(5)[8]", "=NH6EF(4)[7]", "=NH6EU(4)[7]", "=NH6FA(4)[7]", "=NH6FF(3)[6]", "=NH6FV(3)[6]", "=NH6FX(3)[6]", "=NH6GE(5)[8]", "=NH6GR(5)[8]", "=NH6GZ(3)[6]", "=NH6HE(3)[6]", "=NH6HX(5)[8]", "=NH6HZ(3)[6]", "=NH6IH(5)[8]", "=NH6JX(5)[8]", "=NH6KI(5)[8]", "=NH6L(4)[7]", "=NH6LF(3)[6]", "=NH6LM(3)[6]", "=NH6NG(3)[6]", "=NH6NS(3)[6]", "=NH6QR(5)[8]", "=NH6R(4)[8]", "=NH6SF(3)[6]", "=NH6SO(3)[6]", "=NH6SR(5)[8]", "=NH6ST(3)[6]", "=NH6T(5)[8]", "=NH6TD(4)[7]", "=NH6TL(5)[8]", "=NH6U(3)[6]", "=NH6VB(4)[7]", "=NH6VJ(4)[7]", "=NH6WE(3)[6]", "=NH6WF(4)[7]", "=NH6WL(4)[7]", "=NH6WR(3)[6]", "=NH6XN(3)[6]", "=NH6XW(5)[8]", "=NH6Z(3)[6]", "=NH6ZA(3)[6]", "=NH6ZE(3)[6]", "=NH7AG(3)[6]", "=NH7AQ(5)[8]", "=NH7AR(5)[8]", "=NH7C(5)[8]", "=NH7CC(5)[8]", "=NH7CY(4)[7]", "=NH7EM(3)[6]", "=NH7FG(5)[8]", "=NH7FI(4)[7]", "=NH7FK(4)[8]", "=NH7FO(4)[7]", "=NH7FU(3)[6]", "=NH7FW(3)[6]", "=NH7FZ(3)[6]", "=NH7G(3)[6]", "=NH7H(4)[8]", "=NH7IG(3)[6]", "=NH7IH(3)[6]", "=NH7L(3)[6]", "=NH7M(3)[6]", "=NH7MV(4)[7]", "=NH7MY(3)[6]", "=NH7N(3)[6]", "=NH7NA(5)[8]", "=NH7ND(3)[6]", "=NH7OC(3)[6]", "=NH7OI(5)[8]", "=NH7PL(3)[6]", "=NH7PM(3)[6]", "=NH7PZ(4)[7]", "=NH7QV(3)[6]", "=NH7R(4)[7]", "=NH7RO(4)[7]", "=NH7RS(3)[6]", "=NH7RT(3)[6]", "=NH7S(3)[6]", "=NH7SH(3)[6]", "=NH7ST(3)[6]", "=NH7SU(3)[6]", "=NH7TK(4)[8]", "=NH7TN(5)[8]", "=NH7TR(4)[7]", "=NH7TV(5)[8]", "=NH7UN(5)[8]", "=NH7VA(4)[7]", "=NH7W(3)[6]", "=NH7WB(4)[7]", "=NH7WC(3)[6]", "=NH7WE(3)[6]", "=NH7WG(3)[6]", "=NH7WT(3)[6]", "=NH7WU(3)[6]", "=NH7XM(4)[7]", "=NH7XN(5)[8]", "=NH7YE(3)[6]", "=NH7YI(3)[6]", "=NH7YK(5)[8]", "=NH7ZE(3)[6]", "=NH7ZH(4)[7]", "=NL5L(5)[8]", "=NL7AH(3)[6]", "=NL7AK(5)[8]", "=NL7AR(3)[6]", "=NL7AS(4)[7]", "=NL7AX(4)[7]", "=NL7AZ(3)[6]", "=NL7BU(4)[7]", "=NL7BV(5)[8]", "=NL7C(4)[7]", "=NL7CC(5)[8]", "=NL7CF(4)[8]", "=NL7CH(3)[6]", "=NL7CK(5)[8]", "=NL7CM(4)[8]", "=NL7CQ(4)[7]", "=NL7D(3)[6]", "=NL7DC(4)[7]", "=NL7DH(3)[6]", "=NL7DY(3)[6]", "=NL7EO(3)[6]", "=NL7FF(4)[7]", "=NL7FJ(5)[8]", "=NL7FJ/1(5)[8]", "=NL7FK(4)[8]", "=NL7FQ(3)[6]", "=NL7FU(4)[7]", "=NL7FX(3)[6]", "=NL7GE(3)[6]", "=NL7GM(3)[6]", "=NL7GO(3)[6]", "=NL7GU(3)[6]", "=NL7GW(3)[6]", "=NL7HB(4)[7]", "=NL7HK(3)[6]", "=NL7HQ(3)[6]", "=NL7HU(3)[6]", "=NL7IB(3)[6]", "=NL7IE(4)[7]", "=NL7IH(4)[7]", "=NL7IN(3)[6]", "=NL7JH(4)[7]", "=NL7JJ(3)[6]", "=NL7JN(3)[6]", "=NL7JV(4)[7]", "=NL7JY(5)[8]", "=NL7KB(4)[7]", "=NL7KD(4)[8]", "=NL7KL(5)[8]", "=NL7KV(3)[6]", "=NL7KX(5)[8]", "=NL7LC(3)[6]", "=NL7LE(4)[7]", "=NL7LI(3)[6]", "=NL7LO(5)[8]", "=NL7LR(5)[8]", "=NL7LY(5)[8]", "=NL7MD(5)[8]", "=NL7MO(5)[8]", "=NL7MR(5)[8]", "=NL7MS(3)[6]", "=NL7MT(3)[6]", "=NL7MW(4)[7]", "=NL7NJ(5)[8]", "=NL7NL(3)[6]", "=NL7OB(5)[8]", "=NL7OE(4)[8]", "=NL7OF(3)[6]", "=NL7OM(4)[7]", "=NL7OP(3)[6]", "=NL7OS(5)[8]", "=NL7OT(5)[8]", "=NL7P(5)[8]", "=NL7PD(4)[7]", "=NL7PJ(5)[8]", "=NL7PN(3)[6]", "=NL7PV(5)[8]", "=NL7QC(4)[8]", "=NL7QI(3)[6]", "=NL7RC(4)[8]", "=NL7RL(3)[6]", "=NL7RN(3)[6]", "=NL7RO(3)[6]", "=NL7RQ(4)[7]", "=NL7SH(5)[8]", "=NL7SI(4)[7]", "=NL7TK(3)[6]", "=NL7TO(4)[7]", "=NL7TP(3)[6]", "=NL7U(5)[8]", "=NL7UE(3)[6]", "=NL7UH(4)[8]", "=NL7UI(4)[7]", "=NL7VS(3)[6]", "=NL7VV(5)[8]", "=NL7VX(5)[8]", "=NL7WA(5)[8]", "=NL7WJ(3)[6]", "=NL7XM(5)[8]", "=NL7XM/B(5)[8]", "=NL7XT(4)[7]", "=NL7XU(4)[7]", "=NL7XX(3)[6]", "=NL7YI(4)[8]", "=NL7YU(4)[8]", "=NL7YZ(5)[8]", "=NL7ZM(3)[6]", "=NL7ZN(3)[6]", "=NL7ZP(3)[6]", "=NL9H(5)[8]", "=NP2AK(4)[8]", "=NP2AV(4)[8]", "=NP2B(5)[8]", "=NP2BB(5)[8]", "=NP2BW(5)[8]", "=NP2CB(5)[8]", "=NP2CT(3)[6]", "=NP2D(5)[8]", "=NP2DJ(5)[8]", "=NP2EI(5)[8]", "=NP2EP(5)[8]", "=NP2F(4)[8]", "=NP2FT(5)[8]", "=NP2FZ(5)[8]", "=NP2G(5)[8]", "=NP2GG(5)[8]", "=NP2GI(5)[8]", "=NP2GM(4)[8]", "=NP2GW(5)[8]", "=NP2HQ(5)[8]", "=NP2HS(5)[8]", "=NP2HW(5)[8]", "=NP2IE(5)[8]", "=NP2IF(5)[8]", "=NP2IJ(5)[8]", "=NP2IS(5)[8]", "=NP2IW(5)[8]", "=NP2IX(5)[8]", "=NP2JA(5)[8]", "=NP2JS(5)[8]", "=NP2JV(5)[8]", "=NP2KL(3)[6]", "=NP2KY(3)[6]", "=NP2L(5)[8]", "=NP2LC(5)[8]", "=NP2MM(5)[8]", "=NP2MN(5)[8]", "=NP2MP(5)[8]", "=NP2MR(5)[8]", "=NP2MU(4)[8]", "=NP2NC(5)[8]", "=NP2O(5)[8]", "=NP2OL(5)[8]", "=NP2OO(5)[8]", "=NP2OR(5)[8]", "=NP2PA(5)[8]", "=NP2PN(5)[8]", "=NP2PR(4)[7]", "=NP2R(5)[8]", "=NP2RA(4)[7]", "=NP2T(5)[8]", "=NP2W(5)[8]", "=NP3AX(5)[8]", "=NP3BL(5)[8]", "=NP3CM(5)[8]", "=NP3CT(5)[8]", "=NP3CV(4)[7]", "=NP3E(5)[8]", "=NP3ES(5)[8]", "=NP3EU(5)[8]", "=NP3FB(4)[8]", "=NP3FR(5)[8]", "=NP3G(5)[8]", "=NP3HD(5)[8]", "=NP3HN(5)[8]", "=NP3IL(5)[8]", "=NP3IP(5)[8]", "=NP3IU(5)[8]", "=NP3IV(5)[8]", "=NP3K(5)[8]", "=NP3KH(5)[8]", "=NP3KM(5)[8]", "=NP3KP(5)[8]", "=NP3LN(5)[8]", "=NP3MM(5)[8]", "=NP3NA(4)[8]", "=NP3NC(5)[8]", "=NP3NT(4)[7]", "=NP3OW(5)[8]", "=NP3PH(3)[6]", "=NP3QT(5)[8]", "=NP3R(5)[8]", "=NP3RG(4)[7]", "=NP3ST(5)[8]", "=NP3TM(5)[8]", "=NP3UM(5)[8]", "=NP3VJ(5)[8]", "=NP3WX(5)[8]", "=NP3YN(5)[8]", "=NP4AB(3)[6]", "=NP4AC(4)[8]", "=NP4AI(4)[7]", "=NP4AO(5)[8]", "=NP4AS(5)[8]", "=NP4AV(5)[8]", "=NP4AZ(5)[8]", "=NP4CC(5)[8]", "=NP4CK(5)[8]", "=NP4CV(5)[8]", "=NP4DM(5)[8]", "=NP4EA(4)[7]", "=NP4EM(5)[8]", "=NP4ER(5)[8]", "=NP4ES(3)[6]", "=NP4FP(3)[6]", "=NP4GH(5)[8]", "=NP4GW(5)[8]", "=NP4H(5)[8]", "=NP4I(3)[6]", "=NP4IR(5)[8]", "=NP4IT(5)[8]", "=NP4IW(3)[6]", "=NP4J(5)[8]", "=NP4JL(5)[8]", "=NP4JQ(5)[8]", "=NP4JU(5)[8]", "=NP4KV(5)[8]", "=NP4M(5)[8]", "=NP4MV(3)[6]", "=NP4ND(5)[8]", "=NP4NQ(4)[7]", "=NP4PF(5)[8]", "=NP4RH(5)[8]", "=NP4RJ(5)[8]", "=NP4RW(4)[7]", "=NP4RZ(4)[7]", "=NP4SY(5)[8]", "=NP4TR(5)[8]", "=NP4WT(5)[8]", "=NP4XB(5)[8]", "=NP4XE(3)[6]", "=NP4YZ(5)[8]", "=NP4ZI(4)[8]", "=WH0AAM(3)[6]", "=WH0AAZ(3)[6]", "=WH0AI(4)[8]", "=WH0EWX(5)[8]", "=WH0M(3)[6]", "=WH0W(5)[8]", "=WH2AAT(5)[8]", "=WH2ABJ(5)[8]", "=WH2ABS(3)[6]", "=WH2ACT(4)[7]", "=WH2ACV(3)[6]", "=WH2AJF(3)[6]", "=WH2ALN(3)[6]", "=WH2B(5)[8]", "=WH2C(5)[8]", "=WH2G(5)[8]", "=WH2M(4)[7]", "=WH2S(4)[7]", "=WH2T(4)[8]", "=WH2U(4)[8]", "=WH2Z(4)[7]", "=WH6A(5)[8]", "=WH6AAJ(3)[6]", "=WH6ACF(5)[8]", "=WH6ADS(5)[8]", "=WH6AFM(3)[6]", "=WH6AJS(5)[8]", "=WH6AKZ(4)[7]", "=WH6ANA(3)[6]", "=WH6ANH(4)[7]", "=WH6ARN(4)[7]", "=WH6ARU(3)[6]", "=WH6ASB(3)[6]", "=WH6AUL(4)[8]", "=WH6AVU(5)[8]", "=WH6AWO(5)[8]", "=WH6AX(5)[8]", "=WH6AZN(5)[8]", "=WH6B(3)[6]", "=WH6BCB(4)[8]", "=WH6BDR(3)[6]", "=WH6BLM(3)[6]", "=WH6BLT(4)[7]", "=WH6BPL(3)[6]", "=WH6BPU(3)[6]", "=WH6BRQ(5)[8]", "=WH6BUL(4)[7]", "=WH6BXD(4)[7]", "=WH6BYJ(4)[7]", "=WH6BYP(4)[7]", "=WH6BYT(3)[6]", "=WH6CCQ(4)[7]", "=WH6CDU(4)[7]", "=WH6CE(5)[8]", "=WH6CEF(5)[8]", "=WH6CF(3)[6]", "=WH6CIL(3)[6]", "=WH6CK(3)[6]", "=WH6CMS(3)[6]", "=WH6CN(3)[6]", "=WH6CNC(5)[8]", "=WH6CO(3)[6]", "=WH6COM(4)[7]", "=WH6CPO(3)[6]", "=WH6CPT(3)[6]", "=WH6CRE(3)[6]", "=WH6CT(5)[8]", "=WH6CTO(5)[8]", "=WH6CTU(4)[7]", "=WH6CUE(4)[7]", "=WH6CUF(3)[6]", "=WH6CUL(4)[7]", "=WH6CUS(3)[6]", "=WH6CUU(3)[6]", "=WH6CUX(3)[6]", "=WH6CVJ(3)[6]", "=WH6CWD(3)[6]", "=WH6CWS(3)[6]", "=WH6CXA(5)[8]", "=WH6CXB(3)[6]", "=WH6CXE(3)[6]", "=WH6CXT(5)[8]", "=WH6CYB(3)[6]", "=WH6CYR(4)[8]", "=WH6CZ(3)[6]", "=WH6CZF(3)[6]", "=WH6CZH(3)[6]", "=WH6CZI(4)[7]", "=WH6CZU(4)[7]", "=WH6DAY(3)[6]", "=WH6DBX(5)[8]", "=WH6DCJ(4)[7]", "=WH6DHN(3)[6]", "=WH6DJO(3)[6]", "=WH6DKC(3)[6]", "=WH6DKO(3)[6]", "=WH6DLQ(3)[6]", "=WH6DM(4)[8]", "=WH6DMJ(5)[8]", "=WH6DMP(4)[7]", "=WH6DNF(5)[8]", "=WH6DOA(5)[8]", "=WH6DOL(5)[8]", "=WH6DQ(3)[6]", "=WH6DSK(3)[6]", "=WH6DSN(5)[8]", "=WH6DST(3)[6]", "=WH6DTH(3)[6]", "=WH6DUJ(5)[8]", "=WH6DUV(4)[7]", "=WH6DVM(3)[6]", "=WH6DVN(3)[6]", "=WH6DXA(4)[7]", "=WH6DXT(5)[8]", "=WH6DYA(3)[6]", "=WH6DZ(5)[8]", "=WH6DZU(4)[7]", "=WH6DZV(3)[6]", "=WH6DZY(3)[6]", "=WH6E(4)[8]", "=WH6EAA(4)[7]", "=WH6EAE(4)[7]", "=WH6EAR(3)[6]", "=WH6EBA(4)[8]", "=WH6ECJ(4)[7]", "=WH6ECO(5)[8]", "=WH6ECQ(5)[8]", "=WH6EEC(3)[6]", "=WH6EEG(3)[6]", "=WH6EEL(5)[8]", "=WH6EEN(5)[8]", "=WH6EEZ(3)[6]", "=WH6EFI(5)[8]", "=WH6EGM(3)[6]", "=WH6EHT(5)[8]", "=WH6EHW(3)[6]", "=WH6EHY(3)[6]", "=WH6EI(5)[8]", "=WH6EIJ(5)[8]", "=WH6EIK(5)[8]", "=WH6EIR(5)[8]", "=WH6EJD(4)[8]", "=WH6EJV(3)[6]", "=WH6EKB(3)[6]", "=WH6EKW(5)[8]", "=WH6ELG(5)[8]", "=WH6ELM(5)[8]", "=WH6ENG(3)[6]", "=WH6ENX(4)[7]", "=WH6EOF(4)[7]", "=WH6EOG(4)[8]", "=WH6EQB(3)[6]", "=WH6EQW(4)[8]", "=WH6ERQ(4)[8]", "=WH6ERS(4)[7]", "=WH6ESS(3)[6]", "=WH6ETE(5)[8]", "=WH6ETF(5)[8]", "=WH6ETO(3)[6]", "=WH6ETU(4)[7]", "=WH6EUA(4)[7]", "=WH6EVP(4)[7]", "=WH6EWB(4)[8]", "=WH6EWE(3)[6]", "=WH6EXQ(4)[7]", "=WH6EZW(3)[6]", "=WH6FAD(4)[7]", "=WH6FBA(4)[8]", "=WH6FBM(4)[7]", "=WH6FCP(5)[8]", "=WH6FCT(3)[6]", "=WH6FEJ(4)[8]", "=WH6FEU(3)[6]", "=WH6FGK(5)[8]", "=WH6FGM(4)[7]", "=WH6FJR(3)[6]", "=WH6FL(3)[6]", "=WH6FOJ(3)[6]", "=WH6FPR(3)[6]", "=WH6FPS(3)[6]", "=WH6FQ(3)[6]", "=WH6FRH(5)[8]", "=WH6FTF(3)[6]", "=WH6FTO(3)[6]", "=WH6FZL(4)[7]", "=WH6FZN(4)[7]", "=WH6GBC(4)[7]", "=WH6GEA(4)[7]", "=WH6GEU(5)[8]", "=WH6GFX(4)[7]", "=WH6HA(5)[8]", "=WH6IF(5)[8]", "=WH6IO(5)[8]", "=WH6IZ(5)[8]", "=WH6J(5)[8]", "=WH6JO(3)[6]", "=WH6KK(4)[7]", "=WH6L(5)[8]", "=WH6LAK(4)[8]", "=WH6LE(5)[8]", "=WH6LZ(3)[6]", "=WH6MS(4)[7]", "=WH6NE(5)[8]", "=WH6OB(5)[8]", "=WH6OR(4)[8]", "=WH6OY(3)[6]", "=WH6PX(3)[6]", "=WH6QA(3)[6]", "=WH6QS(4)[7]", "=WH6QV(3)[6]", "=WH6RF(3)[6]", "=WH6RN(5)[8]", "=WH6SB(4)[8]", "=WH6SD(3)[6]", "=WH6SR(3)[6]", "=WH6SW(5)[8]", "=WH6TB(4)[8]", "=WH6TI(3)[6]", "=WH6TK(3)[6]", "=WH6TT(3)[6]", "=WH6U(3)[6]", "=WH6UO(5)[8]", "=WH6USA(3)[6]", "=WH6VM(3)[6]", "=WH6VN(3)[6]", "=WH6WX(5)[8]", "=WH6XI(3)[6]", "=WH6XV(3)[6]", "=WH6XX(3)[6]", "=WH6YJ(3)[6]", "=WH6YM(5)[8]", "=WH6YX(3)[6]", "=WH6ZF(5)[8]", "=WH6ZR(3)[6]", "=WH6ZV(3)[6]", "=WH7A(3)[6]", "=WH7CY(3)[6]", "=WH7DA(3)[6]", "=WH7DB(3)[6]", "=WH7DE(3)[6]", "=WH7DG(3)[6]", "=WH7DH(3)[6]", "=WH7DW(4)[7]", "=WH7F(5)[8]", "=WH7G(3)[6]", "=WH7GC(3)[6]", "=WH7GD(5)[8]", "=WH7GY(3)[6]", "=WH7HQ(3)[6]", "=WH7HU(3)[6]", "=WH7HX(5)[8]", "=WH7IN(3)[6]", "=WH7IR(4)[7]", "=WH7IV(3)[6]", "=WH7IZ(3)[6]", "=WH7LB(3)[6]", "=WH7LP(3)[6]", "=WH7MZ(4)[7]", "=WH7NI(5)[8]", "=WH7NS(3)[6]", "=WH7OK(4)[7]", "=WH7OO(3)[6]", "=WH7P(3)[6]", "=WH7PM(3)[6]", "=WH7PV(4)[7]", "=WH7QC(3)[6]", "=WH7R(4)[7]", "=WH7RG(3)[6]", "=WH7RU(3)[6]", "=WH7TC(3)[6]", "=WH7TP(5)[8]", "=WH7TT(3)[6]", "=WH7U(3)[6]", "=WH7UP(3)[6]", "=WH7UZ(3)[6]", "=WH7VM(3)[6]", "=WH7VU(3)[6]", "=WH7WP(3)[6]", "=WH7WT(3)[6]", "=WH7XK(5)[8]", "=WH7XP(3)[6]", "=WH7XR(3)[6]", "=WH7XU(5)[8]", "=WH7YL(5)[8]", "=WH7YM(4)[7]", "=WH7YN(4)[7]", "=WH7YQ(5)[8]", "=WH7YR(5)[8]", "=WH7YV(5)[8]", "=WH7ZM(5)[8]", "=WH7ZS(5)[8]", "=WH8AAG(3)[6]", "=WH9AAF(5)[8]", "=WH9AAH(4)[7]", "=WL0JF(4)[7]", "=WL1ON(4)[7]", "=WL2NAS(5)[8]", "=WL3WX(4)[7]", "=WL4B(4)[8]", "=WL4JC(3)[6]", "=WL4X(5)[8]", "=WL5H(4)[7]", "=WL7A(4)[7]", "=WL7AAW(3)[6]", "=WL7ACO(3)[6]", "=WL7AEC(4)[7]", "=WL7AF(5)[8]", "=WL7AGO(4)[8]", "=WL7AHP(4)[8]", "=WL7AIT(4)[8]", "=WL7AIU(4)[7]", "=WL7AJA(4)[7]", "=WL7AL(3)[6]", "=WL7AM(4)[8]", "=WL7ANY(4)[7]", "=WL7AQ(3)[6]", "=WL7ATK(4)[7]", "=WL7AUL(5)[8]", "=WL7AUY(3)[6]", "=WL7AWC(4)[7]", "=WL7AWD(3)[6]", "=WL7AX(5)[8]", "=WL7AZG(3)[6]", "=WL7AZL(3)[6]", "=WL7B(5)[8]", "=WL7BA(3)[6]", "=WL7BAL(5)[8]", "=WL7BBV(4)[7]", "=WL7BCR(3)[6]", "=WL7BEV(4)[8]", "=WL7BGF(3)[6]", "=WL7BHI(4)[8]", "=WL7BHJ(4)[8]", "=WL7BHR(3)[6]", "=WL7BKF(4)[7]", "=WL7BKR(4)[8]", "=WL7BLM(3)[6]", "=WL7BM(3)[6]", "=WL7BNQ(3)[6]", "=WL7BON(3)[6]", "=WL7BOO(3)[6]", "=WL7BPY(4)[7]", "=WL7BRV(4)[7]", "=WL7BSW(3)[6]", "=WL7BT(4)[7]", "=WL7BUI(3)[6]", "=WL7BVN(3)[6]", "=WL7BVS(3)[6]", "=WL7CAZ(3)[6]", "=WL7CC(5)[8]", "=WL7CEG(4)[7]", "=WL7CES(3)[6]", "=WL7CHA(5)[8]", "=WL7CIB(5)[8]", "=WL7CJA(4)[7]", "=WL7CKJ(5)[8]", "=WL7CLI(4)[7]", "=WL7CMV(4)[8]", "=WL7COL(5)[8]", "=WL7COQ(3)[6]", "=WL7CPA(5)[8]", "=WL7CPE(3)[6]", "=WL7CPI(3)[6]", "=WL7CPL(3)[6]", "=WL7CPW(4)[7]", "=WL7CQE(4)[7]", "=WL7CQF(4)[7]", "=WL7CQH(4)[8]", "=WL7CQK(4)[8]", "=WL7CQT(5)[8]", "=WL7CRJ(3)[6]", "=WL7CRT(4)[7]", "=WL7CSD(3)[6]", "=WL7CSL(3)[6]", "=WL7CTA(4)[8]", "=WL7CTB(3)[6]", "=WL7CTC(3)[6]", "=WL7CTE(3)[6]", "=WL7CTP(4)[7]", "=WL7CTQ(4)[7]", "=WL7CUY(5)[8]", "=WL7CVD(5)[8]", "=WL7D(4)[7]", "=WL7DD(3)[6]", "=WL7EA(3)[6]", "=WL7EKK(3)[6]", "=WL7FA(3)[6]", "=WL7FC(4)[7]", "=WL7FE(4)[7]", "=WL7FJ(4)[8]", "=WL7FR(3)[6]", "=WL7FU(3)[6]", "=WL7GV(5)[8]", "=WL7H(3)[6]", "=WL7HC(4)[8]", "=WL7HE(3)[6]", "=WL7HK(3)[6]", "=WL7HL(3)[6]", "=WL7I(4)[7]", "=WL7IP(4)[8]", "=WL7IQ(3)[6]", "=WL7IS(3)[6]", "=WL7JAN(4)[8]", "=WL7JB(4)[7]", "=WL7JM(3)[6]", "=WL7K(3)[6]", "=WL7LB(3)[6]", "=WL7LK(3)[6]", "=WL7LZ(4)[7]", "=WL7ME(4)[7]", "=WL7NP(4)[8]", "=WL7OA(3)[6]", "=WL7OG(5)[8]", "=WL7OP(4)[7]", "=WL7OS(4)[8]", "=WL7OT(4)[8]", "=WL7OU(4)[7]", "=WL7P(3)[6]", "=WL7PJ(3)[6]", "=WL7QC(3)[6]", "=WL7QX(3)[6]", "=WL7RA(3)[6]", "=WL7RV(4)[7]", "=WL7SD(3)[6]", "=WL7SF(4)[8]", "=WL7SG(4)[7]", "=WL7SO(3)[6]", "=WL7SR(5)[8]", "=WL7SV(3)[6]", "=WL7T(3)[6]", "=WL7TD(4)[8]", "=WL7TG(3)[6]", "=WL7UN(5)[8]", "=WL7UU(4)[8]", "=WL7VK(3)[6]", "=WL7W(4)[7]", "=WL7WB(3)[6]", "=WL7WF(3)[6]", "=WL7WG(3)[6]", "=WL7WK(3)[6]", "=WL7WL(3)[6]", "=WL7WN(4)[7]", "=WL7WU(3)[6]", "=WL7XE(3)[6]", "=WL7XI(4)[7]", "=WL7XJ(3)[6]", "=WL7XN(3)[6]", "=WL7XR(4)[7]", "=WL7XW(3)[6]", "=WL7XZ(4)[8]", "=WL7YM(4)[7]", "=WL7YQ(3)[6]", "=WL7YX(5)[8]", "=WL7Z(3)[6]", "=WL7ZM(3)[6]", "=WP2AAO(5)[8]", "=WP2ADG(3)[6]", "=WP2AGD(5)[8]", "=WP2AGO(5)[8]", "=WP2AHC(5)[8]", "=WP2AHG(4)[7]", "=WP2B(4)[8]", "=WP2C(5)[8]", "=WP2L(5)[8]", "=WP2MA(5)[8]", "=WP2MG(5)[8]", "=WP2N(4)[7]", "=WP2P(5)[8]", "=WP2U(4)[7]", "=WP2WP(4)[7]", "=WP3AL(4)[7]", "=WP3AY(5)[8]", "=WP3BC(5)[8]", "=WP3BX(5)[8]", "=WP3CC(5)[8]", "=WP3DW(5)[8]", "=WP3EC(5)[8]", "=WP3FK(5)[8]", "=WP3GN(5)[8]", "=WP3HL(5)[8]", "=WP3JE(5)[8]", "=WP3JM(4)[7]", "=WP3JU(5)[8]", "=WP3K(5)[8]", "=WP3KU(4)[8]", "=WP3LE(5)[8]", "=WP3MB(5)[8]", "=WP3MD(5)[8]", "=WP3NIS(5)[8]", "=WP3NN(5)[8]", "=WP3O(5)[8]", "=WP3OV(3)[6]", "=WP3QE(5)[8]", "=WP3QH(4)[7]", "=WP3QV(5)[8]", "=WP3VU(5)[8]", "=WP3WV(5)[8]", "=WP3WZ(5)[8]", "=WP3Y(4)[7]", "=WP3ZA(5)[8]", "=WP4A(4)[7]", "=WP4ADA(4)[7]", "=WP4AIL(5)[8]", "=WP4AIZ(5)[8]", "=WP4AKE(5)[8]", "=WP4ALH(5)[8]", "=WP4APJ(4)[7]", "=WP4AZJ(5)[8]", "=WP4B(5)[8]", "=WP4BAB(4)[7]", "=WP4BAT(4)[7]", "=WP4BC(5)[8]", "=WP4BF(5)[8]", "=WP4BFP(5)[8]", "=WP4BGM(5)[8]", "=WP4BIN(5)[8]", "=WP4BJS(5)[8]", "=WP4BK(5)[8]", "=WP4BMU(5)[8]", "=WP4BNI(5)[8]", "=WP4BOC(5)[8]", "=WP4BQV(5)[8]", "=WP4BTQ(4)[7]", "=WP4BZ(5)[8]", "=WP4BZG(3)[6]", "=WP4CB(5)[8]", "=WP4CGI(5)[8]", "=WP4CJH(5)[8]", "=WP4CJY(4)[7]", "=WP4CKW(5)[8]", "=WP4CLS(5)[8]", "=WP4CMH(5)[8]", "=WP4CNA(4)[8]", "=WP4CUJ(3)[6]", "=WP4CW(3)[6]", "=WP4DA(5)[8]", "=WP4DC(5)[8]", "=WP4DCB(5)[8]", "=WP4DCK(5)[8]", "=WP4DME(5)[8]", "=WP4DNE(5)[8]", "=WP4DPX(5)[8]", "=WP4DWH(5)[8]", "=WP4DYP(3)[6]", "=WP4EDM(5)[8]", "=WP4ENX(5)[8]", "=WP4EVA(4)[7]", "=WP4EVL(4)[7]", "=WP4EXH(5)[8]", "=WP4EYW(5)[8]", "=WP4FEI(5)[8]", "=WP4FRK(5)[8]", "=WP4FS(5)[8]", "=WP4GAK(5)[8]", "=WP4GFH(5)[8]", "=WP4GJL(5)[8]", "=WP4GQR(4)[7]", "=WP4GR(5)[8]", "=WP4GX(5)[8]", "=WP4GYA(5)[8]", "=WP4HFZ(5)[8]", "=WP4HNN(5)[8]", "=WP4HOX(5)[8]", "=WP4HRK(5)[8]", "=WP4HSZ(5)[8]", "=WP4HXS(5)[8]", "=WP4IF(5)[8]", "=WP4IK(5)[8]", "=WP4ILP(5)[8]", "=WP4IXT(4)[7]", "=WP4JC(5)[8]", "=WP4JF(5)[8]", "=WP4JKO(5)[8]", "=WP4JQJ(5)[8]", "=WP4JSR(5)[8]", "=WP4JT(5)[8]", "=WP4KCJ(5)[8]", "=WP4KDH(5)[8]", "=WP4KFP(5)[8]", "=WP4KGF(4)[8]", "=WP4KGI(5)[8]", "=WP4KI(5)[8]", "=WP4KJV(5)[8]", "=WP4KKX(5)[8]", "=WP4KPK(5)[8]", "=WP4KQ(5)[8]", "=WP4KSP(4)[7]", "=WP4KSU(3)[6]", "=WP4KTF(4)[7]", "=WP4KTU(5)[8]", "=WP4KUW(4)[7]", "=WP4KXX(5)[8]", "=WP4LBK(5)[8]", "=WP4LC(4)[7]", "=WP4LDG(5)[8]", "=WP4LDL(5)[8]", "=WP4LDP(5)[8]", "=WP4LE(5)[8]", "=WP4LEO(5)[8]", "=WP4LFO(5)[8]", "=WP4LHA(5)[8]", "=WP4LNP(5)[8]", "=WP4LSQ(4)[8]", "=WP4LTA(5)[8]", "=WP4LYI(5)[8]", "=WP4MD(5)[8]", "=WP4MJP(4)[7]", "=WP4MKJ(5)[8]", "=WP4MMV(5)[8]", "=WP4MNV(5)[8]", "=WP4MO(5)[8]", "=WP4MOC(5)[8]", "=WP4MQF(5)[8]", "=WP4MQX(4)[8]", "=WP4MSD(4)[8]", "=WP4MSX(5)[8]", "=WP4MTN(4)[8]", "=WP4MUJ(5)[8]", "=WP4MVE(3)[6]", "=WP4MVQ(4)[8]", "=WP4MWB(4)[8]", "=WP4MWE(5)[8]", "=WP4MWS(5)[8]", "=WP4MXE(5)[8]", "=WP4MYG(5)[8]", "=WP4MYI(4)[7]", "=WP4MYK(5)[8]", "=WP4MYL(4)[8]", "=WP4MYM(5)[8]", "=WP4MYN(5)[8]", "=WP4MZO(5)[8]", "=WP4NAE(4)[8]", "=WP4NAI(5)[8]", "=WP4NAK(4)[7]", "=WP4NAQ(5)[8]", "=WP4NBF(5)[8]", "=WP4NBG(5)[8]", "=WP4NBP(3)[6]", "=WP4NBS(5)[8]", "=WP4NKU(5)[8]", "=WP4NKW(5)[8]", "=WP4NPV(4)[7]", "=WP4NQA(4)[7]", "=WP4NQL(4)[7]", "=WP4NUV(5)[8]", "=WP4NVL(5)[8]", "=WP4NWV(5)[8]", "=WP4NWW(5)[8]", "=WP4NXG(5)[8]", "=WP4NYQ(4)[8]", "=WP4NYY(5)[8]", "=WP4NZF(5)[8]", "=WP4OAT(5)[8]", "=WP4OBB(3)[6]", "=WP4OBC(3)[6]", "=WP4OBD(5)[8]", "=WP4OBH(5)[8]", "=WP4OCO(5)[8]", "=WP4OCZ(4)[8]", "=WP4ODR(5)[8]", "=WP4OEO(5)[8]", "=WP4OFA(5)[8]", "=WP4OFO(5)[8]", "=WP4OHJ(5)[8]", "=WP4OJK(5)[8]", "=WP4OLM(5)[8]", "=WP4OMG(5)[8]", "=WP4OMV(5)[8]", "=WP4ONR(5)[8]", "=WP4OOI(5)[8]", "=WP4OPD(5)[8]", "=WP4OPF(5)[8]", "=WP4OPG(5)[8]", "=WP4OPY(5)[8]", "=WP4OTP(5)[8]", "=WP4OUE(4)[7]", "=WP4OXA(5)[8]", "=WP4P(5)[8]", "=WP4PLR(4)[8]", "=WP4PPH(5)[8]", "=WP4PQN(5)[8]", "=WP4PR(5)[8]", "=WP4PUR(5)[8]", "=WP4PUV(5)[8]", "=WP4PWS(3)[6]", "=WP4PWV(5)[8]", "=WP4PXG(5)[8]", "=WP4PYL(5)[8]", "=WP4PYM(5)[8]", "=WP4PYT(5)[8]", "=WP4PYU(5)[8]", "=WP4PYV(5)[8]", "=WP4PYZ(5)[8]", "=WP4PZA(5)[8]", "=WP4QER(5)[8]", "=WP4QGV(5)[8]", "=WP4QHU(5)[8]", "=WP4QLB(4)[7]", "=WP4R(5)[8]", "=WP4RON(4)[7]", "=WP4TD(5)[8]", "=WP4TX(5)[8]", "=WP4UC(5)[8]", "=WP4UM(5)[8]", "=WP4VL(5)[8]", "=WP4VM(5)[8]", "=WP4XF(4)[8]", "=WP4YG(5)[8]"},
 		PrefixRegexp: regexp.MustCompile("^[4AGKNW]"),
 	}, {
@@ -1864,6 +2049,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     20.000000,
 		Longitude:    -75.000000,
+		UTCOffset:    -5,
 		Prefixes:     []string{"KG4", "=KG4AY", "=KG4NE"},
 		PrefixRegexp: regexp.MustCompile("^[K]"),
 	}, {
@@ -1874,6 +2060,7 @@ var Entities = []Entity{
 		ITUZone:      64,
 		Latitude:     15.180000,
 		Longitude:    145.720000,
+		UTCOffset:    10,
 		Prefixes:     []string{"AH0", "KH0", "NH0", "WH0", "=K8RN", "=NH2B"},
 		PrefixRegexp: regexp.MustCompile("^[AKNW]"),
 	}, {
@@ -1884,6 +2071,7 @@ var Entities = []Entity{
 		ITUZone:      61,
 		Latitude:     0.000000,
 		Longitude:    -176.000000,
+		UTCOffset:    -12,
 		Prefixes:     []string{"AH1", "KH1", "NH1", "WH1"},
 		PrefixRegexp: regexp.MustCompile("^[AKNW]"),
 	}, {
@@ -1894,6 +2082,7 @@ var Entities = []Entity{
 		ITUZone:      64,
 		Latitude:     13.370000,
 		Longitude:    144.700000,
+		UTCOffset:    10,
 		Prefixes:     []string{"AH2", "KH2", "NH2", "WH2", "=K4QFS", "=KG6DX", "=KG6JDX", "=KJ6AYQ", "=N0RY", "=NH0Q"},
 		PrefixRegexp: regexp.MustCompile("^[AKNW]"),
 	}, {
@@ -1904,6 +2093,7 @@ var Entities = []Entity{
 		ITUZone:      61,
 		Latitude:     16.720000,
 		Longitude:    -169.530000,
+		UTCOffset:    -10,
 		Prefixes:     []string{"AH3", "KH3", "NH3", "WH3"},
 		PrefixRegexp: regexp.MustCompile("^[AKNW]"),
 	}, {
@@ -1914,6 +2104,7 @@ var Entities = []Entity{
 		ITUZone:      61,
 		Latitude:     28.200000,
 		Longitude:    -177.370000,
+		UTCOffset:    -11,
 		Prefixes:     []string{"AH4", "KH4", "NH4", "WH4"},
 		PrefixRegexp: regexp.MustCompile("^[AKNW]"),
 	}, {
@@ -1924,6 +2115,7 @@ var Entities = []Entity{
 		ITUZone:      61,
 		Latitude:     5.870000,
 		Longitude:    -162.070000,
+		UTCOffset:    -11,
 		Prefixes:     []string{"AH5", "KH5", "NH5", "WH5"},
 		PrefixRegexp: regexp.MustCompile("^[AKNW]"),
 	}, {
@@ -1934,6 +2126,7 @@ var Entities = []Entity{
 		ITUZone:      61,
 		Latitude:     21.120000,
 		Longitude:    -157.480000,
+		UTCOffset:    -10,
 		Prefixes:     []string{"AH6", "AH7", "KH6", "KH7", "NH6", "NH7", "WH6", "WH7", "=K2GT", "=K4XV", "=K6BU", "=K6HNL", "=K9FD", "=KA7BSK", "=KB1UHL", "=KB3HXI", "=KB5OXR", "=KB6EGA", "=KC2CLQ", "=KD0OXU", "=KD4GVR", "=KD6NVX", "=KD7GWI", "=KD8LYB", "=KE0JSB", "=KE0KIE", "=KE5VQB", "=KE6MKW", "=KE6TIX", "=KF4UJC", "=KG4CAN", "=KG4TZD", "=KG5CH", "=KG5CNO", "=KG5IVP", "=KG9MDR", "=KH0WJ", "=KH3AE", "=KI7AUZ", "=KJ6COM", "=KJ6CQT", "=KJ6FDF", "=KK4RNF", "=KL0TK", "=KL3JC", "=KM6RWE", "=KM6UVP", "=N0VYO", "=N3BQY", "=N3GWR", "=N4BER", "=N7BMD", "=NB6R", "=ND1A", "=W6QPV", "=W7NX", "=WA6AW", "=WA6CZL", "=WB4JTT"},
 		PrefixRegexp: regexp.MustCompile("^[AKNW]"),
 	}, {
@@ -1944,6 +2137,7 @@ var Entities = []Entity{
 		ITUZone:      61,
 		Latitude:     29.000000,
 		Longitude:    -178.000000,
+		UTCOffset:    -10,
 		Prefixes:     []string{"AH7K", "KH7K", "NH7K", "WH7K"},
 		PrefixRegexp: regexp.MustCompile("^[AKNW]"),
 	}, {
@@ -1954,6 +2148,7 @@ var Entities = []Entity{
 		ITUZone:      62,
 		Latitude:     -14.320000,
 		Longitude:    -170.780000,
+		UTCOffset:    -11,
 		Prefixes:     []string{"AH8", "KH8", "NH8", "WH8"},
 		PrefixRegexp: regexp.MustCompile("^[AKNW]"),
 	}, {
@@ -1964,6 +2159,7 @@ var Entities = []Entity{
 		ITUZone:      62,
 		Latitude:     -11.050000,
 		Longitude:    -171.250000,
+		UTCOffset:    -11,
 		Prefixes:     []string{"=KH8S/NA6M"},
 		PrefixRegexp: regexp.MustCompile("^[K]"),
 	}, {
@@ -1974,6 +2170,7 @@ var Entities = []Entity{
 		ITUZone:      65,
 		Latitude:     19.280000,
 		Longitude:    166.630000,
+		UTCOffset:    12,
 		Prefixes:     []string{"AH9", "KH9", "NH9", "WH9"},
 		PrefixRegexp: regexp.MustCompile("^[AKNW]"),
 	}, {
@@ -1984,6 +2181,7 @@ var Entities = []Entity{
 		ITUZone:      1,
 		Latitude:     61.400000,
 		Longitude:    -148.870000,
+		UTCOffset:    -9,
 		Prefixes:     []string{"AL", "KL", "NL", "WL", "=AJ4MY", "=K1KAO", "=K1TMT", "=K1W", "=K4PSG", "=K5DOW", "=K5RD", "=K7BUF", "=K7CAP", "=K7VRK", "=KA1NCN", "=KB5NOW", "=KB7BUF", "=KC0GLN", "=KC1LVR", "=KC5NHL", "=KC9IKH", "=KD0ONB", "=KD2NPD", "=KD5MQC", "=KD7OOS", "=KD8KQL", "=KE7PXV", "=KF3L", "=KF5UBP", "=KF7FLL", "=KF7FLM", "=KF7ING", "=KF7ITN", "=KF7KTH", "=KF7WVE", "=KG5MIO", "=KG7OUF", "=KG7ZEV", "=KJ4YOY", "=KJ6DCH", "=KJ6RFQ", "=KN4ENR", "=KN4LJD", "=KN4RXC", "=KW4XD", "=N6QEK", "=N6ZZX", "=N7DKL", "=N7DUD", "=NH2LS", "=NM0H", "=NU9Q", "=W0ZEE", "=W1JM", "=W1LYD", "=W3MKG", "=WA1FVJ", "=WH6GBB", "=WH6GCO"},
 		PrefixRegexp: regexp.MustCompile("^[AKNW]"),
 	}, {
@@ -1994,6 +2192,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     18.400000,
 		Longitude:    -75.000000,
+		UTCOffset:    -5,
 		Prefixes:     []string{"KP1", "NP1", "WP1"},
 		PrefixRegexp: regexp.MustCompile("^[KNW]"),
 	}, {
@@ -2004,6 +2203,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     17.730000,
 		Longitude:    -64.800000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"KP2", "NP2", "WP2", "=KV4CF", "=KV4FZ", "=KV4KW", "=W4LIS"},
 		PrefixRegexp: regexp.MustCompile("^[KNW]"),
 	}, {
@@ -2014,6 +2214,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     18.180000,
 		Longitude:    -66.550000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"KP3", "KP4", "NP3", "NP4", "WP3", "WP4", "=K4LCR", "=KB0JRR", "=KB1CKX", "=KB2BVX", "=KB3TTV", "=KC2GRZ", "=KC2TE", "=KC5FWS", "=KF5YGN", "=KF5YGX", "=KP2Z", "=N2FVA", "=N2IBR", "=N4MMT", "=WQ2N"},
 		PrefixRegexp: regexp.MustCompile("^[KNW]"),
 	}, {
@@ -2024,6 +2225,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     18.080000,
 		Longitude:    -67.880000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"KP5", "NP5", "WP5"},
 		PrefixRegexp: regexp.MustCompile("^[KNW]"),
 	}, {
@@ -2034,6 +2236,7 @@ var Entities = []Entity{
 		ITUZone:      18,
 		Latitude:     61.000000,
 		Longitude:    9.000000,
+		UTCOffset:    1,
 		Prefixes:     []string{"LA", "LB", "LC", "LD", "LE", "LF", "LG", "LH", "LI", "LJ", "LK", "LL", "LM", "LN"},
 		PrefixRegexp: regexp.MustCompile("^[L]"),
 	}, {
@@ -2044,6 +2247,7 @@ var Entities = []Entity{
 		ITUZone:      14,
 		Latitude:     -34.800000,
 		Longitude:    -65.920000,
+		UTCOffset:    -3,
 		Prefixes:     []string{"AY", "AZ", "L1", "L2", "L3", "L4", "L5", "L6", "L7", "L8", "L9", "LO", "LP", "LQ", "LR", "LS", "LT", "LU", "LV", "LW", "AY0V[16]", "AY0W[16]", "AY0X[16]", "AY0Y[16]", "AY1V[16]", "AY1W[16]", "AY1X[16]", "AY1Y[16]", "AY2V[16]", "AY2W[16]", "AY2X[16]", "AY2Y[16]", "AY3V[16]", "AY3W[16]", "AY3X[16]", "AY3Y[16]", "AY4V[16]", "AY4W[16]", "AY4X[16]", "AY4Y[16]", "AY5V[16]", "AY5W[16]", "AY5X[16]", "AY5Y[16]", "AY6V[16]", "AY6W[16]", "AY6X[16]", "AY6Y[16]", "AY7V[16]", "AY7W[16]", "AY7X[16]", "AY7Y[16]", "AY8V[16]", "AY8W[16]", "AY8X[16]", "AY8Y[16]", "AY9V[16]", "AY9W[16]", "AY9X[16]", "AY9Y[16]", "AZ0V[16]", "AZ0W[16]", "AZ0X[16]", "AZ0Y[16]", "AZ1V[16]", "AZ1W[16]", "AZ1X[16]", "AZ1Y[16]", "AZ2V[16]", "AZ2W[16]", "AZ2X[16]", "AZ2Y[16]", "AZ3V[16]", "AZ3W[16]", "AZ3X[16]", "AZ3Y[16]", "AZ4V[16]", "AZ4W[16]", "AZ4X[16]", "AZ4Y[16]", "AZ5V[16]", "AZ5W[16]", "AZ5X[16]", "AZ5Y[16]", "AZ6V[16]", "AZ6W[16]", "AZ6X[16]", "AZ6Y[16]", "AZ7V[16]", "AZ7W[16]", "AZ7X[16]", "AZ7Y[16]", "AZ8V[16]", "AZ8W[16]", "AZ8X[16]", "AZ8Y[16]", "AZ9V[16]", "AZ9W[16]", "AZ9X[16]", "AZ9Y[16]", "L20V[16]", "L20W[16]", "L20X[16]", "L20Y[16]", "L21V[16]", "L21W[16]", "L21X[16]", "L21Y[16]", "L22V[16]", "L22W[16]", "L22X[16]", "L22Y[16]", "L23V[16]", "L23W[16]", "L23X[16]", "L23Y[16]", "L24V[16]", "L24W[16]", "L24X[16]", "L24Y[16]", "L25V[16]", "L25W[16]", "L25X[16]", "L25Y[16]", "L26V[16]", "L26W[16]", "L26X[16]", "L26Y[16]", "L27V[16]", "L27W[16]", "L27X[16]", "L27Y[16]", "L28V[16]", "L28W[16]", "L28X[16]", "L28Y[16]", "L29V[16]", "L29W[16]", "L29X[16]", "L29Y[16]", "L30V[16]", "L30W[16]", "L30X[16]", "L30Y[16]", "L31V[16]", "L31W[16]", "L31X[16]", "L31Y[16]", "L32V[16]", "L32W[16]", "L32X[16]", "L32Y[16]", "L33V[16]", "L33W[16]", "L33X[16]", "L33Y[16]", "L34V[16]", "L34W[16]", "L34X[16]", "L34Y[16]", "L35V[16]", "L35W[16]", "L35X[16]", "L35Y[16]", "L36V[16]", "L36W[16]", "L36X[16]", "L36Y[16]", "L37V[16]", "L37W[16]", "L37X[16]", "L37Y[16]", "L38V[16]", "L38W[16]", "L38X[16]", "L38Y[16]", "L39V[16]", "L39W[16]", "L39X[16]", "L39Y[16]", "L40V[16]", "L40W[16]", "L40X[16]", "L40Y[16]", "L41V[16]", "L41W[16]", "L41X[16]", "L41Y[16]", "L42V[16]", "L42W[16]", "L42X[16]", "L42Y[16]", "L43V[16]", "L43W[16]", "L43X[16]", "L43Y[16]", "L44V[16]", "L44W[16]", "L44X[16]", "L44Y[16]", "L45V[16]", "L45W[16]", "L45X[16]", "L45Y[16]", "L46V[16]", "L46W[16]", "L46X[16]", "L46Y[16]", "L47V[16]", "L47W[16]", "L47X[16]", "L47Y[16]", "L48V[16]", "L48W[16]", "L48X[16]", "L48Y[16]", "L49V[16]", "L49W[16]", "L49X[16]", "L49Y[16]", "L50V[16]", "L50W[16]", "L50X[16]", "L50Y[16]", "L51V[16]", "L51W[16]", "L51X[16]", "L51Y[16]", "L52V[16]", "L52W[16]", "L52X[16]", "L52Y[16]", "L53V[16]", "L53W[16]", "L53X[16]", "L53Y[16]", "L54V[16]", "L54W[16]", "L54X[16]", "L54Y[16]", "L55V[16]", "L55W[16]", "L55X[16]", "L55Y[16]", "L56V[16]", "L56W[16]", "L56X[16]", "L56Y[16]", "L57V[16]", "L57W[16]", "L57X[16]", "L57Y[16]", "L58V[16]", "L58W[16]", "L58X[16]", "L58Y[16]", "L59V[16]", "L59W[16]", "L59X[16]", "L59Y[16]", "L60V[16]", "L60W[16]", "L60X[16]", "L60Y[16]", "L61V[16]", "L61W[16]", "L61X[16]", "L61Y[16]", "L62V[16]", "L62W[16]", "L62X[16]", "L62Y[16]", "L63V[16]", "L63W[16]", "L63X[16]", "L63Y[16]", "L64V[16]", "L64W[16]", "L64X[16]", "L64Y[16]", "L65V[16]", "L65W[16]", "L65X[16]", "L65Y[16]", "L66V[16]", "L66W[16]", "L66X[16]", "L66Y[16]", "L67V[16]", "L67W[16]", "L67X[16]", "L67Y[16]", "L68V[16]", "L68W[16]", "L68X[16]", "L68Y[16]", "L69V[16]", "L69W[16]", "L69X[16]", "L69Y[16]", "L70V[16]", "L70W[16]", "L70X[16]", "L70Y[16]", "L71V[16]", "L71W[16]", "L71X[16]", "L71Y[16]", "L72V[16]", "L72W[16]", "L72X[16]", "L72Y[16]", "L73V[16]", "L73W[16]", "L73X[16]", "L73Y[16]", "L74V[16]", "L74W[16]", "L74X[16]", "L74Y[16]", "L75V[16]", "L75W[16]", "L75X[16]", "L75Y[16]", "L76V[16]", "L76W[16]", "L76X[16]", "L76Y[16]", "L77V[16]", "L77W[16]", "L77X[16]", "L77Y[16]", "L78V[16]", "L78W[16]", "L78X[16]", "L78Y[16]", "L79V[16]", "L79W[16]", "L79X[16]", "L79Y[16]", "L80V[16]", "L80W[16]", "L80X[16]", "L80Y[16]", "L81V[16]", "L81W[16]", "L81X[16]", "L81Y[16]", "L82V[16]", "L82W[16]", "L82X[16]", "L82Y[16]", "L83V[16]", "L83W[16]", "L83X[16]", "L83Y[16]", "L84V[16]", "L84W[16]", "L84X[16]", "L84Y[16]", "L85V[16]", "L85W[16]", "L85X[16]", "L85Y[16]", "L86V[16]", "L86W[16]", "L86X[16]", "L86Y[16]", "L87V[16]", "L87W[16]", "L87X[16]", "L87Y[16]", "L88V[16]", "L88W[16]", "L88X[16]", "L88Y[16]", "L89V[16]", "L89W[16]", "L89X[16]", "L89Y[16]", "L90V[16]", "L90W[16]", "L90X[16]", "L90Y[16]", "L91V[16]", "L91W[16]", "L91X[16]", "L91Y[16]", "L92V[16]", "L92W[16]", "L92X[16]", "L92Y[16]", "L93V[16]", "L93W[16]", "L93X[16]", "L93Y[16]", "L94V[16]", "L94W[16]", "L94X[16]", "L94Y[16]", "L95V[16]", "L95W[16]", "L95X[16]", "L95Y[16]", "L96V[16]", "L96W[16]", "L96X[16]", "L96Y[16]", "L97V[16]", "L97W[16]", "L97X[16]", "L97Y[16]", "L98V[16]", "L98W[16]", "L98X[16]", "L98Y[16]", "L99V[16]", "L99W[16]", "L99X[16]", "L99Y[16]", "LO0V[16]", "LO0W[16]", "LO0X[16]", "LO0Y[16]", "LO1V[16]", "LO1W[16]", "LO1X[16]", "LO1Y[16]", "LO2V[16]", "LO2W[16]", "LO2X[16]", "LO2Y[16]", "LO3V[16]", "LO3W[16]", "LO3X[16]", "LO3Y[16]", "LO4V[16]", "LO4W[16]", "LO4X[16]", "LO4Y[16]", "LO5V[16]", "LO5W[16]", "LO5X[16]", "LO5Y[16]", "LO6V[16]", "LO6W[16]", "LO6X[16]", "LO6Y[16]", "LO7V[16]", "LO7W[16]", "LO7X[16]", "LO7Y[16]", "LO8V[16]", "LO8W[16]", "LO8X[16]", "LO8Y[16]", "LO9V[16]", "LO9W[16]", "LO9X[16]", "LO9Y[16]", "LP0V[16]", "LP0W[16]", "LP0X[16]", "LP0Y[16]", "LP1V[16]", "LP1W[16]", "LP1X[16]", "LP1Y[16]", "LP2V[16]", "LP2W[16]", "LP2X[16]", "LP2Y[16]", "LP3V[16]", "LP3W[16]", "LP3X[16]", "LP3Y[16]", "LP4V[16]", "LP4W[16]", "LP4X[16]", "LP4Y[16]", "LP5V[16]", "LP5W[16]", "LP5X[16]", "LP5Y[16]", "LP6V[16]", "LP6W[16]", "LP6X[16]", "LP6Y[16]", "LP7V[16]", "LP7W[16]", "LP7X[16]", "LP7Y[16]", "LP8V[16]", "LP8W[16]", "LP8X[16]", "LP8Y[16]", "LP9V[16]", "LP9W[16]", "LP9X[16]", "LP9Y[16]", "LQ0V[16]", "LQ0W[16]", "LQ0X[16]", "LQ0Y[16]", "LQ1V[16]", "LQ1W[16]", "LQ1X[16]", "LQ1Y[16]", "LQ2V[16]", "LQ2W[16]", "LQ2X[16]", "LQ2Y[16]", "LQ3V[16]", "LQ3W[16]", "LQ3X[16]", "LQ3Y[16]", "LQ4V[16]", "LQ4W[16]", "LQ4X[16]", "LQ4Y[16]", "LQ5V[16]", "LQ5W[16]", "LQ5X[16]", "LQ5Y[16]", "LQ6V[16]", "LQ6W[16]", "LQ6X[16]", "LQ6Y[16]", "LQ7V[16]", "LQ7W[16]", "LQ7X[16]", "LQ7Y[16]", "LQ8V[16]", "LQ8W[16]", "LQ8X[16]", "LQ8Y[16]", "LQ9V[16]", "LQ9W[16]", "LQ9X[16]", "LQ9Y[16]", "LR0V[16]", "LR0W[16]", "LR0X[16]", "LR0Y[16]", "LR1V[16]", "LR1W[16]", "LR1X[16]", "LR1Y[16]", "LR2V[16]", "LR2W[16]", "LR2X[16]", "LR2Y[16]", "LR3V[16]", "LR3W[16]", "LR3X[16]", "LR3Y[16]", "LR4V[16]", "LR4W[16]", "LR4X[16]", "LR4Y[16]", "LR5V[16]", "LR5W[16]", "LR5X[16]", "LR5Y[16]", "LR6V[16]", "LR6W[16]", "LR6X[16]", "LR6Y[16]", "LR7V[16]", "LR7W[16]", "LR7X[16]", "LR7Y[16]", "LR8V[16]", "LR8W[16]", "LR8X[16]", "LR8Y[16]", "LR9V[16]", "LR9W[16]", "LR9X[16]", "LR9Y[16]", "LS0V[16]", "LS0W[16]", "LS0X[16]", "LS0Y[16]", "LS1V[16]", "LS1W[16]", "LS1X[16]", "LS1Y[16]", "LS2V[16]", "LS2W[16]", "LS2X[16]", "LS2Y[16]", "LS3V[16]", "LS3W[16]", "LS3X[16]", "LS3Y[16]", "LS4V[16]", "LS4W[16]", "LS4X[16]", "LS4Y[16]", "LS5V[16]", "LS5W[16]", "LS5X[16]", "LS5Y[16]", "LS6V[16]", "LS6W[16]", "LS6X[16]", "LS6Y[16]", "LS7V[16]", "LS7W[16]", "LS7X[16]", "LS7Y[16]", "LS8V[16]", "LS8W[16]", "LS8X[16]", "LS8Y[16]", "LS9V[16]", "LS9W[16]", "LS9X[16]", "LS9Y[16]", "LT0V[16]", "LT0W[16]", "LT0X[16]", "LT0Y[16]", "LT1V[16]", "LT1W[16]", "LT1X[16]", "LT1Y[16]", "LT2V[16]", "LT2W[16]", "LT2X[16]", "LT2Y[16]", "LT3V[16]", "LT3W[16]", "LT3X[16]", "LT3Y[16]", "LT4V[16]", "LT4W[16]", "LT4X[16]", "LT4Y[16]", "LT5V[16]", "LT5W[16]", "LT5X[16]", "LT5Y[16]", "LT6V[16]", "LT6W[16]", "LT6X[16]", "LT6Y[16]", "LT7V[16]", "LT7W[16]", "LT7X[16]", "LT7Y[16]", "LT8V[16]", "LT8W[16]", "LT8X[16]", "LT8Y[16]", "LT9V[16]", "LT9W[16]", "LT9X[16]", "LT9Y[16]", "LU0V[16]", "LU0W[16]", "LU0X[16]", "LU0Y[16]", "LU1V[16]", "LU1W[16]", "LU1X[16]", "LU1Y[16]", "LU2V[16]", "LU2W[16]", "LU2X[16]", "LU2Y[16]", "LU3V[16]", "LU3W[16]", "LU3X[16]", "LU3Y[16]", "LU4V[16]", "LU4W[16]", "LU4X[16]", "LU4Y[16]", "LU5V[16]", "LU5W[16]", "LU5X[16]", "LU5Y[16]", "LU6V[16]", "LU6W[16]", "LU6X[16]", "LU6Y[16]", "LU7V[16]", "LU7W[16]", "LU7X[16]", "LU7Y[16]", "LU8V[16]", "LU8W[16]", "LU8X[16]", "LU8Y[16]", "LU9V[16]", "LU9W[16]", "LU9X[16]", "LU9Y[16]", "LV0V[16]", "LV0W[16]", "LV0X[16]", "LV0Y[16]", "LV1V[16]", "LV1W[16]", "LV1X[16]", "LV1Y[16]", "LV2V[16]", "LV2W[16]", "LV2X[16]", "LV2Y[16]", "LV3V[16]", "LV3W[16]", "LV3X[16]", "LV3Y[16]", "LV4V[16]", "LV4W[16]", "LV4X[16]", "LV4Y[16]", "LV5V[16]", "LV5W[16]", "LV5X[16]", "LV5Y[16]", "LV6V[16]", "LV6W[16]", "LV6X[16]", "LV6Y[16]", "LV7V[16]", "LV7W[16]", "LV7X[16]", "LV7Y[16]", "LV8V[16]", "LV8W[16]", "LV8X[16]", "LV8Y[16]", "LV9V[16]", "LV9W[16]", "LV9X[16]", "LV9Y[16]", "LW0V[16]", "LW0W[16]", "LW0X[16]", "LW0Y[16]", "LW1V[16]", "LW1W[16]", "LW1X[16]", "LW1Y[16]", "LW2V[16]", "LW2W[16]", "LW2X[16]", "LW2Y[16]", "LW3V[16]", "LW3W[16]", "LW3X[16]", "LW3Y[16]", "LW4V[16]", "LW4W[16]", "LW4X[16]", "LW4Y[16]", "LW5V[16]", "LW5W[16]", "LW5X[16]", "LW5Y[16]", "LW6V[16]", "LW6W[16]", "LW6X[16]", "LW6Y[16]", "LW7V[16]", "LW7W[16]", "LW7X[16]", "LW7Y[16]", "LW8V[16]", "LW8W[16]", "LW8X[16]", "LW8Y[16]", "LW9V[16]", "LW9W[16]", "LW9X[16]", "LW9Y[16]", "=LU1AW/X[16]", "=LU2DVI/H", "=LU4ARU/D", "=LU4ERS/I", "=LU7JMS/H", "=LU8XW/XP[16]", "=LU9DPI/I"},
 		PrefixRegexp: regexp.MustCompile("^[AL]"),
 	}, {
@@ -2054,6 +2258,7 @@ var Entities = []Entity{
 		ITUZone:      27,
 		Latitude:     50.000000,
 		Longitude:    6.000000,
+		UTCOffset:    1,
 		Prefixes:     []string{"LX"},
 		PrefixRegexp: regexp.MustCompile("^[L]"),
 	}, {
@@ -2064,6 +2269,7 @@ var Entities = []Entity{
 		ITUZone:      29,
 		Latitude:     55.450000,
 		Longitude:    23.630000,
+		UTCOffset:    2,
 		Prefixes:     []string{"LY"},
 		PrefixRegexp: regexp.MustCompile("^[L]"),
 	}, {
@@ -2074,6 +2280,7 @@ var Entities = []Entity{
 		ITUZone:      28,
 		Latitude:     42.830000,
 		Longitude:    25.080000,
+		UTCOffset:    2,
 		Prefixes:     []string{"LZ"},
 		PrefixRegexp: regexp.MustCompile("^[L]"),
 	}, {
@@ -2084,6 +2291,7 @@ var Entities = []Entity{
 		ITUZone:      12,
 		Latitude:     -10.000000,
 		Longitude:    -76.000000,
+		UTCOffset:    -5,
 		Prefixes:     []string{"4T", "OA", "OB", "OC"},
 		PrefixRegexp: regexp.MustCompile("^[4O]"),
 	}, {
@@ -2094,6 +2302,7 @@ var Entities = []Entity{
 		ITUZone:      39,
 		Latitude:     33.830000,
 		Longitude:    35.830000,
+		UTCOffset:    2,
 		Prefixes:     []string{"OD"},
 		PrefixRegexp: regexp.MustCompile("^[O]"),
 	}, {
@@ -2104,6 +2313,7 @@ var Entities = []Entity{
 		ITUZone:      28,
 		Latitude:     47.330000,
 		Longitude:    13.330000,
+		UTCOffset:    1,
 		Prefixes:     []string{"OE", "=4U0R", "=4U1A", "=4U1VIC", "=4U2STAYHOME", "=4U2U", "=4Y1A", "=C7A"},
 		PrefixRegexp: regexp.MustCompile("^[4CO]"),
 	}, {
@@ -2114,6 +2324,7 @@ var Entities = []Entity{
 		ITUZone:      18,
 		Latitude:     63.780000,
 		Longitude:    27.080000,
+		UTCOffset:    2,
 		Prefixes:     []string{"OF", "OG", "OH", "OI", "OJ"},
 		PrefixRegexp: regexp.MustCompile("^[O]"),
 	}, {
@@ -2124,6 +2335,7 @@ var Entities = []Entity{
 		ITUZone:      18,
 		Latitude:     60.130000,
 		Longitude:    20.370000,
+		UTCOffset:    2,
 		Prefixes:     []string{"OF0", "OG0", "OH0", "OI0"},
 		PrefixRegexp: regexp.MustCompile("^[O]"),
 	}, {
@@ -2134,6 +2346,7 @@ var Entities = []Entity{
 		ITUZone:      18,
 		Latitude:     60.000000,
 		Longitude:    19.000000,
+		UTCOffset:    2,
 		Prefixes:     []string{"OJ0"},
 		PrefixRegexp: regexp.MustCompile("^[O]"),
 	}, {
@@ -2144,6 +2357,7 @@ var Entities = []Entity{
 		ITUZone:      28,
 		Latitude:     50.000000,
 		Longitude:    16.000000,
+		UTCOffset:    1,
 		Prefixes:     []string{"OK", "OL"},
 		PrefixRegexp: regexp.MustCompile("^[O]"),
 	}, {
@@ -2154,6 +2368,7 @@ var Entities = []Entity{
 		ITUZone:      28,
 		Latitude:     49.000000,
 		Longitude:    20.000000,
+		UTCOffset:    1,
 		Prefixes:     []string{"OM"},
 		PrefixRegexp: regexp.MustCompile("^[O]"),
 	}, {
@@ -2164,6 +2379,7 @@ var Entities = []Entity{
 		ITUZone:      27,
 		Latitude:     50.700000,
 		Longitude:    4.850000,
+		UTCOffset:    1,
 		Prefixes:     []string{"ON", "OO", "OP", "OQ", "OR", "OS", "OT"},
 		PrefixRegexp: regexp.MustCompile("^[O]"),
 	}, {
@@ -2174,6 +2390,7 @@ var Entities = []Entity{
 		ITUZone:      5,
 		Latitude:     74.000000,
 		Longitude:    -42.780000,
+		UTCOffset:    -2,
 		Prefixes:     []string{"OX", "XP"},
 		PrefixRegexp: regexp.MustCompile("^[OX]"),
 	}, {
@@ -2184,6 +2401,7 @@ var Entities = []Entity{
 		ITUZone:      18,
 		Latitude:     62.070000,
 		Longitude:    -6.930000,
+		UTCOffset:    0,
 		Prefixes:     []string{"OW", "OY"},
 		PrefixRegexp: regexp.MustCompile("^[O]"),
 	}, {
@@ -2194,6 +2412,7 @@ var Entities = []Entity{
 		ITUZone:      18,
 		Latitude:     56.000000,
 		Longitude:    10.000000,
+		UTCOffset:    1,
 		Prefixes:     []string{"5P", "5Q", "OU", "OV", "OZ"},
 		PrefixRegexp: regexp.MustCompile("^[5O]"),
 	}, {
@@ -2204,6 +2423,7 @@ var Entities = []Entity{
 		ITUZone:      51,
 		Latitude:     -9.500000,
 		Longitude:    147.120000,
+		UTCOffset:    10,
 		Prefixes:     []string{"P2"},
 		PrefixRegexp: regexp.MustCompile("^[P]"),
 	}, {
@@ -2214,6 +2434,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     12.530000,
 		Longitude:    -69.980000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"P4"},
 		PrefixRegexp: regexp.MustCompile("^[P]"),
 	}, {
@@ -2224,6 +2445,7 @@ var Entities = []Entity{
 		ITUZone:      44,
 		Latitude:     39.780000,
 		Longitude:    126.300000,
+		UTCOffset:    9,
 		Prefixes:     []string{"P5", "P6", "P7", "P8", "P9"},
 		PrefixRegexp: regexp.MustCompile("^[P]"),
 	}, {
@@ -2234,6 +2456,7 @@ var Entities = []Entity{
 		ITUZone:      27,
 		Latitude:     52.280000,
 		Longitude:    5.470000,
+		UTCOffset:    1,
 		Prefixes:     []string{"PA", "PB", "PC", "PD", "PE", "PF", "PG", "PH", "PI"},
 		PrefixRegexp: regexp.MustCompile("^[P]"),
 	}, {
@@ -2244,6 +2467,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     12.170000,
 		Longitude:    -69.000000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"PJ2"},
 		PrefixRegexp: regexp.MustCompile("^[P]"),
 	}, {
@@ -2254,6 +2478,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     12.200000,
 		Longitude:    -68.250000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"PJ4"},
 		PrefixRegexp: regexp.MustCompile("^[P]"),
 	}, {
@@ -2264,6 +2489,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     17.570000,
 		Longitude:    -63.100000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"PJ5", "PJ6"},
 		PrefixRegexp: regexp.MustCompile("^[P]"),
 	}, {
@@ -2274,6 +2500,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     18.070000,
 		Longitude:    -63.070000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"PJ0", "PJ7", "PJ8"},
 		PrefixRegexp: regexp.MustCompile("^[P]"),
 	}, {
@@ -2284,6 +2511,7 @@ var Entities = []Entity{
 		ITUZone:      15,
 		Latitude:     -10.000000,
 		Longitude:    -53.000000,
+		UTCOffset:    -3,
 		Prefixes:     []string{"PP", "PQ", "PR", "PS", "PT", "PU", "PV", "PW", "PX", "PY", "ZV", "ZW", "ZX", "ZY", "ZZ", "PP6[13]", "PP7[13]", "PP8[12]", "PQ2[13]", "PQ8[13]", "PR7[13]", "PR8[13]", "PS7[13]", "PS8[13]", "PT2[13]", "PT7[13]", "PT8[12]", "PV8[12]", "PW8[12]", "PY6[13]", "PY7[13]", "PY8[13]", "PY9[13]"},
 		PrefixRegexp: regexp.MustCompile("^[PZ]"),
 	}, {
@@ -2294,6 +2522,7 @@ var Entities = []Entity{
 		ITUZone:      13,
 		Latitude:     -3.850000,
 		Longitude:    -32.430000,
+		UTCOffset:    -2,
 		Prefixes:     []string{"PP0F", "PP0ZF", "PQ0F", "PQ0ZF", "PR0F", "PR0ZF", "PS0F", "PS0ZF", "PT0F", "PT0ZF", "PU0F", "PU0ZF", "PV0F", "PV0ZF", "PW0F", "PW0ZF", "PX0F", "PX0ZF", "PY0F", "PY0Z", "ZV0F", "ZV0ZF", "ZW0F", "ZW0ZF", "ZX0F", "ZX0ZF", "ZY0F", "ZY0Z", "ZZ0F", "ZZ0ZF", "PP0R", "PP0ZR", "PQ0R", "PQ0ZR", "PR0R", "PR0ZR", "PS0R", "PS0ZR", "PT0R", "PT0ZR", "PU0R", "PU0ZR", "PV0R", "PV0ZR", "PW0R", "PW0ZR", "PX0R", "PX0ZR", "PY0R", "ZV0R", "ZV0ZR", "ZW0R", "ZW0ZR", "ZX0R", "ZX0ZR", "ZY0R", "ZZ0R", "ZZ0ZR"},
 		PrefixRegexp: regexp.MustCompile("^[PZ]"),
 	}, {
@@ -2304,6 +2533,7 @@ var Entities = []Entity{
 		ITUZone:      13,
 		Latitude:     0.000000,
 		Longitude:    -29.000000,
+		UTCOffset:    -2,
 		Prefixes:     []string{"PP0S", "PP0ZS", "PQ0S", "PQ0ZS", "PR0S", "PR0ZS", "PS0S", "PS0ZS", "PT0S", "PT0ZS", "PU0S", "PU0ZS", "PV0S", "PV0ZS", "PW0S", "PW0ZS", "PX0S", "PX0ZS", "PY0S", "PY0ZS", "ZV0S", "ZV0ZS", "ZW0S", "ZW0ZS", "ZX0S", "ZX0ZS", "ZY0S", "ZY0ZS", "ZZ0S", "ZZ0ZS"},
 		PrefixRegexp: regexp.MustCompile("^[PZ]"),
 	}, {
@@ -2314,6 +2544,7 @@ var Entities = []Entity{
 		ITUZone:      15,
 		Latitude:     -20.500000,
 		Longitude:    -29.320000,
+		UTCOffset:    -2,
 		Prefixes:     []string{"PP0T", "PP0ZT", "PQ0T", "PQ0ZT", "PR0T", "PR0ZT", "PS0T", "PS0ZT", "PT0T", "PT0ZT", "PU0T", "PU0ZT", "PV0T", "PV0ZT", "PW0T", "PW0ZT", "PX0T", "PX0ZT", "PY0T", "PY0ZT", "ZV0T", "ZV0ZT", "ZW0T", "ZW0ZT", "ZX0T", "ZX0ZT", "ZY0T", "ZY0ZT", "ZZ0T", "ZZ0ZT"},
 		PrefixRegexp: regexp.MustCompile("^[PZ]"),
 	}, {
@@ -2324,6 +2555,7 @@ var Entities = []Entity{
 		ITUZone:      12,
 		Latitude:     4.000000,
 		Longitude:    -56.000000,
+		UTCOffset:    -3,
 		Prefixes:     []string{"PZ"},
 		PrefixRegexp: regexp.MustCompile("^[P]"),
 	}, {
@@ -2334,6 +2566,7 @@ var Entities = []Entity{
 		ITUZone:      75,
 		Latitude:     80.680000,
 		Longitude:    49.920000,
+		UTCOffset:    3,
 		Prefixes:     []string{"RI1F"},
 		PrefixRegexp: regexp.MustCompile("^[R]"),
 	}, {
@@ -2344,6 +2577,7 @@ var Entities = []Entity{
 		ITUZone:      46,
 		Latitude:     24.820000,
 		Longitude:    -13.850000,
+		UTCOffset:    0,
 		Prefixes:     []string{"S0"},
 		PrefixRegexp: regexp.MustCompile("^[S]"),
 	}, {
@@ -2354,6 +2588,7 @@ var Entities = []Entity{
 		ITUZone:      41,
 		Latitude:     24.120000,
 		Longitude:    89.650000,
+		UTCOffset:    6,
 		Prefixes:     []string{"S2", "S3"},
 		PrefixRegexp: regexp.MustCompile("^[S]"),
 	}, {
@@ -2364,6 +2599,7 @@ var Entities = []Entity{
 		ITUZone:      28,
 		Latitude:     46.000000,
 		Longitude:    14.000000,
+		UTCOffset:    1,
 		Prefixes:     []string{"S5"},
 		PrefixRegexp: regexp.MustCompile("^[S]"),
 	}, {
@@ -2374,6 +2610,7 @@ var Entities = []Entity{
 		ITUZone:      53,
 		Latitude:     -4.670000,
 		Longitude:    55.470000,
+		UTCOffset:    4,
 		Prefixes:     []string{"S7"},
 		PrefixRegexp: regexp.MustCompile("^[S]"),
 	}, {
@@ -2384,6 +2621,7 @@ var Entities = []Entity{
 		ITUZone:      47,
 		Latitude:     0.220000,
 		Longitude:    6.570000,
+		UTCOffset:    0,
 		Prefixes:     []string{"S9"},
 		PrefixRegexp: regexp.MustCompile("^[S]"),
 	}, {
@@ -2394,6 +2632,7 @@ var Entities = []Entity{
 		ITUZone:      18,
 		Latitude:     61.200000,
 		Longitude:    14.570000,
+		UTCOffset:    1,
 		Prefixes:     []string{"7S", "8S", "SA", "SB", "SC", "SD", "SE", "SF", "SG", "SH", "SI", "SJ", "SK", "SL", "SM"},
 		PrefixRegexp: regexp.MustCompile("^[78S]"),
 	}, {
@@ -2404,6 +2643,7 @@ var Entities = []Entity{
 		ITUZone:      28,
 		Latitude:     52.280000,
 		Longitude:    18.670000,
+		UTCOffset:    1,
 		Prefixes:     []string{"3Z", "HF", "SN", "SO", "SP", "SQ", "SR"},
 		PrefixRegexp: regexp.MustCompile("^[3HS]"),
 	}, {
@@ -2414,6 +2654,7 @@ var Entities = []Entity{
 		ITUZone:      48,
 		Latitude:     14.470000,
 		Longitude:    28.620000,
+		UTCOffset:    2,
 		Prefixes:     []string{"6T", "6U", "ST"},
 		PrefixRegexp: regexp.MustCompile("^[6S]"),
 	}, {
@@ -2424,6 +2665,7 @@ var Entities = []Entity{
 		ITUZone:      38,
 		Latitude:     26.280000,
 		Longitude:    28.600000,
+		UTCOffset:    2,
 		Prefixes:     []string{"6A", "6B", "SS", "SU"},
 		PrefixRegexp: regexp.MustCompile("^[6S]"),
 	}, {
@@ -2434,6 +2676,7 @@ var Entities = []Entity{
 		ITUZone:      28,
 		Latitude:     39.780000,
 		Longitude:    21.780000,
+		UTCOffset:    2,
 		Prefixes:     []string{"J4", "SV", "SW", "SX", "SY", "SZ"},
 		PrefixRegexp: regexp.MustCompile("^[JS]"),
 	}, {
@@ -2444,6 +2687,7 @@ var Entities = []Entity{
 		ITUZone:      28,
 		Latitude:     40.000000,
 		Longitude:    24.000000,
+		UTCOffset:    2,
 		Prefixes:     []string{"=SV2ASP/A", "=SV2RSG/A"},
 		PrefixRegexp: regexp.MustCompile("^[S]"),
 	}, {
@@ -2454,6 +2698,7 @@ var Entities = []Entity{
 		ITUZone:      28,
 		Latitude:     36.170000,
 		Longitude:    27.930000,
+		UTCOffset:    2,
 		Prefixes:     []string{"J45", "SV5", "SW5", "SX5", "SY5", "SZ5"},
 		PrefixRegexp: regexp.MustCompile("^[JS]"),
 	}, {
@@ -2464,6 +2709,7 @@ var Entities = []Entity{
 		ITUZone:      28,
 		Latitude:     35.230000,
 		Longitude:    24.780000,
+		UTCOffset:    2,
 		Prefixes:     []string{"J49", "SV9", "SW9", "SX9", "SY9", "SZ9", "=SV0XAZ"},
 		PrefixRegexp: regexp.MustCompile("^[JS]"),
 	}, {
@@ -2474,6 +2720,7 @@ var Entities = []Entity{
 		ITUZone:      65,
 		Latitude:     -8.500000,
 		Longitude:    179.200000,
+		UTCOffset:    12,
 		Prefixes:     []string{"T2"},
 		PrefixRegexp: regexp.MustCompile("^[T]"),
 	}, {
@@ -2484,6 +2731,7 @@ var Entities = []Entity{
 		ITUZone:      65,
 		Latitude:     1.420000,
 		Longitude:    173.000000,
+		UTCOffset:    12,
 		Prefixes:     []string{"T30"},
 		PrefixRegexp: regexp.MustCompile("^[T]"),
 	}, {
@@ -2494,6 +2742,7 @@ var Entities = []Entity{
 		ITUZone:      62,
 		Latitude:     -2.830000,
 		Longitude:    -171.720000,
+		UTCOffset:    13,
 		Prefixes:     []string{"T31"},
 		PrefixRegexp: regexp.MustCompile("^[T]"),
 	}, {
@@ -2504,6 +2753,7 @@ var Entities = []Entity{
 		ITUZone:      61,
 		Latitude:     1.800000,
 		Longitude:    -157.350000,
+		UTCOffset:    14,
 		Prefixes:     []string{"T32"},
 		PrefixRegexp: regexp.MustCompile("^[T]"),
 	}, {
@@ -2514,6 +2764,7 @@ var Entities = []Entity{
 		ITUZone:      65,
 		Latitude:     -0.880000,
 		Longitude:    169.530000,
+		UTCOffset:    12,
 		Prefixes:     []string{"T33"},
 		PrefixRegexp: regexp.MustCompile("^[T]"),
 	}, {
@@ -2524,6 +2775,7 @@ var Entities = []Entity{
 		ITUZone:      48,
 		Latitude:     2.030000,
 		Longitude:    45.350000,
+		UTCOffset:    3,
 		Prefixes:     []string{"6O", "T5"},
 		PrefixRegexp: regexp.MustCompile("^[6T]"),
 	}, {
@@ -2534,6 +2786,7 @@ var Entities = []Entity{
 		ITUZone:      28,
 		Latitude:     43.950000,
 		Longitude:    12.450000,
+		UTCOffset:    1,
 		Prefixes:     []string{"T7"},
 		PrefixRegexp: regexp.MustCompile("^[T]"),
 	}, {
@@ -2544,6 +2797,7 @@ var Entities = []Entity{
 		ITUZone:      64,
 		Latitude:     7.450000,
 		Longitude:    134.530000,
+		UTCOffset:    9,
 		Prefixes:     []string{"T8"},
 		PrefixRegexp: regexp.MustCompile("^[T]"),
 	}, {
@@ -2554,6 +2808,7 @@ var Entities = []Entity{
 		ITUZone:      39,
 		Latitude:     39.180000,
 		Longitude:    35.650000,
+		UTCOffset:    3,
 		Prefixes:     []string{"TA", "TB", "TC", "YM", "=TA1BZ/2", "=TA1C/2", "=TA1D/3", "=TA1D/4", "=TA1FA/2", "=TA1HZ/2"},
 		PrefixRegexp: regexp.MustCompile("^[TY]"),
 	}, {
@@ -2564,6 +2819,7 @@ var Entities = []Entity{
 		ITUZone:      39,
 		Latitude:     41.020000,
 		Longitude:    28.970000,
+		UTCOffset:    3,
 		Prefixes:     []string{"TA1", "TB1", "TC1", "YM1", "=TA6CQ/1"},
 		PrefixRegexp: regexp.MustCompile("^[TY]"),
 	}, {
@@ -2574,6 +2830,7 @@ var Entities = []Entity{
 		ITUZone:      17,
 		Latitude:     64.800000,
 		Longitude:    -18.730000,
+		UTCOffset:    0,
 		Prefixes:     []string{"TF"},
 		PrefixRegexp: regexp.MustCompile("^[T]"),
 	}, {
@@ -2584,6 +2841,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     15.500000,
 		Longitude:    -90.300000,
+		UTCOffset:    -6,
 		Prefixes:     []string{"TD", "TG"},
 		PrefixRegexp: regexp.MustCompile("^[T]"),
 	}, {
@@ -2594,6 +2852,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     10.000000,
 		Longitude:    -84.000000,
+		UTCOffset:    -6,
 		Prefixes:     []string{"TE", "TI"},
 		PrefixRegexp: regexp.MustCompile("^[T]"),
 	}, {
@@ -2604,6 +2863,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     5.520000,
 		Longitude:    -87.050000,
+		UTCOffset:    -6,
 		Prefixes:     []string{"TE9", "TI9"},
 		PrefixRegexp: regexp.MustCompile("^[T]"),
 	}, {
@@ -2614,6 +2874,7 @@ var Entities = []Entity{
 		ITUZone:      47,
 		Latitude:     5.380000,
 		Longitude:    11.870000,
+		UTCOffset:    1,
 		Prefixes:     []string{"TJ"},
 		PrefixRegexp: regexp.MustCompile("^[T]"),
 	}, {
@@ -2624,6 +2885,7 @@ var Entities = []Entity{
 		ITUZone:      28,
 		Latitude:     42.000000,
 		Longitude:    9.000000,
+		UTCOffset:    1,
 		Prefixes:     []string{"TK"},
 		PrefixRegexp: regexp.MustCompile("^[T]"),
 	}, {
@@ -2634,6 +2896,7 @@ var Entities = []Entity{
 		ITUZone:      47,
 		Latitude:     6.750000,
 		Longitude:    20.330000,
+		UTCOffset:    1,
 		Prefixes:     []string{"TL"},
 		PrefixRegexp: regexp.MustCompile("^[T]"),
 	}, {
@@ -2644,6 +2907,7 @@ var Entities = []Entity{
 		ITUZone:      52,
 		Latitude:     -1.020000,
 		Longitude:    15.370000,
+		UTCOffset:    1,
 		Prefixes:     []string{"TN"},
 		PrefixRegexp: regexp.MustCompile("^[T]"),
 	}, {
@@ -2654,6 +2918,7 @@ var Entities = []Entity{
 		ITUZone:      52,
 		Latitude:     -0.370000,
 		Longitude:    11.730000,
+		UTCOffset:    1,
 		Prefixes:     []string{"TR"},
 		PrefixRegexp: regexp.MustCompile("^[T]"),
 	}, {
@@ -2664,6 +2929,7 @@ var Entities = []Entity{
 		ITUZone:      47,
 		Latitude:     15.800000,
 		Longitude:    18.170000,
+		UTCOffset:    1,
 		Prefixes:     []string{"TT"},
 		PrefixRegexp: regexp.MustCompile("^[T]"),
 	}, {
@@ -2674,6 +2940,7 @@ var Entities = []Entity{
 		ITUZone:      46,
 		Latitude:     7.580000,
 		Longitude:    -5.800000,
+		UTCOffset:    0,
 		Prefixes:     []string{"TU"},
 		PrefixRegexp: regexp.MustCompile("^[T]"),
 	}, {
@@ -2684,6 +2951,7 @@ var Entities = []Entity{
 		ITUZone:      46,
 		Latitude:     9.870000,
 		Longitude:    2.250000,
+		UTCOffset:    1,
 		Prefixes:     []string{"TY"},
 		PrefixRegexp: regexp.MustCompile("^[T]"),
 	}, {
@@ -2694,6 +2962,7 @@ var Entities = []Entity{
 		ITUZone:      46,
 		Latitude:     18.000000,
 		Longitude:    -2.580000,
+		UTCOffset:    0,
 		Prefixes:     []string{"TZ"},
 		PrefixRegexp: regexp.MustCompile("^[T]"),
 	}, {
@@ -2704,6 +2973,7 @@ var Entities = []Entity{
 		ITUZone:      29,
 		Latitude:     53.650000,
 		Longitude:    41.370000,
+		UTCOffset:    3,
 		Prefixes:     []string{"R", "U", "R1I(17)[20]", "R1N[19]", "R1O[19]", "R1P[20]", "R1Z[19]", "R4H[30]", "R4I[30]", "R4W[30]", "R8F(17)[30]", "R8G(17)[30]", "R8X(17)[20]", "R9F(17)[30]", "R9G(17)[30]", "R9X(17)[20]", "RA1I(17)[20]", "RA1N[19]", "RA1O[19]", "RA1P[20]", "RA1Z[19]", "RA4H[30]", "RA4I[30]", "RA4W[30]", "RA8F(17)[30]", "RA8G(17)[30]", "RA8X(17)[20]", "RA9F(17)[30]", "RA9G(17)[30]", "RA9X(17)[20]", "RC1I(17)[20]", "RC1N[19]", "RC1O[19]", "RC1P[20]", "RC1Z[19]", "RC4H[30]", "RC4I[30]", "RC4W[30]", "RC8F(17)[30]", "RC8G(17)[30]", "RC8X(17)[20]", "RC9F(17)[30]", "RC9G(17)[30]", "RC9X(17)[20]", "RD1I(17)[20]", "RD1N[19]", "RD1O[19]", "RD1P[20]", "RD1Z[19]", "RD4H[30]", "RD4I[30]", "RD4W[30]", "RD8F(17)[30]", "RD8G(17)[30]", "RD8X(17)[20]", "RD9F(17)[30]", "RD9G(17)[30]", "RD9X(17)[20]", "RE1I(17)[20]", "RE1N[19]", "RE1O[19]", "RE1P[20]", "RE1Z[19]", "RE4H[30]", "RE4I[30]", "RE4W[30]", "RE8F(17)[30]", "RE8G(17)[30]", "RE8X(17)[20]", "RE9F(17)[30]", "RE9G(17)[30]", "RE9X(17)[20]", "RF1I(17)[20]", "RF1N[19]", "RF1O[19]", "RF1P[20]", "RF1Z[19]", "RF4H[30]", "RF4I[30]", "RF4W[30]", "RF8F(17)[30]", "RF8G(17)[30]", "RF8X(17)[20]", "RF9F(17)[30]", "RF9G(17)[30]", "RF9X(17)[20]", "RG1I(17)[20]", "RG1N[19]", "RG1O[19]", "RG1P[20]", "RG1Z[19]", "RG4H[30]", "RG4I[30]", "RG4W[30]", "RG8F(17)[30]", "RG8G(17)[30]", "RG8X(17)[20]", "RG9F(17)[30]", "RG9G(17)[30]", "RG9X(17)[20]", "RI8X(17)[20]", "RI9X(17)[20]", "RJ1I(17)[20]", "RJ1N[19]", "RJ1O[19]", "RJ1P[20]", "RJ1Z[19]", "RJ4H[30]", "RJ4I[30]", "RJ4W[30]", "RJ8F(17)[30]", "RJ8G(17)[30]", "RJ8X(17)[20]", "RJ9F(17)[30]", "RJ9G(17)[30]", "RJ9X(17)[20]", "RK1I(17)[20]", "RK1N[19]", "RK1O[19]", "RK1P[20]", "RK1Z[19]", "RK4H[30]", "RK4I[30]", "RK4W[30]", "RK8F(17)[30]", "RK8G(17)[30]", "RK8X(17)[20]", "RK9F(17)[30]", "RK9G(17)[30]", "RK9X(17)[20]", "RL1I(17)[20]", "RL1N[19]", "RL1O[19]", "RL1P[20]", "RL1Z[19]", "RL4H[30]", "RL4I[30]", "RL4W[30]", "RL8F(17)[30]", "RL8G(17)[30]", "RL8X(17)[20]", "RL9F(17)[30]", "RL9G(17)[30]", "RL9X(17)[20]", "RM1I(17)[20]", "RM1N[19]", "RM1O[19]", "RM1P[20]", "RM1Z[19]", "RM4H[30]", "RM4I[30]", "RM4W[30]", "RM8F(17)[30]", "RM8G(17)[30]", "RM8X(17)[20]", "RM9F(17)[30]", "RM9G(17)[30]", "RM9X(17)[20]", "RN1I(17)[20]", "RN1N[19]", "RN1O[19]", "RN1P[20]", "RN1Z[19]", "RN4H[30]", "RN4I[30]", "RN4W[30]", "RN8F(17)[30]", "RN8G(17)[30]", "RN8X(17)[20]", "RN9F(17)[30]", "RN9G(17)[30]", "RN9X(17)[20]", "RO1I(17)[20]", "RO1N[19]", "RO1O[19]", "RO1P[20]", "RO1Z[19]", "RO4H[30]", "RO4I[30]", "RO4W[30]", "RO8F(17)[30]", "RO8G(17)[30]", "RO8X(17)[20]", "RO9F(17)[30]", "RO9G(17)[30]", "RO9X(17)[20]", "RQ1I(17)[20]", "RQ1N[19]", "RQ1O[19]", "RQ1P[20]", "RQ1Z[19]", "RQ4H[30]", "RQ4I[30]", "RQ4W[30]", "RQ8F(17)[30]", "RQ8G(17)[30]", "RQ8X(17)[20]", "RQ9F(17)[30]", "RQ9G(17)[30]", "RQ9X(17)[20]", "RT1I(17)[20]", "RT1N[19]", "RT1O[19]", "RT1P[20]", "RT1Z[19]", "RT4H[30]", "RT4I[30]", "RT4W[30]", "RT8F(17)[30]", "RT8G(17)[30]", "RT8X(17)[20]", "RT9F(17)[30]", "RT9G(17)[30]", "RT9X(17)[20]", "RU1I(17)[20]", "RU1N[19]", "RU1O[19]", "RU1P[20]", "RU1Z[19]", "RU4H[30]", "RU4I[30]", "RU4W[30]", "RU8F(17)[30]", "RU8G(17)[30]", "RU8X(17)[20]", "RU9F(17)[30]", "RU9G(17)[30]", "RU9X(17)[20]", "RV1I(17)[20]", "RV1N[19]", "RV1O[19]", "RV1P[20]", "RV1Z[19]", "RV4H[30]", "RV4I[30]", "RV4W[30]", "RV8F(17)[30]", "RV8G(17)[30]", "RV8X(17)[20]", "RV9F(17)[30]", "RV9G(17)[30]", "RV9X(17)[20]", "RW1I(17)[20]", "RW1N[19]", "RW1O[19]", "RW1P[20]", "RW1Z[19]", "RW4H[30]", "RW4I[30]", "RW4W[30]", "RW8F(17)[30]", "RW8G(17)[30]", "RW8X(17)[20]", "RW9F(17)[30]", "RW9G(17)[30]", "RW9X(17)[20]", "RX1I(17)[20]", "RX1N[19]", "RX1O[19]", "RX1P[20]", "RX1Z[19]", "RX4H[30]", "RX4I[30]", "RX4W[30]", "RX8F(17)[30]", "RX8G(17)[30]", "RX8X(17)[20]", "RX9F(17)[30]", "RX9G(17)[30]", "RX9X(17)[20]", "RY1I(17)[20]", "RY1N[19]", "RY1O[19]", "RY1P[20]", "RY1Z[19]", "RY4H[30]", "RY4I[30]", "RY4W[30]", "RY8F(17)[30]", "RY8G(17)[30]", "RY8X(17)[20]", "RY9F(17)[30]", "RY9G(17)[30]", "RY9X(17)[20]", "RZ1I(17)[20]", "RZ1N[19]", "RZ1O[19]", "RZ1P[20]", "RZ1Z[19]", "RZ4H[30]", "RZ4I[30]", "RZ4W[30]", "RZ8F(17)[30]", "RZ8G(17)[30]", "RZ8X(17)[20]", "RZ9F(17)[30]", "RZ9G(17)[30]", "RZ9X(17)[20]", "U1I(17)[20]", "U1N[19]", "U1O[19]", "U1P[20]", "U1Z[19]", "U4H[30]", "U4I[30]", "U4W[30]", "U8F(17)[30]", "U8G(17)[30]", "U8X(17)[20]", "U9F(17)[30]", "U9G(17)[30]", "U9X(17)[20]", "UA1I(17)[20]", "UA1N[19]", "UA1O[19]", "UA1P[20]", "UA1Z[19]", "UA4H[30]", "UA4I[30]", "UA4W[30]", "UA8F(17)[30]", "UA8G(17)[30]", "UA8X(17)[20]", "UA9F(17)[30]", "UA9G(17)[30]", "UA9X(17)[20]", "UB1I(17)[20]", "UB1N[19]", "UB1O[19]", "UB1P[20]", "UB1Z[19]", "UB4H[30]", "UB4I[30]", "UB4W[30]", "UB8F(17)[30]", "UB8G(17)[30]", "UB8X(17)[20]", "UB9F(17)[30]", "UB9G(17)[30]", "UB9X(17)[20]", "UC1I(17)[20]", "UC1N[19]", "UC1O[19]", "UC1P[20]", "UC1Z[19]", "UC4H[30]", "UC4I[30]", "UC4W[30]", "UC8F(17)[30]", "UC8G(17)[30]", "UC8X(17)[20]", "UC9F(17)[30]", "UC9G(17)[30]", "UC9X(17)[20]", "UD1I(17)[20]", "UD1N[19]", "UD1O[19]", "UD1P[20]", "UD1Z[19]", "UD4H[30]", "UD4I[30]", "UD4W[30]", "UD8F(17)[30]", "UD8G(17)[30]", "UD8X(17)[20]", "UD9F(17)[30]", "UD9G(17)[30]", "UD9X(17)[20]", "UE1I(17)[20]", "UE1N[19]", "UE1O[19]", "UE1P[20]", "UE1Z[19]", "UE4H[30]", "UE4I[30]", "UE4W[30]", "UE8F(17)[30]", "UE8G(17)[30]", "UE8X(17)[20]", "UE9F(17)[30]", "UE9G(17)[30]", "UE9X(17)[20]", "UF1I(17)[20]", "UF1N[19]", "UF1O[19]", "UF1P[20]", "UF1Z[19]", "UF4H[30]", "UF4I[30]", "UF4W[30]", "UF8F(17)[30]", "UF8G(17)[30]", "UF8X(17)[20]", "UF9F(17)[30]", "UF9G(17)[30]", "UF9X(17)[20]", "UG1I(17)[20]", "UG1N[19]", "UG1O[19]", "UG1P[20]", "UG1Z[19]", "UG4H[30]", "UG4I[30]", "UG4W[30]", "UG8F(17)[30]", "UG8G(17)[30]", "UG8X(17)[20]", "UG9F(17)[30]", "UG9G(17)[30]", "UG9X(17)[20]", "UH1I(17)[20]", "UH1N[19]", "UH1O[19]", "UH1P[20]", "UH1Z[19]", "UH4H[30]", "UH4I[30]", "UH4W[30]", "UH8F(17)[30]", "UH8G(17)[30]", "UH8X(17)[20]", "UH9F(17)[30]", "UH9G(17)[30]", "UH9X(17)[20]", "UI1I(17)[20]", "UI1N[19]", "UI1O[19]", "UI1P[20]", "UI1Z[19]", "UI4H[30]", "UI4I[30]", "UI4W[30]", "UI8F(17)[30]", "UI8G(17)[30]", "UI8X(17)[20]", "UI9F(17)[30]", "UI9G(17)[30]", "UI9X(17)[20]", "=R9JBF/1", "=RC8C/6", "=RT9T/1", "=RT9T/3", "=R100FA[19]", "=R240S(17)[20]", "=R4HAT[29]", "=R4HC[29]", "=R4HCE[29]", "=R4HCZ[29]", "=R4HD[29]", "=R4HDC[29]", "=R4HDR[29]", "=R4HL[29]", "=R4IC[29]", "=R4ID[29]", "=R4II[29]", "=R4IK[29]", "=R4IM[29]", "=R4IN[29]", "=R4IO[29]", "=R4IT[29]", "=R50VAZ[30]", "=R8MB/1(17)[20]", "=R9OM/6", "=RA4HL[29]", "=RA4NCC[30]", "=RC4HT[29]", "=RC4I[29]", "=RJ4I[29]", "=RJ4P[30]", "=RK4HM[29]", "=RK4P[30]", "=RM4I[29]", "=RM4R[30]", "=RN4HFJ[29]", "=RN4HIF[29]", "=RP75A[19]", "=RP75AG[30]", "=RP75AOS[19]", "=RP75AP[19]", "=RP75AU[19]", "=RP75BFS[19]", "=RP75DD[30]", "=RP75EP[19]", "=RP75FD[30]", "=RP75I[30]", "=RP75IZ[30]", "=RP75NK[19]", "=RP75OM[19]", "=RP75PL[19]", "=RP75PT[19]", "=RP75RK[19]", "=RP75SN[30]", "=RP75TK(17)[30]", "=RP75X(17)[20]", "=RP75ZS[30]", "=RT9K/6", "=RU4HD[29]", "=RU4HP[29]", "=RU4I[29]", "=RW4HM[29]", "=RW4HTK[29]", "=RW4HW[29]", "=RW4HZ[29]", "=RW9WJ/4[30]", "=UA0QQO/3", "=UA3LMR/P", "=UA4H[29]", "=UA4HBM[29]", "=UA4HGL[29]", "=UA4HIP[29]", "=UA4HRZ[29]", "=UA4HY[29]", "=UA4NF[30]", "=UA4PN[30]", "=UB5O/1", "=UC4I[29]", "=UE20DS", "=UI4I[29]"},
 		PrefixRegexp: regexp.MustCompile("^[RU]"),
 	}, {
@@ -2714,6 +2984,7 @@ var Entities = []Entity{
 		ITUZone:      29,
 		Latitude:     54.720000,
 		Longitude:    20.520000,
+		UTCOffset:    2,
 		Prefixes:     []string{"R2F", "R2K", "RA2", "RC2F", "RC2K", "RD2F", "RD2K", "RE2F", "RE2K", "RF2F", "RF2K", "RG2F", "RG2K", "RJ2F", "RJ2K", "RK2F", "RK2K", "RL2F", "RL2K", "RM2F", "RM2K", "RN2F", "RN2K", "RO2F", "RO2K", "RQ2F", "RQ2K", "RT2F", "RT2K", "RU2F", "RU2K", "RV2F", "RV2K", "RW2F", "RW2K", "RX2F", "RX2K", "RY2F", "RY2K", "RZ2F", "RZ2K", "U2F", "U2K", "UA2", "UB2", "UC2", "UD2", "UE2", "UF2", "UG2", "UH2", "UI2", "=R2MWO", "=RP75FYS", "=RP75GC", "=RP75IGS", "=RP75KB", "=RP75MW", "=RP75STP"},
 		PrefixRegexp: regexp.MustCompile("^[RU]"),
 	}, {
@@ -2724,6 +2995,7 @@ var Entities = []Entity{
 		ITUZone:      30,
 		Latitude:     55.880000,
 		Longitude:    84.080000,
+		UTCOffset:    7,
 		Prefixes:     []string{"R0", "R8(17)[30]", "R9", "RA0", "RA8(17)[30]", "RA9", "RC0", "RC8(17)[30]", "RC9", "RD0", "RD8(17)[30]", "RD9", "RE0", "RE8(17)[30]", "RE9", "RF0", "RF8(17)[30]", "RF9", "RG0", "RG8(17)[30]", "RG9", "RI0", "RI8(17)[30]", "RI9", "RJ0", "RJ8(17)[30]", "RJ9", "RK0", "RK8(17)[30]", "RK9", "RL0", "RL8(17)[30]", "RL9", "RM0", "RM8(17)[30]", "RM9", "RN0", "RN8(17)[30]", "RN9", "RO0", "RO8(17)[30]", "RO9", "RQ0", "RQ8(17)[30]", "RQ9", "RT0", "RT8(17)[30]", "RT9", "RU0", "RU8(17)[30]", "RU9", "RV0", "RV8(17)[30]", "RV9", "RW0", "RW8(17)[30]", "RW9", "RX0", "RX8(17)[30]", "RX9", "RY0", "RY8(17)[30]", "RY9", "RZ0", "RZ8(17)[30]", "RZ9", "U0", "U8(17)[30]", "U9", "UA0", "UA8(17)[30]", "UA9", "UB0", "UB8(17)[30]", "UB9", "UC0", "UC8(17)[30]", "UC9", "UD0", "UD8(17)[30]", "UD9", "UE0", "UE8(17)[30]", "UE9", "UF0", "UF8(17)[30]", "UF9", "UG0", "UG8(17)[30]", "UG9", "UH0", "UH8(17)[30]", "UH9", "UI0", "UI8(17)[30]", "UI9", "R0T(18)[32]", "R8H(18)[31]", "R8I(18)[31]", "R8O(18)[31]", "R8P(18)[31]", "R8S(16)[30]", "R8T(16)[30]", "R8U(18)[31]", "R8V(18)[31]", "R8W(16)[30]", "R8Y(18)[31]", "R8Z(18)[31]", "R9I(18)[31]", "R9M(17)[30]", "R9P(18)[31]", "R9S(16)", "R9T(16)", "R9V(18)[31]", "R9W(16)", "RA0T(18)[32]", "RA8H(18)[31]", "RA8I(18)[31]", "RA8O(18)[31]", "RA8P(18)[31]", "RA8S(16)[30]", "RA8T(16)[30]", "RA8U(18)[31]", "RA8V(18)[31]", "RA8W(16)[30]", "RA8Y(18)[31]", "RA8Z(18)[31]", "RA9I(18)[31]", "RA9M(17)[30]", "RA9P(18)[31]", "RA9S(16)", "RA9T(16)", "RA9V(18)[31]", "RA9W(16)", "RC0T(18)[32]", "RC8H(18)[31]", "RC8I(18)[31]", "RC8O(18)[31]", "RC8P(18)[31]", "RC8S(16)[30]", "RC8T(16)[30]", "RC8U(18)[31]", "RC8V(18)[31]", "RC8W(16)[30]", "RC8Y(18)[31]", "RC8Z(18)[31]", "RC9I(18)[31]", "RC9M(17)[30]", "RC9P(18)[31]", "RC9S(16)", "RC9T(16)", "RC9V(18)[31]", "RC9W(16)", "RD0T(18)[32]", "RD8H(18)[31]", "RD8I(18)[31]", "RD8O(18)[31]", "RD8P(18)[31]", "RD8S(16)[30]", "RD8T(16)[30]", "RD8U(18)[31]", "RD8V(18)[31]", "RD8W(16)[30]", "RD8Y(18)[31]", "RD8Z(18)[31]", "RD9I(18)[31]", "RD9M(17)[30]", "RD9P(18)[31]", "RD9S(16)", "RD9T(16)", "RD9V(18)[31]", "RD9W(16)", "RE0T(18)[32]", "RE8H(18)[31]", "RE8I(18)[31]", "RE8O(18)[31]", "RE8P(18)[31]", "RE8S(16)[30]", "RE8T(16)[30]", "RE8U(18)[31]", "RE8V(18)[31]", "RE8W(16)[30]", "RE8Y(18)[31]", "RE8Z(18)[31]", "RE9I(18)[31]", "RE9M(17)[30]", "RE9P(18)[31]", "RE9S(16)", "RE9T(16)", "RE9V(18)[31]", "RE9W(16)", "RF0T(18)[32]", "RF8H(18)[31]", "RF8I(18)[31]", "RF8O(18)[31]", "RF8P(18)[31]", "RF8S(16)[30]", "RF8T(16)[30]", "RF8U(18)[31]", "RF8V(18)[31]", "RF8W(16)[30]", "RF8Y(18)[31]", "RF8Z(18)[31]", "RF9I(18)[31]", "RF9M(17)[30]", "RF9P(18)[31]", "RF9S(16)", "RF9T(16)", "RF9V(18)[31]", "RF9W(16)", "RG0T(18)[32]", "RG8H(18)[31]", "RG8I(18)[31]", "RG8O(18)[31]", "RG8P(18)[31]", "RG8S(16)[30]", "RG8T(16)[30]", "RG8U(18)[31]", "RG8V(18)[31]", "RG8W(16)[30]", "RG8Y(18)[31]", "RG8Z(18)[31]", "RG9I(18)[31]", "RG9M(17)[30]", "RG9P(18)[31]", "RG9S(16)", "RG9T(16)", "RG9V(18)[31]", "RG9W(16)", "RJ0T(18)[32]", "RJ8H(18)[31]", "RJ8I(18)[31]", "RJ8O(18)[31]", "RJ8P(18)[31]", "RJ8S(16)[30]", "RJ8T(16)[30]", "RJ8U(18)[31]", "RJ8V(18)[31]", "RJ8W(16)[30]", "RJ8Y(18)[31]", "RJ8Z(18)[31]", "RJ9I(18)[31]", "RJ9M(17)[30]", "RJ9P(18)[31]", "RJ9S(16)", "RJ9T(16)", "RJ9V(18)[31]", "RJ9W(16)", "RK0T(18)[32]", "RK8H(18)[31]", "RK8I(18)[31]", "RK8O(18)[31]", "RK8P(18)[31]", "RK8S(16)[30]", "RK8T(16)[30]", "RK8U(18)[31]", "RK8V(18)[31]", "RK8W(16)[30]", "RK8Y(18)[31]", "RK8Z(18)[31]", "RK9I(18)[31]", "RK9M(17)[30]", "RK9P(18)[31]", "RK9S(16)", "RK9T(16)", "RK9V(18)[31]", "RK9W(16)", "RL0T(18)[32]", "RL8H(18)[31]", "RL8I(18)[31]", "RL8O(18)[31]", "RL8P(18)[31]", "RL8S(16)[30]", "RL8T(16)[30]", "RL8U(18)[31]", "RL8V(18)[31]", "RL8W(16)[30]", "RL8Y(18)[31]", "RL8Z(18)[31]", "RL9I(18)[31]", "RL9M(17)[30]", "RL9P(18)[31]", "RL9S(16)", "RL9T(16)", "RL9V(18)[31]", "RL9W(16)", "RM0T(18)[32]", "RM8H(18)[31]", "RM8I(18)[31]", "RM8O(18)[31]", "RM8P(18)[31]", "RM8S(16)[30]", "RM8T(16)[30]", "RM8U(18)[31]", "RM8V(18)[31]", "RM8W(16)[30]", "RM8Y(18)[31]", "RM8Z(18)[31]", "RM9I(18)[31]", "RM9M(17)[30]", "RM9P(18)[31]", "RM9S(16)", "RM9T(16)", "RM9V(18)[31]", "RM9W(16)", "RN0T(18)[32]", "RN8H(18)[31]", "RN8I(18)[31]", "RN8O(18)[31]", "RN8P(18)[31]", "RN8S(16)[30]", "RN8T(16)[30]", "RN8U(18)[31]", "RN8V(18)[31]", "RN8W(16)[30]", "RN8Y(18)[31]", "RN8Z(18)[31]", "RN9I(18)[31]", "RN9M(17)[30]", "RN9P(18)[31]", "RN9S(16)", "RN9T(16)", "RN9V(18)[31]", "RN9W(16)", "RO0T(18)[32]", "RO8H(18)[31]", "RO8I(18)[31]", "RO8O(18)[31]", "RO8P(18)[31]", "RO8S(16)[30]", "RO8T(16)[30]", "RO8U(18)[31]", "RO8V(18)[31]", "RO8W(16)[30]", "RO8Y(18)[31]", "RO8Z(18)[31]", "RO9I(18)[31]", "RO9M(17)[30]", "RO9P(18)[31]", "RO9S(16)", "RO9T(16)", "RO9V(18)[31]", "RO9W(16)", "RQ0T(18)[32]", "RQ8H(18)[31]", "RQ8I(18)[31]", "RQ8O(18)[31]", "RQ8P(18)[31]", "RQ8S(16)[30]", "RQ8T(16)[30]", "RQ8U(18)[31]", "RQ8V(18)[31]", "RQ8W(16)[30]", "RQ8Y(18)[31]", "RQ8Z(18)[31]", "RQ9I(18)[31]", "RQ9M(17)[30]", "RQ9P(18)[31]", "RQ9S(16)", "RQ9T(16)", "RQ9V(18)[31]", "RQ9W(16)", "RT0T(18)[32]", "RT8H(18)[31]", "RT8I(18)[31]", "RT8O(18)[31]", "RT8P(18)[31]", "RT8S(16)[30]", "RT8T(16)[30]", "RT8U(18)[31]", "RT8V(18)[31]", "RT8W(16)[30]", "RT8Y(18)[31]", "RT8Z(18)[31]", "RT9I(18)[31]", "RT9M(17)[30]", "RT9P(18)[31]", "RT9S(16)", "RT9T(16)", "RT9V(18)[31]", "RT9W(16)", "RU0T(18)[32]", "RU8H(18)[31]", "RU8I(18)[31]", "RU8O(18)[31]", "RU8P(18)[31]", "RU8S(16)[30]", "RU8T(16)[30]", "RU8U(18)[31]", "RU8V(18)[31]", "RU8W(16)[30]", "RU8Y(18)[31]", "RU8Z(18)[31]", "RU9I(18)[31]", "RU9M(17)[30]", "RU9P(18)[31]", "RU9S(16)", "RU9T(16)", "RU9V(18)[31]", "RU9W(16)", "RV0T(18)[32]", "RV8H(18)[31]", "RV8I(18)[31]", "RV8O(18)[31]", "RV8P(18)[31]", "RV8S(16)[30]", "RV8T(16)[30]", "RV8U(18)[31]", "RV8V(18)[31]", "RV8W(16)[30]", "RV8Y(18)[31]", "RV8Z(18)[31]", "RV9I(18)[31]", "RV9M(17)[30]", "RV9P(18)[31]", "RV9S(16)", "RV9T(16)", "RV9V(18)[31]", "RV9W(16)", "RW0T(18)[32]", "RW8H(18)[31]", "RW8I(18)[31]", "RW8O(18)[31]", "RW8P(18)[31]", "RW8S(16)[30]", "RW8T(16)[30]", "RW8U(18)[31]", "RW8V(18)[31]", "RW8W(16)[30]", "RW8Y(18)[31]", "RW8Z(18)[31]", "RW9I(18)[31]", "RW9M(17)[30]", "RW9P(18)[31]", "RW9S(16)", "RW9T(16)", "RW9V(18)[31]", "RW9W(16)", "RX0T(18)[32]", "RX8H(18)[31]", "RX8I(18)[31]", "RX8O(18)[31]", "RX8P(18)[31]", "RX8S(16)[30]", "RX8T(16)[30]", "RX8U(18)[31]", "RX8V(18)[31]", "RX8W(16)[30]", "RX8Y(18)[31]", "RX8Z(18)[31]", "RX9I(18)[31]", "RX9M(17)[30]", "RX9P(18)[31]", "RX9S(16)", "RX9T(16)", "RX9V(18)[31]", "RX9W(16)", "RY0T(18)[32]", "RY8H(18)[31]", "RY8I(18)[31]", "RY8O(18)[31]", "RY8P(18)[31]", "RY8S(16)[30]", "RY8T(16)[30]", "RY8U(18)[31]", "RY8V(18)[31]", "RY8W(16)[30]", "RY8Y(18)[31]", "RY8Z(18)[31]", "RY9I(18)[31]", "RY9M(17)[30]", "RY9P(18)[31]", "RY9S(16)", "RY9T(16)", "RY9V(18)[31]", "RY9W(16)", "RZ0T(18)[32]", "RZ8H(18)[31]", "RZ8I(18)[31]", "RZ8O(18)[31]", "RZ8P(18)[31]", "RZ8S(16)[30]", "RZ8T(16)[30]", "RZ8U(18)[31]", "RZ8V(18)[31]", "RZ8W(16)[30]", "RZ8Y(18)[31]", "RZ8Z(18)[31]", "RZ9I(18)[31]", "RZ9M(17)[30]", "RZ9P(18)[31]", "RZ9S(16)", "RZ9T(16)", "RZ9V(18)[31]", "RZ9W(16)", "U0T(18)[32]", "U8H(18)[31]", "U8I(18)[31]", "U8O(18)[31]", "U8P(18)[31]", "U8S(16)[30]", "U8T(16)[30]", "U8U(18)[31]", "U8V(18)[31]", "U8W(16)[30]", "U8Y(18)[31]", "U8Z(18)[31]", "U9I(18)[31]", "U9M(17)[30]", "U9P(18)[31]", "U9S(16)", "U9T(16)", "U9V(18)[31]", "U9W(16)", "UA0T(18)[32]", "UA8H(18)[31]", "UA8I(18)[31]", "UA8O(18)[31]", "UA8P(18)[31]", "UA8S(16)[30]", "UA8T(16)[30]", "UA8U(18)[31]", "UA8V(18)[31]", "UA8W(16)[30]", "UA8Y(18)[31]", "UA8Z(18)[31]", "UA9I(18)[31]", "UA9M(17)[30]", "UA9P(18)[31]", "UA9S(16)", "UA9T(16)", "UA9V(18)[31]", "UA9W(16)", "UB0T(18)[32]", "UB8H(18)[31]", "UB8I(18)[31]", "UB8O(18)[31]", "UB8P(18)[31]", "UB8S(16)[30]", "UB8T(16)[30]", "UB8U(18)[31]", "UB8V(18)[31]", "UB8W(16)[30]", "UB8Y(18)[31]", "UB8Z(18)[31]", "UB9I(18)[31]", "UB9M(17)[30]", "UB9P(18)[31]", "UB9S(16)", "UB9T(16)", "UB9V(18)[31]", "UB9W(16)", "UC0T(18)[32]", "UC8H(18)[31]", "UC8I(18)[31]", "UC8O(18)[31]", "UC8P(18)[31]", "UC8S(16)[30]", "UC8T(16)[30]", "UC8U(18)[31]", "UC8V(18)[31]", "UC8W(16)[30]", "UC8Y(18)[31]", "UC8Z(18)[31]", "UC9I(18)[31]", "UC9M(17)[30]", "UC9P(18)[31]", "UC9S(16)", "UC9T(16)", "UC9V(18)[31]", "UC9W(16)", "UD0T(18)[32]", "UD8H(18)[31]", "UD8I(18)[31]", "UD8O(18)[31]", "UD8P(18)[31]", "UD8S(16)[30]", "UD8T(16)[30]", "UD8U(18)[31]", "UD8V(18)[31]", "UD8W(16)[30]", "UD8Y(18)[31]", "UD8Z(18)[31]", "UD9I(18)[31]", "UD9M(17)[30]", "UD9P(18)[31]", "UD9S(16)", "UD9T(16)", "UD9V(18)[31]", "UD9W(16)", "UE0T(18)[32]", "UE8H(18)[31]", "UE8I(18)[31]", "UE8O(18)[31]", "UE8P(18)[31]", "UE8S(16)[30]", "UE8T(16)[30]", "UE8U(18)[31]", "UE8V(18)[31]", "UE8W(16)[30]", "UE8Y(18)[31]", "UE8Z(18)[31]", "UE9I(18)[31]", "UE9M(17)[30]", "UE9P(18)[31]", "UE9S(16)", "UE9T(16)", "UE9V(18)[31]", "UE9W(16)", "UF0T(18)[32]", "UF8H(18)[31]", "UF8I(18)[31]", "UF8O(18)[31]", "UF8P(18)[31]", "UF8S(16)[30]", "UF8T(16)[30]", "UF8U(18)[31]", "UF8V(18)[31]", "UF8W(16)[30]", "UF8Y(18)[31]", "UF8Z(18)[31]", "UF9I(18)[31]", "UF9M(17)[30]", "UF9P(18)[31]", "UF9S(16)", "UF9T(16)", "UF9V(18)[31]", "UF9W(16)", "UG0T(18)[32]", "UG8H(18)[31]", "UG8I(18)[31]", "UG8O(18)[31]", "UG8P(18)[31]", "UG8S(16)[30]", "UG8T(16)[30]", "UG8U(18)[31]", "UG8V(18)[31]", "UG8W(16)[30]", "UG8Y(18)[31]", "UG8Z(18)[31]", "UG9I(18)[31]", "UG9M(17)[30]", "UG9P(18)[31]", "UG9S(16)", "UG9T(16)", "UG9V(18)[31]", "UG9W(16)", "UH0T(18)[32]", "UH8H(18)[31]", "UH8I(18)[31]", "UH8O(18)[31]", "UH8P(18)[31]", "UH8S(16)[30]", "UH8T(16)[30]", "UH8U(18)[31]", "UH8V(18)[31]", "UH8W(16)[30]", "UH8Y(18)[31]", "UH8Z(18)[31]", "UH9I(18)[31]", "UH9M(17)[30]", "UH9P(18)[31]", "UH9S(16)", "UH9T(16)", "UH9V(18)[31]", "UH9W(16)", "UI0T(18)[32]", "UI8H(18)[31]", "UI8I(18)[31]", "UI8O(18)[31]", "UI8P(18)[31]", "UI8S(16)[30]", "UI8T(16)[30]", "UI8U(18)[31]", "UI8V(18)[31]", "UI8W(16)[30]", "UI8Y(18)[31]", "UI8Z(18)[31]", "UI9I(18)[31]", "UI9M(17)[30]", "UI9P(18)[31]", "UI9S(16)", "UI9T(16)", "UI9V(18)[31]", "UI9W(16)", "=R207RRC(19)[25]", "=R2ET/9(18)[31]", "=RA/UT5IA(19)[23]", "=RA0QK/8(17)[30]", "=RA3AV/0(19)[25]", "=RA4FCJ/9[20]", "=RN9S(16)", "=RP75AB(18)[32]", "=RP75AM(19)[33]", "=RP75AZ", "=RP75BKF(18)[32]", "=RP75DG(19)[34]", "=RP75DM(16)", "=RP75DS[20]", "=RP75DT", "=RP75GB", "=RP75GI(16)", "=RP75GK(17)[30]", "=RP75GP", "=RP75GS[20]", "=RP75IE", "=RP75IM", "=RP75J[20]", "=RP75JK[20]", "=RP75KE", "=RP75KM(18)[31]", "=RP75LL", "=RP75MF", "=RP75MGI(16)", "=RP75MLI", "=RP75MMK", "=RP75MP(18)[31]", "=RP75P", "=RP75RGA", "=RP75SD(19)[34]", "=RP75SF[20]", "=RP75SU", "=RP75TG", "=RP75TS(16)", "=RP75TT", "=RP75U", "=RP75UF(16)", "=RP75UR", "=RP75V(19)[34]", "=RP75VAM(16)", "=RP75WU(16)", "=RP75YE(18)[31]", "=RP75YN", "=RT8T(16)[30]", "=RT9S(16)", "=RT9W(16)", "=RU9MV/0(19)[25]", "=RV3DSA/0(19)[34]", "=RX6DL/8(17)[30]", "=RX6DL/8/P(17)[30]", "=UB5O/4(16)", "=UB5O/8(17)[30]", "=UB5O/9(18)[31]", "=UD6AOP/0(19)[25]"},
 		PrefixRegexp: regexp.MustCompile("^[RU]"),
 	}, {
@@ -2734,6 +3006,7 @@ var Entities = []Entity{
 		ITUZone:      30,
 		Latitude:     41.400000,
 		Longitude:    63.970000,
+		UTCOffset:    5,
 		Prefixes:     []string{"UJ", "UK", "UL", "UM"},
 		PrefixRegexp: regexp.MustCompile("^[U]"),
 	}, {
@@ -2744,6 +3017,7 @@ var Entities = []Entity{
 		ITUZone:      30,
 		Latitude:     48.170000,
 		Longitude:    65.180000,
+		UTCOffset:    5,
 		Prefixes:     []string{"UN", "UO", "UP", "UQ", "UN0F[31]", "UN0G[31]", "UN0J[31]", "UN0Q[31]", "UN2F[31]", "UN2G[31]", "UN2J[31]", "UN2Q[31]", "UN3F[31]", "UN3G[31]", "UN3J[31]", "UN3Q[31]", "UN4F[31]", "UN4G[31]", "UN4J[31]", "UN4Q[31]", "UN5F[31]", "UN5G[31]", "UN5J[31]", "UN5Q[31]", "UN6F[31]", "UN6G[31]", "UN6J[31]", "UN6Q[31]", "UN7F[31]", "UN7G[31]", "UN7J[31]", "UN7Q[31]", "UN8F[31]", "UN8G[31]", "UN8J[31]", "UN8Q[31]", "UN9F[31]", "UN9G[31]", "UN9J[31]", "UN9Q[31]", "UO0F[31]", "UO0G[31]", "UO0J[31]", "UO0Q[31]", "UO1F[31]", "UO1G[31]", "UO1J[31]", "UO1Q[31]", "UO2F[31]", "UO2G[31]", "UO2J[31]", "UO2Q[31]", "UO3F[31]", "UO3G[31]", "UO3J[31]", "UO3Q[31]", "UO4F[31]", "UO4G[31]", "UO4J[31]", "UO4Q[31]", "UO5F[31]", "UO5G[31]", "UO5J[31]", "UO5Q[31]", "UO6F[31]", "UO6G[31]", "UO6J[31]", "UO6Q[31]", "UO7F[31]", "UO7G[31]", "UO7J[31]", "UO7Q[31]", "UO8F[31]", "UO8G[31]", "UO8J[31]", "UO8Q[31]", "UO9F[31]", "UO9G[31]", "UO9J[31]", "UO9Q[31]", "UP0F[31]", "UP0G[31]", "UP0J[31]", "UP0Q[31]", "UP1F[31]", "UP1G[31]", "UP1J[31]", "UP1Q[31]", "UP2F[31]", "UP2G[31]", "UP2J[31]", "UP2Q[31]", "UP3F[31]", "UP3G[31]", "UP3J[31]", "UP3Q[31]", "UP4F[31]", "UP4G[31]", "UP4J[31]", "UP4Q[31]", "UP5F[31]", "UP5G[31]", "UP5J[31]", "UP5Q[31]", "UP6F[31]", "UP6G[31]", "UP6J[31]", "UP6Q[31]", "UP7F[31]", "UP7G[31]", "UP7J[31]", "UP7Q[31]", "UP8F[31]", "UP8G[31]", "UP8J[31]", "UP8Q[31]", "UP9F[31]", "UP9G[31]", "UP9J[31]", "UP9Q[31]", "UQ0F[31]", "UQ0G[31]", "UQ0J[31]", "UQ0Q[31]", "UQ1F[31]", "UQ1G[31]", "UQ1J[31]", "UQ1Q[31]", "UQ2F[31]", "UQ2G[31]", "UQ2J[31]", "UQ2Q[31]", "UQ3F[31]", "UQ3G[31]", "UQ3J[31]", "UQ3Q[31]", "UQ4F[31]", "UQ4G[31]", "UQ4J[31]", "UQ4Q[31]", "UQ5F[31]", "UQ5G[31]", "UQ5J[31]", "UQ5Q[31]", "UQ6F[31]", "UQ6G[31]", "UQ6J[31]", "UQ6Q[31]", "UQ7F[31]", "UQ7G[31]", "UQ7J[31]", "UQ7Q[31]", "UQ8F[31]", "UQ8G[31]", "UQ8J[31]", "UQ8Q[31]", "UQ9F[31]", "UQ9G[31]", "UQ9J[31]", "UQ9Q[31]"},
 		PrefixRegexp: regexp.MustCompile("^[U]"),
 	}, {
@@ -2754,6 +3028,7 @@ var Entities = []Entity{
 		ITUZone:      29,
 		Latitude:     50.000000,
 		Longitude:    30.000000,
+		UTCOffset:    2,
 		Prefixes:     []string{"EM", "EN", "EO", "U5", "UR", "US", "UT", "UU", "UV", "UW", "UX", "UY", "UZ"},
 		PrefixRegexp: regexp.MustCompile("^[EU]"),
 	}, {
@@ -2764,6 +3039,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     17.070000,
 		Longitude:    -61.800000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"V2"},
 		PrefixRegexp: regexp.MustCompile("^[V]"),
 	}, {
@@ -2774,6 +3050,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     16.970000,
 		Longitude:    -88.670000,
+		UTCOffset:    -6,
 		Prefixes:     []string{"V3"},
 		PrefixRegexp: regexp.MustCompile("^[V]"),
 	}, {
@@ -2784,6 +3061,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     17.370000,
 		Longitude:    -62.780000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"V4"},
 		PrefixRegexp: regexp.MustCompile("^[V]"),
 	}, {
@@ -2794,6 +3072,7 @@ var Entities = []Entity{
 		ITUZone:      57,
 		Latitude:     -22.000000,
 		Longitude:    17.000000,
+		UTCOffset:    2,
 		Prefixes:     []string{"V5"},
 		PrefixRegexp: regexp.MustCompile("^[V]"),
 	}, {
@@ -2804,6 +3083,7 @@ var Entities = []Entity{
 		ITUZone:      65,
 		Latitude:     6.880000,
 		Longitude:    158.200000,
+		UTCOffset:    10,
 		Prefixes:     []string{"V6"},
 		PrefixRegexp: regexp.MustCompile("^[V]"),
 	}, {
@@ -2814,6 +3094,7 @@ var Entities = []Entity{
 		ITUZone:      65,
 		Latitude:     9.080000,
 		Longitude:    167.330000,
+		UTCOffset:    12,
 		Prefixes:     []string{"V7"},
 		PrefixRegexp: regexp.MustCompile("^[V]"),
 	}, {
@@ -2824,6 +3105,7 @@ var Entities = []Entity{
 		ITUZone:      54,
 		Latitude:     4.500000,
 		Longitude:    114.600000,
+		UTCOffset:    8,
 		Prefixes:     []string{"V8"},
 		PrefixRegexp: regexp.MustCompile("^[V]"),
 	}, {
@@ -2834,6 +3116,7 @@ var Entities = []Entity{
 		ITUZone:      9,
 		Latitude:     44.350000,
 		Longitude:    -78.750000,
+		UTCOffset:    -5,
 		Prefixes:     []string{"CF", "CG", "CJ", "CK", "VA", "VB", "VC", "VE", "VG", "VX", "VY9", "XL", "XM", "CF2[4]", "CG2[4]", "CH1", "CH2(2)", "CI0(2)[4]", "CI1(1)[2]", "CI2", "CJ2[4]", "CK2[4]", "CY1", "CY2(2)", "CZ0(2)[4]", "CZ1(1)[2]", "CZ2", "VA2[4]", "VB2[4]", "VC2[4]", "VD1", "VD2(2)", "VE2[4]", "VF0(2)[4]", "VF1(1)[2]", "VF2", "VG2[4]", "VO1", "VO2(2)", "VX2[4]", "VY0(2)[4]", "VY1(1)[2]", "VY2", "XJ1", "XJ2(2)", "XK0(2)[4]", "XK1(1)[2]", "XK2", "XL2[4]", "XM2[4]", "XN1", "XN2(2)", "XO0(2)[4]", "XO1(1)[2]", "XO2", "=VER20200423", "=VA2VVV(2)[4]", "=VE2CSI(2)[4]", "=VE2EKA(2)[4]", "=VE2FK[9]", "=VE2IDX(2)[4]", "=VE2IM(2)[4]", "=VE2KK[9]", "=VE2NN(2)[4]", "=VE8AT(2)[4]", "=VY0AA(4)[3]", "=VY0PW(4)[3]"},
 		PrefixRegexp: regexp.MustCompile("^[CVX]"),
 	}, {
@@ -2844,6 +3127,7 @@ var Entities = []Entity{
 		ITUZone:      59,
 		Latitude:     -23.700000,
 		Longitude:    132.330000,
+		UTCOffset:    10,
 		Prefixes:     []string{"AX", "VH", "VI", "VJ", "VK", "VL", "VM", "VN", "VZ", "AX4[55]", "VH4[55]", "VI4[55]", "VJ4[55]", "VK4[55]", "VL4[55]", "VM4[55]", "VN4[55]", "VZ4[55]"},
 		PrefixRegexp: regexp.MustCompile("^[AV]"),
 	}, {
@@ -2854,6 +3138,7 @@ var Entities = []Entity{
 		ITUZone:      68,
 		Latitude:     -53.080000,
 		Longitude:    73.500000,
+		UTCOffset:    5,
 		Prefixes:     []string{"=VK0EK"},
 		PrefixRegexp: regexp.MustCompile("^[V]"),
 	}, {
@@ -2864,6 +3149,7 @@ var Entities = []Entity{
 		ITUZone:      60,
 		Latitude:     -54.600000,
 		Longitude:    158.880000,
+		UTCOffset:    10,
 		Prefixes:     []string{"=VK0AI"},
 		PrefixRegexp: regexp.MustCompile("^[V]"),
 	}, {
@@ -2874,6 +3160,7 @@ var Entities = []Entity{
 		ITUZone:      54,
 		Latitude:     -12.150000,
 		Longitude:    96.820000,
+		UTCOffset:    6.5,
 		Prefixes:     []string{"AX9C", "AX9Y", "VH9C", "VH9Y", "VI9C", "VI9Y", "VJ9C", "VJ9Y", "VK9C", "VK9FC", "VK9KC", "VK9Y", "VK9ZY", "VL9C", "VL9Y", "VM9C", "VM9Y", "VN9C", "VN9Y", "VZ9C", "VZ9Y"},
 		PrefixRegexp: regexp.MustCompile("^[AV]"),
 	}, {
@@ -2884,6 +3171,7 @@ var Entities = []Entity{
 		ITUZone:      60,
 		Latitude:     -31.550000,
 		Longitude:    159.080000,
+		UTCOffset:    10.5,
 		Prefixes:     []string{"AX9L", "VH9L", "VI9L", "VJ9L", "VK9FL", "VK9L", "VK9ZL", "VL9L", "VM9L", "VN9L", "VZ9L", "=VK3YQS/9", "=VK3YQS/VK9"},
 		PrefixRegexp: regexp.MustCompile("^[AV]"),
 	}, {
@@ -2894,6 +3182,7 @@ var Entities = []Entity{
 		ITUZone:      56,
 		Latitude:     -17.400000,
 		Longitude:    155.850000,
+		UTCOffset:    10,
 		Prefixes:     []string{"AX9M", "VH9M", "VI9M", "VJ9M", "VK9M", "VL9M", "VM9M", "VN9M", "VZ9M"},
 		PrefixRegexp: regexp.MustCompile("^[AV]"),
 	}, {
@@ -2904,6 +3193,7 @@ var Entities = []Entity{
 		ITUZone:      60,
 		Latitude:     -29.030000,
 		Longitude:    167.930000,
+		UTCOffset:    11,
 		Prefixes:     []string{"AX9", "VH9", "VI9", "VJ9", "VK9", "VL9", "VM9", "VN9", "VZ9"},
 		PrefixRegexp: regexp.MustCompile("^[AV]"),
 	}, {
@@ -2914,6 +3204,7 @@ var Entities = []Entity{
 		ITUZone:      55,
 		Latitude:     -16.220000,
 		Longitude:    150.020000,
+		UTCOffset:    10,
 		Prefixes:     []string{"AX9W", "AX9Z", "VH9W", "VH9Z", "VI9W", "VI9Z", "VJ9W", "VJ9Z", "VK9FW", "VK9W", "VK9Z", "VL9W", "VL9Z", "VM9W", "VM9Z", "VN9W", "VN9Z", "VZ9W", "VZ9Z"},
 		PrefixRegexp: regexp.MustCompile("^[AV]"),
 	}, {
@@ -2924,6 +3215,7 @@ var Entities = []Entity{
 		ITUZone:      54,
 		Latitude:     -10.480000,
 		Longitude:    105.630000,
+		UTCOffset:    7,
 		Prefixes:     []string{"AX9X", "VH9X", "VI9X", "VJ9X", "VK9FX", "VK9KX", "VK9X", "VL9X", "VM9X", "VN9X", "VZ9X"},
 		PrefixRegexp: regexp.MustCompile("^[AV]"),
 	}, {
@@ -2934,6 +3226,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     18.230000,
 		Longitude:    -63.000000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"VP2E"},
 		PrefixRegexp: regexp.MustCompile("^[V]"),
 	}, {
@@ -2944,6 +3237,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     16.750000,
 		Longitude:    -62.180000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"VP2M"},
 		PrefixRegexp: regexp.MustCompile("^[V]"),
 	}, {
@@ -2954,6 +3248,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     18.330000,
 		Longitude:    -64.750000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"VP2V"},
 		PrefixRegexp: regexp.MustCompile("^[V]"),
 	}, {
@@ -2964,6 +3259,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     21.770000,
 		Longitude:    -71.750000,
+		UTCOffset:    -5,
 		Prefixes:     []string{"VP5", "VQ5"},
 		PrefixRegexp: regexp.MustCompile("^[V]"),
 	}, {
@@ -2974,6 +3270,7 @@ var Entities = []Entity{
 		ITUZone:      63,
 		Latitude:     -25.070000,
 		Longitude:    -130.100000,
+		UTCOffset:    -8,
 		Prefixes:     []string{"VP6"},
 		PrefixRegexp: regexp.MustCompile("^[V]"),
 	}, {
@@ -2984,6 +3281,7 @@ var Entities = []Entity{
 		ITUZone:      63,
 		Latitude:     -24.700000,
 		Longitude:    -124.800000,
+		UTCOffset:    -8,
 		Prefixes:     []string{"=VP6D"},
 		PrefixRegexp: regexp.MustCompile("^[V]"),
 	}, {
@@ -2994,6 +3292,7 @@ var Entities = []Entity{
 		ITUZone:      16,
 		Latitude:     -51.630000,
 		Longitude:    -58.720000,
+		UTCOffset:    -3,
 		Prefixes:     []string{"VP8"},
 		PrefixRegexp: regexp.MustCompile("^[V]"),
 	}, {
@@ -3004,6 +3303,7 @@ var Entities = []Entity{
 		ITUZone:      73,
 		Latitude:     -54.480000,
 		Longitude:    -37.080000,
+		UTCOffset:    -2,
 		Prefixes:     []string{"=VP8CA"},
 		PrefixRegexp: regexp.MustCompile("^[V]"),
 	}, {
@@ -3014,6 +3314,7 @@ var Entities = []Entity{
 		ITUZone:      73,
 		Latitude:     -62.080000,
 		Longitude:    -58.670000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"CE9", "XR9", "=EA4FZR", "=HF0POL"},
 		PrefixRegexp: regexp.MustCompile("^[CEHX]"),
 	}, {
@@ -3024,6 +3325,7 @@ var Entities = []Entity{
 		ITUZone:      73,
 		Latitude:     -60.600000,
 		Longitude:    -45.550000,
+		UTCOffset:    -3,
 		Prefixes:     []string{"=VP8PJ"},
 		PrefixRegexp: regexp.MustCompile("^[V]"),
 	}, {
@@ -3034,6 +3336,7 @@ var Entities = []Entity{
 		ITUZone:      73,
 		Latitude:     -58.430000,
 		Longitude:    -26.330000,
+		UTCOffset:    -2,
 		Prefixes:     []string{"=VP8DXU"},
 		PrefixRegexp: regexp.MustCompile("^[V]"),
 	}, {
@@ -3044,6 +3347,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     32.320000,
 		Longitude:    -64.730000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"VP9"},
 		PrefixRegexp: regexp.MustCompile("^[V]"),
 	}, {
@@ -3054,6 +3358,7 @@ var Entities = []Entity{
 		ITUZone:      41,
 		Latitude:     -7.320000,
 		Longitude:    72.420000,
+		UTCOffset:    6,
 		Prefixes:     []string{"VQ9"},
 		PrefixRegexp: regexp.MustCompile("^[V]"),
 	}, {
@@ -3064,6 +3369,7 @@ var Entities = []Entity{
 		ITUZone:      44,
 		Latitude:     22.280000,
 		Longitude:    114.180000,
+		UTCOffset:    8,
 		Prefixes:     []string{"VR"},
 		PrefixRegexp: regexp.MustCompile("^[V]"),
 	}, {
@@ -3074,6 +3380,7 @@ var Entities = []Entity{
 		ITUZone:      41,
 		Latitude:     22.500000,
 		Longitude:    77.580000,
+		UTCOffset:    5.5,
 		Prefixes:     []string{"8T", "8U", "8V", "8W", "8X", "8Y", "AT", "AU", "AV", "AW", "VT", "VU", "VV", "VW"},
 		PrefixRegexp: regexp.MustCompile("^[8AV]"),
 	}, {
@@ -3084,6 +3391,7 @@ var Entities = []Entity{
 		ITUZone:      49,
 		Latitude:     12.370000,
 		Longitude:    92.780000,
+		UTCOffset:    5.5,
 		Prefixes:     []string{"VU4"},
 		PrefixRegexp: regexp.MustCompile("^[V]"),
 	}, {
@@ -3094,6 +3402,7 @@ var Entities = []Entity{
 		ITUZone:      41,
 		Latitude:     11.230000,
 		Longitude:    72.780000,
+		UTCOffset:    5.5,
 		Prefixes:     []string{"VU7"},
 		PrefixRegexp: regexp.MustCompile("^[V]"),
 	}, {
@@ -3104,6 +3413,7 @@ var Entities = []Entity{
 		ITUZone:      10,
 		Latitude:     21.320000,
 		Longitude:    -100.230000,
+		UTCOffset:    -6,
 		Prefixes:     []string{"4A", "4B", "4C", "6D", "6E", "6F", "6G", "6H", "6I", "6J", "XA", "XB", "XC", "XD", "XE", "XF", "XG", "XH", "XI"},
 		PrefixRegexp: regexp.MustCompile("^[46X]"),
 	}, {
@@ -3114,6 +3424,7 @@ var Entities = []Entity{
 		ITUZone:      10,
 		Latitude:     18.770000,
 		Longitude:    -110.970000,
+		UTCOffset:    -7,
 		Prefixes:     []string{"4A4", "4B4", "4C4", "6D4", "6E4", "6F4", "6G4", "6H4", "6I4", "6J4", "XA4", "XB4", "XC4", "XD4", "XE4", "XF4", "XG4", "XH4", "XI4"},
 		PrefixRegexp: regexp.MustCompile("^[46X]"),
 	}, {
@@ -3124,6 +3435,7 @@ var Entities = []Entity{
 		ITUZone:      46,
 		Latitude:     12.000000,
 		Longitude:    -2.000000,
+		UTCOffset:    0,
 		Prefixes:     []string{"XT"},
 		PrefixRegexp: regexp.MustCompile("^[X]"),
 	}, {
@@ -3134,6 +3446,7 @@ var Entities = []Entity{
 		ITUZone:      49,
 		Latitude:     12.930000,
 		Longitude:    105.130000,
+		UTCOffset:    7,
 		Prefixes:     []string{"XU"},
 		PrefixRegexp: regexp.MustCompile("^[X]"),
 	}, {
@@ -3144,6 +3457,7 @@ var Entities = []Entity{
 		ITUZone:      49,
 		Latitude:     18.200000,
 		Longitude:    104.550000,
+		UTCOffset:    7,
 		Prefixes:     []string{"XW"},
 		PrefixRegexp: regexp.MustCompile("^[X]"),
 	}, {
@@ -3154,6 +3468,7 @@ var Entities = []Entity{
 		ITUZone:      44,
 		Latitude:     22.100000,
 		Longitude:    113.500000,
+		UTCOffset:    8,
 		Prefixes:     []string{"XX9"},
 		PrefixRegexp: regexp.MustCompile("^[X]"),
 	}, {
@@ -3164,6 +3479,7 @@ var Entities = []Entity{
 		ITUZone:      49,
 		Latitude:     20.000000,
 		Longitude:    96.370000,
+		UTCOffset:    6.5,
 		Prefixes:     []string{"XY", "XZ"},
 		PrefixRegexp: regexp.MustCompile("^[X]"),
 	}, {
@@ -3174,6 +3490,7 @@ var Entities = []Entity{
 		ITUZone:      40,
 		Latitude:     34.700000,
 		Longitude:    65.800000,
+		UTCOffset:    4.5,
 		Prefixes:     []string{"T6", "YA"},
 		PrefixRegexp: regexp.MustCompile("^[TY]"),
 	}, {
@@ -3184,6 +3501,7 @@ var Entities = []Entity{
 		ITUZone:      51,
 		Latitude:     -7.300000,
 		Longitude:    109.880000,
+		UTCOffset:    7,
 		Prefixes:     []string{"7A", "7B", "7C", "7D", "7E", "7F", "7G", "7H", "7I", "8A", "8B", "8C", "8D", "8E", "8F", "8G", "8H", "8I", "PK", "PL", "PM", "PN", "PO", "YB", "YC", "YD", "YE", "YF", "YG", "YH", "7A0[54]", "7A1[54]", "7A2[54]", "7A3[54]", "7A4[54]", "7A5[54]", "7A6[54]", "7A7[54]", "7A8[54]", "7B0[54]", "7B1[54]", "7B2[54]", "7B3[54]", "7B4[54]", "7B5[54]", "7B6[54]", "7B7[54]", "7B8[54]", "7C0[54]", "7C1[54]", "7C2[54]", "7C3[54]", "7C4[54]", "7C5[54]", "7C6[54]", "7C7[54]", "7C8[54]", "7D0[54]", "7D1[54]", "7D2[54]", "7D3[54]", "7D4[54]", "7D5[54]", "7D6[54]", "7D7[54]", "7D8[54]", "7E0[54]", "7E1[54]", "7E2[54]", "7E3[54]", "7E4[54]", "7E5[54]", "7E6[54]", "7E7[54]", "7E8[54]", "7F0[54]", "7F1[54]", "7F2[54]", "7F3[54]", "7F4[54]", "7F5[54]", "7F6[54]", "7F7[54]", "7F8[54]", "7G0[54]", "7G1[54]", "7G2[54]", "7G3[54]", "7G4[54]", "7G5[54]", "7G6[54]", "7G7[54]", "7G8[54]", "7H0[54]", "7H1[54]", "7H2[54]", "7H3[54]", "7H4[54]", "7H5[54]", "7H6[54]", "7H7[54]", "7H8[54]", "7I0[54]", "7I1[54]", "7I2[54]", "7I3[54]", "7I4[54]", "7I5[54]", "7I6[54]", "7I7[54]", "7I8[54]", "8A0[54]", "8A1[54]", "8A2[54]", "8A3[54]", "8A4[54]", "8A5[54]", "8A6[54]", "8A7[54]", "8A8[54]", "8B0[54]", "8B1[54]", "8B2[54]", "8B3[54]", "8B4[54]", "8B5[54]", "8B6[54]", "8B7[54]", "8B8[54]", "8C0[54]", "8C1[54]", "8C2[54]", "8C3[54]", "8C4[54]", "8C5[54]", "8C6[54]", "8C7[54]", "8C8[54]", "8D0[54]", "8D1[54]", "8D2[54]", "8D3[54]", "8D4[54]", "8D5[54]", "8D6[54]", "8D7[54]", "8D8[54]", "8E0[54]", "8E1[54]", "8E2[54]", "8E3[54]", "8E4[54]", "8E5[54]", "8E6[54]", "8E7[54]", "8E8[54]", "8F0[54]", "8F1[54]", "8F2[54]", "8F3[54]", "8F4[54]", "8F5[54]", "8F6[54]", "8F7[54]", "8F8[54]", "8G0[54]", "8G1[54]", "8G2[54]", "8G3[54]", "8G4[54]", "8G5[54]", "8G6[54]", "8G7[54]", "8G8[54]", "8H0[54]", "8H1[54]", "8H2[54]", "8H3[54]", "8H4[54]", "8H5[54]", "8H6[54]", "8H7[54]", "8H8[54]", "8I0[54]", "8I1[54]", "8I2[54]", "8I3[54]", "8I4[54]", "8I5[54]", "8I6[54]", "8I7[54]", "8I8[54]", "YB0[54]", "YB1[54]", "YB2[54]", "YB3[54]", "YB4[54]", "YB5[54]", "YB6[54]", "YB7[54]", "YB8[54]", "YC0[54]", "YC1[54]", "YC2[54]", "YC3[54]", "YC4[54]", "YC5[54]", "YC6[54]", "YC7[54]", "YC8[54]", "YD0[54]", "YD1[54]", "YD2[54]", "YD3[54]", "YD4[54]", "YD5[54]", "YD6[54]", "YD7[54]", "YD8[54]", "YE0[54]", "YE1[54]", "YE2[54]", "YE3[54]", "YE4[54]", "YE5[54]", "YE6[54]", "YE7[54]", "YE8[54]", "YF0[54]", "YF1[54]", "YF2[54]", "YF3[54]", "YF4[54]", "YF5[54]", "YF6[54]", "YF7[54]", "YF8[54]", "YG0[54]", "YG1[54]", "YG2[54]", "YG3[54]", "YG4[54]", "YG5[54]", "YG6[54]", "YG7[54]", "YG8[54]", "YH0[54]", "YH1[54]", "YH2[54]", "YH3[54]", "YH4[54]", "YH5[54]", "YH6[54]", "YH7[54]", "YH8[54]"},
 		PrefixRegexp: regexp.MustCompile("^[78PY]"),
 	}, {
@@ -3194,6 +3512,7 @@ var Entities = []Entity{
 		ITUZone:      39,
 		Latitude:     33.920000,
 		Longitude:    42.780000,
+		UTCOffset:    3,
 		Prefixes:     []string{"HN", "YI"},
 		PrefixRegexp: regexp.MustCompile("^[HY]"),
 	}, {
@@ -3204,6 +3523,7 @@ var Entities = []Entity{
 		ITUZone:      56,
 		Latitude:     -17.670000,
 		Longitude:    168.380000,
+		UTCOffset:    11,
 		Prefixes:     []string{"YJ"},
 		PrefixRegexp: regexp.MustCompile("^[Y]"),
 	}, {
@@ -3214,6 +3534,7 @@ var Entities = []Entity{
 		ITUZone:      39,
 		Latitude:     35.380000,
 		Longitude:    38.200000,
+		UTCOffset:    3,
 		Prefixes:     []string{"6C", "YK"},
 		PrefixRegexp: regexp.MustCompile("^[6Y]"),
 	}, {
@@ -3224,6 +3545,7 @@ var Entities = []Entity{
 		ITUZone:      29,
 		Latitude:     57.030000,
 		Longitude:    24.650000,
+		UTCOffset:    2,
 		Prefixes:     []string{"YL"},
 		PrefixRegexp: regexp.MustCompile("^[Y]"),
 	}, {
@@ -3234,6 +3556,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     12.880000,
 		Longitude:    -85.050000,
+		UTCOffset:    -6,
 		Prefixes:     []string{"H6", "H7", "HT", "YN"},
 		PrefixRegexp: regexp.MustCompile("^[HY]"),
 	}, {
@@ -3244,6 +3567,7 @@ var Entities = []Entity{
 		ITUZone:      28,
 		Latitude:     45.780000,
 		Longitude:    24.700000,
+		UTCOffset:    2,
 		Prefixes:     []string{"YO", "YP", "YQ", "YR"},
 		PrefixRegexp: regexp.MustCompile("^[Y]"),
 	}, {
@@ -3254,6 +3578,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     14.000000,
 		Longitude:    -89.000000,
+		UTCOffset:    -6,
 		Prefixes:     []string{"HU", "YS"},
 		PrefixRegexp: regexp.MustCompile("^[HY]"),
 	}, {
@@ -3264,6 +3589,7 @@ var Entities = []Entity{
 		ITUZone:      28,
 		Latitude:     44.000000,
 		Longitude:    21.000000,
+		UTCOffset:    1,
 		Prefixes:     []string{"YT", "YU"},
 		PrefixRegexp: regexp.MustCompile("^[Y]"),
 	}, {
@@ -3274,6 +3600,7 @@ var Entities = []Entity{
 		ITUZone:      12,
 		Latitude:     8.000000,
 		Longitude:    -66.000000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"4M", "YV", "YW", "YX", "YY"},
 		PrefixRegexp: regexp.MustCompile("^[4Y]"),
 	}, {
@@ -3284,6 +3611,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     15.670000,
 		Longitude:    -63.600000,
+		UTCOffset:    -4,
 		Prefixes:     []string{"4M0", "YV0", "YW0", "YX0", "YY0"},
 		PrefixRegexp: regexp.MustCompile("^[4Y]"),
 	}, {
@@ -3294,6 +3622,7 @@ var Entities = []Entity{
 		ITUZone:      53,
 		Latitude:     -18.000000,
 		Longitude:    31.000000,
+		UTCOffset:    2,
 		Prefixes:     []string{"Z2"},
 		PrefixRegexp: regexp.MustCompile("^[Z]"),
 	}, {
@@ -3304,6 +3633,7 @@ var Entities = []Entity{
 		ITUZone:      28,
 		Latitude:     41.600000,
 		Longitude:    21.650000,
+		UTCOffset:    1,
 		Prefixes:     []string{"Z3"},
 		PrefixRegexp: regexp.MustCompile("^[Z]"),
 	}, {
@@ -3314,6 +3644,7 @@ var Entities = []Entity{
 		ITUZone:      28,
 		Latitude:     42.670000,
 		Longitude:    21.170000,
+		UTCOffset:    1,
 		Prefixes:     []string{"Z6"},
 		PrefixRegexp: regexp.MustCompile("^[Z]"),
 	}, {
@@ -3324,6 +3655,7 @@ var Entities = []Entity{
 		ITUZone:      48,
 		Latitude:     4.850000,
 		Longitude:    31.600000,
+		UTCOffset:    2,
 		Prefixes:     []string{"Z8"},
 		PrefixRegexp: regexp.MustCompile("^[Z]"),
 	}, {
@@ -3334,6 +3666,7 @@ var Entities = []Entity{
 		ITUZone:      28,
 		Latitude:     41.000000,
 		Longitude:    20.000000,
+		UTCOffset:    1,
 		Prefixes:     []string{"ZA"},
 		PrefixRegexp: regexp.MustCompile("^[Z]"),
 	}, {
@@ -3344,6 +3677,7 @@ var Entities = []Entity{
 		ITUZone:      37,
 		Latitude:     36.150000,
 		Longitude:    -5.370000,
+		UTCOffset:    1,
 		Prefixes:     []string{"ZB", "ZG"},
 		PrefixRegexp: regexp.MustCompile("^[Z]"),
 	}, {
@@ -3354,6 +3688,7 @@ var Entities = []Entity{
 		ITUZone:      39,
 		Latitude:     35.320000,
 		Longitude:    33.570000,
+		UTCOffset:    2,
 		Prefixes:     []string{"ZC4"},
 		PrefixRegexp: regexp.MustCompile("^[Z]"),
 	}, {
@@ -3364,6 +3699,7 @@ var Entities = []Entity{
 		ITUZone:      66,
 		Latitude:     -15.970000,
 		Longitude:    -5.720000,
+		UTCOffset:    0,
 		Prefixes:     []string{"ZD7"},
 		PrefixRegexp: regexp.MustCompile("^[Z]"),
 	}, {
@@ -3374,6 +3710,7 @@ var Entities = []Entity{
 		ITUZone:      66,
 		Latitude:     -7.930000,
 		Longitude:    -14.370000,
+		UTCOffset:    0,
 		Prefixes:     []string{"ZD8"},
 		PrefixRegexp: regexp.MustCompile("^[Z]"),
 	}, {
@@ -3384,6 +3721,7 @@ var Entities = []Entity{
 		ITUZone:      66,
 		Latitude:     -37.130000,
 		Longitude:    -12.300000,
+		UTCOffset:    0,
 		Prefixes:     []string{"ZD9"},
 		PrefixRegexp: regexp.MustCompile("^[Z]"),
 	}, {
@@ -3394,6 +3732,7 @@ var Entities = []Entity{
 		ITUZone:      11,
 		Latitude:     19.320000,
 		Longitude:    -81.220000,
+		UTCOffset:    -5,
 		Prefixes:     []string{"ZF"},
 		PrefixRegexp: regexp.MustCompile("^[Z]"),
 	}, {
@@ -3404,6 +3743,7 @@ var Entities = []Entity{
 		ITUZone:      62,
 		Latitude:     -9.400000,
 		Longitude:    -171.200000,
+		UTCOffset:    13,
 		Prefixes:     []string{"ZK3"},
 		PrefixRegexp: regexp.MustCompile("^[Z]"),
 	}, {
@@ -3414,6 +3754,7 @@ var Entities = []Entity{
 		ITUZone:      60,
 		Latitude:     -39.030000,
 		Longitude:    174.470000,
+		UTCOffset:    12,
 		Prefixes:     []string{"ZK", "ZL", "ZL50", "ZM"},
 		PrefixRegexp: regexp.MustCompile("^[Z]"),
 	}, {
@@ -3424,6 +3765,7 @@ var Entities = []Entity{
 		ITUZone:      60,
 		Latitude:     -43.850000,
 		Longitude:    -176.480000,
+		UTCOffset:    12.75,
 		Prefixes:     []string{"ZL7", "ZM7"},
 		PrefixRegexp: regexp.MustCompile("^[Z]"),
 	}, {
@@ -3434,6 +3776,7 @@ var Entities = []Entity{
 		ITUZone:      60,
 		Latitude:     -29.250000,
 		Longitude:    -177.920000,
+		UTCOffset:    12,
 		Prefixes:     []string{"ZL8", "ZM8"},
 		PrefixRegexp: regexp.MustCompile("^[Z]"),
 	}, {
@@ -3444,6 +3787,7 @@ var Entities = []Entity{
 		ITUZone:      60,
 		Latitude:     -51.620000,
 		Longitude:    167.620000,
+		UTCOffset:    12,
 		Prefixes:     []string{"ZL9"},
 		PrefixRegexp: regexp.MustCompile("^[Z]"),
 	}, {
@@ -3454,6 +3798,7 @@ var Entities = []Entity{
 		ITUZone:      14,
 		Latitude:     -25.270000,
 		Longitude:    -57.670000,
+		UTCOffset:    -3,
 		Prefixes:     []string{"ZP"},
 		PrefixRegexp: regexp.MustCompile("^[Z]"),
 	}, {
@@ -3464,6 +3809,7 @@ var Entities = []Entity{
 		ITUZone:      57,
 		Latitude:     -29.070000,
 		Longitude:    22.630000,
+		UTCOffset:    2,
 		Prefixes:     []string{"H5", "S4", "S8", "V9", "ZR", "ZS", "ZT", "ZU"},
 		PrefixRegexp: regexp.MustCompile("^[HSVZ]"),
 	}, {
@@ -3474,6 +3820,7 @@ var Entities = []Entity{
 		ITUZone:      57,
 		Latitude:     -46.880000,
 		Longitude:    37.720000,
+		UTCOffset:    2,
 		Prefixes:     []string{"ZR8", "ZS8", "ZT8", "ZU8"},
 		PrefixRegexp: regexp.MustCompile("^[Z]"),
 	}}
@@ -24,6 +24,22 @@ func TestLookup(t *testing.T) {
 			call:   "VE2SPEED",
 			entity: "Canada",
 		},
+		{
+			call:   "W1AW/P",
+			entity: "United States",
+		},
+		{
+			call:   "KH6/W1AW/P",
+			entity: "Hawaii",
+		},
+		{
+			call:   "W1AW/KH6",
+			entity: "Hawaii",
+		},
+		{
+			call:   "VE3/KN4LHY",
+			entity: "Canada",
+		},
 	}
 	for _, tc := range testData {
 		ent, ok := dxcc.Lookup(tc.call)
@@ -36,3 +52,33 @@ func TestLookup(t *testing.T) {
 	}
 
 }
+
+func TestLookupNoEntity(t *testing.T) {
+	for _, call := range []string{"W1AW/MM", "DG2KM/AM"} {
+		if ent, ok := dxcc.Lookup(call); ok {
+			t.Errorf("expected no entity for %s, got %s", call, ent.Entity)
+		}
+	}
+}
+
+func TestUTCOffset(t *testing.T) {
+	testData := []struct {
+		call   string
+		offset float64
+	}{
+		{"EA4ABC", 1},
+		{"VU2ABC", 5.5},
+		{"LU1ABC", -3},
+		{"9N1ABC", 5.75},
+		{"G4ABC", 0},
+	}
+	for _, tc := range testData {
+		ent, ok := dxcc.Lookup(tc.call)
+		if !ok {
+			t.Fatalf("expected an entity for %s", tc.call)
+		}
+		if ent.UTCOffset != tc.offset {
+			t.Errorf("expected UTC offset %g for %s, got %g", tc.offset, tc.call, ent.UTCOffset)
+		}
+	}
+}
@@ -34,6 +34,7 @@ func main() {
 	fmt.Fprint(src, "  ITUZone int\n")
 	fmt.Fprint(src, "  Latitude float64\n")
 	fmt.Fprint(src, "  Longitude float64\n")
+	fmt.Fprint(src, "  UTCOffset float64\n")
 	fmt.Fprint(src, "  Prefixes []string\n")
 	fmt.Fprint(src, "  Score int\n")
 	fmt.Fprint(src, "  PrefixRegexp *regexp.Regexp\n")
@@ -55,6 +56,9 @@ func main() {
 		lon, _ := strconv.ParseFloat(record[7], 64)
 		// positive is west, so convert to normal form
 		lon *= -1
+		offset, _ := strconv.ParseFloat(record[8], 64)
+		// also positive is west, subtracted from zero so UTC isn't -0
+		offset = 0 - offset
 
 		fmt.Fprintf(src, "{\n")
 		fmt.Fprintf(src, `  Entity: "%s",`+"\n", entity)
@@ -64,6 +68,7 @@ func main() {
 		fmt.Fprintf(src, `  ITUZone: %s,`+"\n", ituZone)
 		fmt.Fprintf(src, `  Latitude: %f,`+"\n", lat)
 		fmt.Fprintf(src, `  Longitude: %f,`+"\n", lon)
+		fmt.Fprintf(src, `  UTCOffset: %g,`+"\n", offset)
 		fmt.Fprintf(src, "  Prefixes: []string{%s},\n", splitPrefixes(prefixes))
 		fmt.Fprintf(src, `  PrefixRegexp: regexp.MustCompile("%s"),`+"\n", prefixRegexp(prefixes))
 		fmt.Fprintf(src, "},")
@@ -0,0 +1,223 @@
+package dxcc
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// prefixIndex is a trie of the entity prefixes and exact calls, so that a
+// lookup only needs to walk the characters of the call once.
+type prefixIndex struct {
+	root *indexNode
+}
+
+type indexNode struct {
+	children map[byte]*indexNode
+	// prefixes are the entities for calls beginning with the path to this
+	// node, the first one that applies at the time of the lookup wins
+	prefixes []indexEntry
+	// exacts are the entities for a call that is exactly the path to this
+	// node, which take priority over any prefix match
+	exacts []indexEntry
+	// invalid are the times that operations by the call don't count
+	invalid []validity
+	// zones are CQ zone overrides for the call
+	zones []datedZone
+}
+
+type indexEntry struct {
+	ent *Entity
+	validity
+}
+
+var (
+	indexMu sync.Mutex
+	index   *prefixIndex
+	// indexed and indexedDated are the tables the index was built from, so
+	// that it can be rebuilt if they're replaced
+	indexed      []Entity
+	indexedDated *datedTables
+)
+
+// currentIndex returns the index for the current entity table, building it if
+// required.
+func currentIndex() *prefixIndex {
+	indexMu.Lock()
+	defer indexMu.Unlock()
+	dt := dated
+	if dt != nil && !sameEntities(dt.entities, Entities) {
+		dt = nil
+	}
+	if index == nil || !sameEntities(indexed, Entities) || indexedDated != dt {
+		index = newPrefixIndex(Entities, dt)
+		indexed = Entities
+		indexedDated = dt
+	}
+	return index
+}
+
+func sameEntities(a, b []Entity) bool {
+	return len(a) == len(b) && (len(a) == 0 || &a[0] == &b[0])
+}
+
+func newPrefixIndex(ents []Entity, dt *datedTables) *prefixIndex {
+	x := &prefixIndex{root: &indexNode{}}
+
+	// the dated records come first so they win while they apply, and the
+	// current ones are also in the entity table, so skip them there
+	type entityPrefix struct {
+		dxcc int
+		key  string
+	}
+	skip := map[entityPrefix]bool{}
+	if dt != nil {
+		for _, d := range dt.exceptions {
+			x.add("="+d.prefix, d.ent, d.validity)
+			skip[entityPrefix{d.ent.DXCC, "=" + stripOverrides(d.prefix)}] = true
+		}
+		for _, d := range dt.prefixes {
+			x.add(d.prefix, d.ent, d.validity)
+			skip[entityPrefix{d.ent.DXCC, stripOverrides(d.prefix)}] = true
+		}
+		for _, d := range dt.invalid {
+			n := x.insert(d.call)
+			n.invalid = append(n.invalid, d.validity)
+		}
+		for _, d := range dt.zones {
+			n := x.insert(d.call)
+			n.zones = append(n.zones, d)
+		}
+	}
+
+	for _, e := range ents {
+		for _, pfx := range e.Prefixes {
+			if !skip[entityPrefix{e.DXCC, stripOverrides(pfx)}] {
+				x.add(pfx, e, validity{})
+			}
+		}
+	}
+	return x
+}
+
+// add adds a prefix, or an exact call if it begins with '=', for an entity.
+func (x *prefixIndex) add(pfx string, e Entity, v validity) {
+	exact := strings.HasPrefix(pfx, "=")
+	if exact {
+		pfx = pfx[1:]
+	}
+	key := stripOverrides(pfx)
+	if key == "" {
+		return
+	}
+
+	ent := e
+	applyOverrides(pfx, &ent)
+	ent.Score = len(key)
+	n := x.insert(key)
+	if exact {
+		n.exacts = append(n.exacts, indexEntry{&ent, v})
+	} else {
+		n.prefixes = append(n.prefixes, indexEntry{&ent, v})
+	}
+}
+
+func (x *prefixIndex) insert(key string) *indexNode {
+	n := x.root
+	for i := 0; i < len(key); i++ {
+		if n.children == nil {
+			n.children = map[byte]*indexNode{}
+		}
+		child, ok := n.children[key[i]]
+		if !ok {
+			child = &indexNode{}
+			n.children[key[i]] = child
+		}
+		n = child
+	}
+	return n
+}
+
+// find returns the node for a key, or nil if there isn't one.
+func (x *prefixIndex) find(key string) *indexNode {
+	n := x.root
+	for i := 0; i < len(key) && n != nil; i++ {
+		n = n.children[key[i]]
+	}
+	return n
+}
+
+// lookupAt returns the entity at time t for an exact match of the call or
+// DXCC prefix key, or otherwise the entity with the longest matching prefix of
+// key.
+func (x *prefixIndex) lookupAt(call, key string, t time.Time) (Entity, bool) {
+	exactKeys := []string{call}
+	if key != call {
+		exactKeys = append(exactKeys, key)
+	}
+
+	var best *Entity
+	for _, k := range exactKeys {
+		n := x.find(k)
+		if n == nil {
+			continue
+		}
+		for _, v := range n.invalid {
+			if v.contains(t) {
+				return Entity{}, false
+			}
+		}
+		if best == nil {
+			best = entryAt(n.exacts, t)
+		}
+	}
+
+	if best == nil {
+		n := x.root
+		for i := 0; i < len(key); i++ {
+			n = n.children[key[i]]
+			if n == nil {
+				break
+			}
+			if e := entryAt(n.prefixes, t); e != nil {
+				best = e
+			}
+		}
+	}
+	if best == nil {
+		return Entity{}, false
+	}
+
+	ent := *best
+	for _, k := range exactKeys {
+		if n := x.find(k); n != nil {
+			for _, z := range n.zones {
+				if z.contains(t) {
+					ent.CQZone = z.zone
+					return ent, true
+				}
+			}
+		}
+	}
+	return ent, true
+}
+
+// entryAt returns the first entity that applies at time t.
+func entryAt(entries []indexEntry, t time.Time) *Entity {
+	for _, e := range entries {
+		if e.contains(t) {
+			return e.ent
+		}
+	}
+	return nil
+}
+
+// stripOverrides removes any override markers from a prefix.
+func stripOverrides(pfx string) string {
+	for _, oc := range markers {
+		if idx := strings.IndexByte(pfx, oc); idx != -1 {
+			pfx = pfx[0:idx]
+		}
+	}
+	return pfx
+}
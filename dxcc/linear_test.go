@@ -0,0 +1,94 @@
+package dxcc
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/tzneal/ham-go/callsigns"
+)
+
+// linearLookup is the original lookup that checks every entity, kept to
+// verify and benchmark the prefix index against.
+func linearLookup(callsign string) (Entity, bool) {
+	cs := callsigns.ParseCallsign(callsign)
+	if !cs.HasEntity() {
+		return Entity{}, false
+	}
+	callsign = cs.DXCCPrefix()
+	matchedEntities := []Entity{}
+	for _, ent := range Entities {
+		// only look deeper if the prefix matches
+		if ent.PrefixRegexp.MatchString(callsign) {
+			if matched, ok := ent.Match(callsign); ok {
+				matchedEntities = append(matchedEntities, matched)
+			}
+		}
+	}
+	sort.Slice(matchedEntities, func(i, j int) bool {
+		return matchedEntities[i].Score > matchedEntities[j].Score
+	})
+	if len(matchedEntities) > 0 {
+		return matchedEntities[0], true
+	}
+	return Entity{}, false
+}
+
+// spotStream is a sample of the DX and spotter calls seen on a busy cluster.
+var spotStream = strings.Fields(`
+	W1AW K3LR DL1ABC G4ABC JA1XYZ VK2ABC VE3ABC PY2XX LU1ABC ZS6ABC
+	EA8ABC CT3ABC 9A1A OH2BH SM5ABC UA9ABC R3AB UA0ZZ BY1AA HL5ABC
+	4X4ABC SV9ABC KH6ABC KL7ABC KP4ABC VP2EAB 3DA0RU FO5AB ZL2ABC YB1ABC
+	DK9IP-# W3LPL-# VE7CC-# OH6BG-# EA5WU-# F5XYZ ON4ABC PA3ABC OK1ABC SP5ABC
+	HA5ABC YO9ABC LZ2ABC S51ABC OE1ABC HB9ABC IK2ABC I5ABC EI5ABC GM4ABC
+	KH6/W1AW W1AW/P N8BJQ/KH9 VE3/KN4LHY DL/G4ABC/P W1AW/MM EA8/DL1ABC/P
+	CN8ABC 5B4ABC A61ABC JY4ABC TA1ABC RA9ABC UN7ABC EX8ABC 4L1ABC EK6ABC
+	XE1ABC TI2ABC HP1ABC HK3ABC YV5ABC CE3ABC OA4ABC CX2ABC ZP5ABC CP6ABC
+	N0ABC WB9ABC KC1ABC AA1ABC KB2ABC W6ABC K7ABC N4ABC KN4LHY WD8ABC
+`)
+
+func TestIndexMatchesLinear(t *testing.T) {
+	calls := append([]string{}, spotStream...)
+	// every exact call override, except those with a designator which the
+	// linear lookup never matched exactly
+	for _, e := range Entities {
+		for _, pfx := range e.Prefixes {
+			if strings.HasPrefix(pfx, "=") && !strings.Contains(pfx, "/") {
+				calls = append(calls, stripOverrides(pfx[1:]))
+			}
+		}
+	}
+	for _, call := range calls {
+		exp, expOK := linearLookup(call)
+		got, gotOK := Lookup(call)
+		if expOK != gotOK {
+			t.Errorf("%s: expected found = %v, got %v", call, expOK, gotOK)
+			continue
+		}
+		if exp.DXCC != got.DXCC || exp.Entity != got.Entity || exp.CQZone != got.CQZone || exp.ITUZone != got.ITUZone {
+			t.Errorf("%s: expected %s (%d, CQ %d, ITU %d), got %s (%d, CQ %d, ITU %d)", call,
+				exp.Entity, exp.DXCC, exp.CQZone, exp.ITUZone,
+				got.Entity, got.DXCC, got.CQZone, got.ITUZone)
+		}
+	}
+}
+
+func BenchmarkLookup(b *testing.B) {
+	Lookup("W1AW") // build the index
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Lookup(spotStream[i%len(spotStream)])
+	}
+}
+
+func BenchmarkLinearLookup(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		linearLookup(spotStream[i%len(spotStream)])
+	}
+}
+
+func BenchmarkBuildIndex(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		newPrefixIndex(Entities, nil)
+	}
+}
@@ -0,0 +1,507 @@
+package dxcc
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CountryFiles are the country file names that Load looks for, in order of
+// preference.  They're in the Club Log cty.xml, cty.dat and cty.csv formats
+// (see www.country-files.com and clublog.org).
+var CountryFiles = []string{"cty.xml", "cty.dat", "cty.csv"}
+
+// Load replaces the built in entity table with the most recently modified
+// country file from CountryFiles found in dir, which is the one most recently
+// installed.  It returns the name of the file loaded, or the empty string if
+// there wasn't one and the built in table is still in use.  It must be called
+// before any lookups are performed.
+func Load(dir string) (string, error) {
+	filename := newestCountryFile(dir)
+	if filename == "" {
+		return "", nil
+	}
+	cd, err := parseFile(filename)
+	if err != nil {
+		return "", err
+	}
+	if err := validate(cd.entities); err != nil {
+		return "", fmt.Errorf("%s: %s", filename, err)
+	}
+	setEntities(cd.entities, cd.dated)
+	return filename, nil
+}
+
+// newestCountryFile returns the most recently modified country file in dir,
+// preferring the order of CountryFiles if they were modified at the same time.
+func newestCountryFile(dir string) string {
+	newest := ""
+	var newestTime time.Time
+	for _, name := range CountryFiles {
+		filename := filepath.Join(dir, name)
+		fi, err := os.Stat(filename)
+		if err != nil || fi.IsDir() {
+			continue
+		}
+		if newest == "" || fi.ModTime().After(newestTime) {
+			newest = filename
+			newestTime = fi.ModTime()
+		}
+	}
+	return newest
+}
+
+// Install validates a country file and copies it into dir, which is created if
+// necessary, so that Load will use it.  Gzipped files (e.g. the cty.xml.gz that
+// Club Log distributes) are decompressed.  It returns the name of the installed
+// file and the number of entities in it.
+func Install(filename string, dir string) (string, int, error) {
+	name, err := countryFileName(filename)
+	if err != nil {
+		return "", 0, err
+	}
+	data, err := readFile(filename)
+	if err != nil {
+		return "", 0, err
+	}
+	cd, err := parse(data, name)
+	if err != nil {
+		return "", 0, err
+	}
+	if err := validate(cd.entities); err != nil {
+		return "", 0, err
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", 0, err
+	}
+	dst := filepath.Join(dir, name)
+	tmp := dst + ".new"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return "", 0, err
+	}
+	if err := os.Rename(tmp, dst); err != nil {
+		os.Remove(tmp)
+		return "", 0, err
+	}
+	return dst, len(cd.entities), nil
+}
+
+// ParseFile parses a country file in cty.csv, cty.dat or Club Log cty.xml
+// format, determined from the file name.  The file may be gzipped.
+func ParseFile(filename string) ([]Entity, error) {
+	cd, err := parseFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return cd.entities, nil
+}
+
+// countryData is the contents of a country file.
+type countryData struct {
+	// entities are the current entities and prefixes
+	entities []Entity
+	// dated are any records that only apply for a range of dates
+	dated *datedTables
+}
+
+func parseFile(filename string) (*countryData, error) {
+	name, err := countryFileName(filename)
+	if err != nil {
+		return nil, err
+	}
+	data, err := readFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return parse(data, name)
+}
+
+// countryFileName returns which of the CountryFiles formats a file is in.
+func countryFileName(filename string) (string, error) {
+	ext := strings.ToLower(strings.TrimSuffix(filename, ".gz"))
+	switch filepath.Ext(ext) {
+	case ".xml":
+		return "cty.xml", nil
+	case ".dat":
+		return "cty.dat", nil
+	case ".csv":
+		return "cty.csv", nil
+	}
+	return "", fmt.Errorf("%s is not a .csv, .dat or .xml country file", filename)
+}
+
+// readFile reads a possibly gzipped file.
+func readFile(filename string) ([]byte, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var r io.Reader = f
+	if strings.HasSuffix(strings.ToLower(filename), ".gz") {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", filename, err)
+		}
+		defer gz.Close()
+		r = gz
+	}
+	return ioutil.ReadAll(r)
+}
+
+func parse(data []byte, name string) (*countryData, error) {
+	var ents []Entity
+	var err error
+	switch name {
+	case "cty.xml":
+		return parseClubLog(data)
+	case "cty.dat":
+		ents, err = parseDAT(data)
+	default:
+		ents, err = parseCSV(data)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &countryData{entities: ents}, nil
+}
+
+// parseCSV parses the cty.csv format, which has one entity per line:
+// prefix,name,dxcc,continent,cq,itu,lat,lon,utc offset,prefixes;
+func parseCSV(data []byte) ([]Entity, error) {
+	cr := csv.NewReader(bytes.NewReader(data))
+	var ents []Entity
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(record) < 10 {
+			return nil, fmt.Errorf("line %d: expected 10 fields, got %d", len(ents)+1, len(record))
+		}
+		ent, err := parseHeader(record[1], record[4], record[5], record[3], record[6], record[7], record[8])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", len(ents)+1, err)
+		}
+		ent.DXCC, err = strconv.Atoi(record[2])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid DXCC %q", len(ents)+1, record[2])
+		}
+		ent.Prefixes = strings.Fields(strings.Replace(record[9], ";", "", -1))
+		ents = append(ents, ent)
+	}
+	return ents, nil
+}
+
+// parseDAT parses the cty.dat format, where each entity is a header of colon
+// separated fields (name, cq, itu, continent, lat, lon, utc offset, prefix)
+// followed by a comma separated list of prefixes terminated by a semicolon.
+// The format has no DXCC entity codes, so they're matched by name against the
+// built in table.
+func parseDAT(data []byte) ([]Entity, error) {
+	var ents []Entity
+	rest := string(data)
+	for {
+		rest = strings.TrimSpace(rest)
+		if rest == "" {
+			break
+		}
+		end := strings.IndexByte(rest, ';')
+		if end == -1 {
+			return nil, fmt.Errorf("entity %d: missing ';'", len(ents)+1)
+		}
+		record := rest[:end]
+		rest = rest[end+1:]
+
+		fields := strings.SplitN(record, ":", 9)
+		if len(fields) != 9 {
+			return nil, fmt.Errorf("entity %d: expected 8 header fields", len(ents)+1)
+		}
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		ent, err := parseHeader(fields[0], fields[1], fields[2], fields[3], fields[4], fields[5], fields[6])
+		if err != nil {
+			return nil, fmt.Errorf("entity %d: %s", len(ents)+1, err)
+		}
+		if known, err := LookupEntity(ent.Entity); err == nil {
+			ent.DXCC = known.DXCC
+		}
+		for _, pfx := range strings.Split(fields[8], ",") {
+			if pfx = strings.TrimSpace(pfx); pfx != "" {
+				ent.Prefixes = append(ent.Prefixes, pfx)
+			}
+		}
+		ents = append(ents, ent)
+	}
+	return ents, nil
+}
+
+// parseHeader parses the entity fields common to cty.csv and cty.dat.
+func parseHeader(name, cq, itu, cont, lat, lon, offset string) (Entity, error) {
+	ent := Entity{
+		Entity:    name,
+		Continent: cont,
+	}
+	var err error
+	if ent.CQZone, err = strconv.Atoi(cq); err != nil {
+		return ent, fmt.Errorf("invalid CQ zone %q", cq)
+	}
+	if ent.ITUZone, err = strconv.Atoi(itu); err != nil {
+		return ent, fmt.Errorf("invalid ITU zone %q", itu)
+	}
+	if ent.Latitude, err = strconv.ParseFloat(lat, 64); err != nil {
+		return ent, fmt.Errorf("invalid latitude %q", lat)
+	}
+	if ent.Longitude, err = strconv.ParseFloat(lon, 64); err != nil {
+		return ent, fmt.Errorf("invalid longitude %q", lon)
+	}
+	if ent.UTCOffset, err = strconv.ParseFloat(offset, 64); err != nil {
+		return ent, fmt.Errorf("invalid UTC offset %q", offset)
+	}
+	// positive is west, so convert to normal form
+	ent.Longitude *= -1
+	ent.UTCOffset *= -1
+	return ent, nil
+}
+
+type clubLogFile struct {
+	Entities   []clubLogEntity  `xml:"entities>entity"`
+	Exceptions []clubLogRecord  `xml:"exceptions>exception"`
+	Prefixes   []clubLogRecord  `xml:"prefixes>prefix"`
+	Invalid    []clubLogInvalid `xml:"invalid_operations>invalid"`
+	Zones      []clubLogZone    `xml:"zone_exceptions>zone_exception"`
+}
+
+type clubLogEntity struct {
+	ADIF      int     `xml:"adif"`
+	Name      string  `xml:"name"`
+	Deleted   bool    `xml:"deleted"`
+	CQZone    int     `xml:"cqz"`
+	Continent string  `xml:"cont"`
+	Latitude  float64 `xml:"lat"`
+	Longitude float64 `xml:"long"`
+}
+
+// clubLogRecord is a prefix or exception record, which maps a prefix or call
+// to an entity for an optional range of dates.
+type clubLogRecord struct {
+	Call      string  `xml:"call"`
+	ADIF      int     `xml:"adif"`
+	CQZone    int     `xml:"cqz"`
+	Continent string  `xml:"cont"`
+	Latitude  float64 `xml:"lat"`
+	Longitude float64 `xml:"long"`
+	Start     string  `xml:"start"`
+	End       string  `xml:"end"`
+}
+
+// clubLogInvalid is an operation that doesn't count for any entity.
+type clubLogInvalid struct {
+	Call  string `xml:"call"`
+	Start string `xml:"start"`
+	End   string `xml:"end"`
+}
+
+// clubLogZone is a call with a CQ zone that differs from its entity's.
+type clubLogZone struct {
+	Call  string `xml:"call"`
+	Zone  int    `xml:"zone"`
+	Start string `xml:"start"`
+	End   string `xml:"end"`
+}
+
+// clubLogValidity parses the start and end dates of a record, which are
+// unbounded if they're missing.
+func clubLogValidity(start, end string) validity {
+	v := validity{}
+	if t, err := time.Parse(time.RFC3339, start); err == nil {
+		v.start = t
+	}
+	if t, err := time.Parse(time.RFC3339, end); err == nil {
+		v.end = t
+	}
+	return v
+}
+
+// parseClubLog parses the Club Log cty.xml format.  The entity table has the
+// prefixes and exceptions that currently apply, and any with date ranges are
+// also kept in the dated tables for LookupAt.  Club Log doesn't provide ITU
+// zones or UTC offsets, so they're taken from the built in table and are zero
+// for entities that aren't in it.
+func parseClubLog(data []byte) (*countryData, error) {
+	cl := clubLogFile{}
+	if err := xml.Unmarshal(data, &cl); err != nil {
+		return nil, err
+	}
+	if len(cl.Entities) == 0 {
+		return nil, errors.New("no entities found")
+	}
+
+	now := time.Now()
+	byADIF := map[int]*Entity{}
+	deleted := map[int]bool{}
+	var ents []*Entity
+	for _, ce := range cl.Entities {
+		ent := &Entity{
+			Entity:    ce.Name,
+			DXCC:      ce.ADIF,
+			Continent: ce.Continent,
+			CQZone:    ce.CQZone,
+			Latitude:  ce.Latitude,
+			Longitude: ce.Longitude,
+		}
+		// prefer the names, ITU zones and UTC offsets we already know
+		if known, err := LookupEntityCode(int64(ce.ADIF)); err == nil {
+			ent.Entity = known.Entity
+			ent.ITUZone = known.ITUZone
+			ent.UTCOffset = known.UTCOffset
+		}
+		byADIF[ce.ADIF] = ent
+		if ce.Deleted {
+			// only used for QSOs from before the entity was deleted
+			deleted[ce.ADIF] = true
+		} else {
+			ents = append(ents, ent)
+		}
+	}
+
+	dt := &datedTables{}
+	add := func(r clubLogRecord, exact bool) {
+		ent, ok := byADIF[r.ADIF]
+		if !ok {
+			return
+		}
+		pfx := r.Call
+		if r.CQZone != 0 && r.CQZone != ent.CQZone {
+			pfx += fmt.Sprintf("(%d)", r.CQZone)
+		}
+		if r.Continent != "" && r.Continent != ent.Continent {
+			pfx += "{" + r.Continent + "}"
+		}
+		if (r.Latitude != 0 || r.Longitude != 0) && (r.Latitude != ent.Latitude || r.Longitude != ent.Longitude) {
+			// the override is positive to the west like the other formats
+			pfx += fmt.Sprintf("<%.2f/%.2f>", r.Latitude, -r.Longitude)
+		}
+
+		v := clubLogValidity(r.Start, r.End)
+		if v.bounded() {
+			d := datedPrefix{prefix: pfx, ent: *ent, validity: v}
+			d.ent.Prefixes = nil
+			if exact {
+				dt.exceptions = append(dt.exceptions, d)
+			} else {
+				dt.prefixes = append(dt.prefixes, d)
+			}
+			if !v.contains(now) {
+				return
+			}
+		}
+		if deleted[r.ADIF] {
+			return
+		}
+		if exact {
+			pfx = "=" + pfx
+		}
+		ent.Prefixes = append(ent.Prefixes, pfx)
+	}
+	for _, r := range cl.Prefixes {
+		add(r, false)
+	}
+	for _, r := range cl.Exceptions {
+		add(r, true)
+	}
+	for _, r := range cl.Invalid {
+		dt.invalid = append(dt.invalid, datedCall{call: r.Call, validity: clubLogValidity(r.Start, r.End)})
+	}
+	for _, r := range cl.Zones {
+		dt.zones = append(dt.zones, datedZone{call: r.Call, zone: r.Zone, validity: clubLogValidity(r.Start, r.End)})
+	}
+
+	res := make([]Entity, 0, len(ents))
+	for _, ent := range ents {
+		// entities with no current prefixes can't be looked up
+		if len(ent.Prefixes) > 0 {
+			res = append(res, *ent)
+		}
+	}
+	return &countryData{entities: res, dated: dt}, nil
+}
+
+// validate checks that a parsed entity table is usable.
+func validate(ents []Entity) error {
+	if len(ents) == 0 {
+		return errors.New("no entities found")
+	}
+	for _, ent := range ents {
+		if ent.Entity == "" {
+			return fmt.Errorf("entity %d has no name", ent.DXCC)
+		}
+		if len(ent.Prefixes) == 0 {
+			return fmt.Errorf("%s has no prefixes", ent.Entity)
+		}
+		switch ent.Continent {
+		case "AF", "AN", "AS", "EU", "NA", "OC", "SA":
+		default:
+			return fmt.Errorf("%s has an invalid continent %q", ent.Entity, ent.Continent)
+		}
+		if ent.CQZone < 1 || ent.CQZone > 40 {
+			return fmt.Errorf("%s has an invalid CQ zone %d", ent.Entity, ent.CQZone)
+		}
+		if ent.ITUZone < 0 || ent.ITUZone > 90 {
+			return fmt.Errorf("%s has an invalid ITU zone %d", ent.Entity, ent.ITUZone)
+		}
+	}
+	return nil
+}
+
+// setEntities replaces the entity table and any dated records.
+func setEntities(ents []Entity, dt *datedTables) {
+	for i := range ents {
+		ents[i].PrefixRegexp = prefixRegexp(ents[i].Prefixes)
+	}
+	sort.SliceStable(ents, func(i, j int) bool {
+		return ents[i].DXCC < ents[j].DXCC
+	})
+	if dt != nil {
+		dt.entities = ents
+	}
+	Entities = ents
+	dated = dt
+}
+
+// prefixRegexp returns a regexp matching the first character of any of the
+// prefixes, used to quickly skip entities that can't match a call.
+func prefixRegexp(prefixes []string) *regexp.Regexp {
+	initial := map[byte]struct{}{}
+	for _, p := range prefixes {
+		p = strings.TrimPrefix(p, "=")
+		if p != "" {
+			initial[p[0]] = struct{}{}
+		}
+	}
+	var sorted []byte
+	for c := range initial {
+		sorted = append(sorted, c)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+	return regexp.MustCompile("^[" + regexp.QuoteMeta(string(sorted)) + "]")
+}
@@ -0,0 +1,328 @@
+package dxcc_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/tzneal/ham-go/dxcc"
+)
+
+const testCSV = `1A,Sov Mil Order of Malta,246,EU,15,28,41.90,-12.43,-1.0,1A;
+K,United States,291,NA,5,8,37.53,91.67,5.0,AA AB K N W =W1XYZ(3)[6];
+`
+
+const testDAT = `Sov Mil Order of Malta:   15:  28:  EU:   41.90:   -12.43:    -1.0:  1A:
+    1A;
+United States:            05:  08:  NA:   37.53:    91.67:     5.0:  K:
+    AA,AB,K,N,W,
+    =W1XYZ(3)[6];
+`
+
+const testXML = `<?xml version="1.0" encoding="UTF-8"?>
+<clublog date="2020-06-01T00:00:00+00:00" xmlns="https://clublog.org/cty/v1.2">
+<entities>
+<entity><adif>246</adif><name>SOVEREIGN MILITARY ORDER OF MALTA</name><prefix>1A</prefix><deleted>false</deleted><cqz>15</cqz><cont>EU</cont><long>12.43</long><lat>41.90</lat></entity>
+<entity><adif>291</adif><name>UNITED STATES OF AMERICA</name><prefix>K</prefix><deleted>false</deleted><cqz>5</cqz><cont>NA</cont><long>-91.67</long><lat>37.53</lat></entity>
+<entity><adif>81</adif><name>GERMANY</name><prefix>DL</prefix><deleted>true</deleted><cqz>14</cqz><cont>EU</cont><long>10.00</long><lat>51.00</lat></entity>
+</entities>
+<exceptions>
+<exception record="1"><call>W1XYZ</call><entity>UNITED STATES OF AMERICA</entity><adif>291</adif><cqz>3</cqz><cont>NA</cont><long>-120.00</long><lat>45.00</lat></exception>
+<exception record="2"><call>W1OLD</call><entity>UNITED STATES OF AMERICA</entity><adif>291</adif><cqz>3</cqz><cont>NA</cont><long>-120.00</long><lat>45.00</lat><end>2001-01-01T00:00:00+00:00</end></exception>
+</exceptions>
+<prefixes>
+<prefix record="1"><call>1A</call><entity>SOVEREIGN MILITARY ORDER OF MALTA</entity><adif>246</adif><cqz>15</cqz><cont>EU</cont><long>12.43</long><lat>41.90</lat></prefix>
+<prefix record="2"><call>K</call><entity>UNITED STATES OF AMERICA</entity><adif>291</adif><cqz>5</cqz><cont>NA</cont><long>-91.67</long><lat>37.53</lat></prefix>
+<prefix record="3"><call>W</call><entity>UNITED STATES OF AMERICA</entity><adif>291</adif><cqz>5</cqz><cont>NA</cont><long>-91.67</long><lat>37.53</lat></prefix>
+</prefixes>
+</clublog>
+`
+
+func writeFile(t *testing.T, dir, name string, data []byte) string {
+	t.Helper()
+	fn := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(fn, data, 0644); err != nil {
+		t.Fatalf("error writing %s: %s", fn, err)
+	}
+	return fn
+}
+
+func TestParseFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cty")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	gz := &bytes.Buffer{}
+	w := gzip.NewWriter(gz)
+	w.Write([]byte(testXML))
+	w.Close()
+
+	for name, data := range map[string][]byte{
+		"cty.csv":    []byte(testCSV),
+		"cty.dat":    []byte(testDAT),
+		"cty.xml":    []byte(testXML),
+		"cty.xml.gz": gz.Bytes(),
+	} {
+		ents, err := dxcc.ParseFile(writeFile(t, dir, name, data))
+		if err != nil {
+			t.Errorf("error parsing %s: %s", name, err)
+			continue
+		}
+		if len(ents) != 2 {
+			t.Errorf("expected 2 entities from %s, got %d", name, len(ents))
+			continue
+		}
+		us := ents[1]
+		if us.Entity != "United States" || us.DXCC != 291 || us.Continent != "NA" || us.CQZone != 5 || us.ITUZone != 8 {
+			t.Errorf("unexpected entity from %s: %+v", name, us)
+		}
+		if us.Longitude != -91.67 {
+			t.Errorf("expected longitude -91.67 from %s, got %f", name, us.Longitude)
+		}
+		// Club Log has no UTC offsets, so they come from the built in table
+		if filepath.Ext(name) != ".xml" && filepath.Ext(name) != ".gz" && us.UTCOffset != -5 {
+			t.Errorf("expected UTC offset -5 from %s, got %f", name, us.UTCOffset)
+		}
+		found := false
+		for _, pfx := range us.Prefixes {
+			if pfx == "=W1XYZ(3)[6]" || pfx == "=W1XYZ(3)<45.00/120.00>" {
+				found = true
+			}
+			if pfx == "=W1OLD" || pfx == "=W1OLD(3)" {
+				t.Errorf("expected expired exception to be skipped from %s", name)
+			}
+		}
+		if !found {
+			t.Errorf("expected W1XYZ exception from %s, got %v", name, us.Prefixes)
+		}
+	}
+
+	if _, err := dxcc.ParseFile(writeFile(t, dir, "cty.txt", []byte(testCSV))); err == nil {
+		t.Errorf("expected an error for an unknown format")
+	}
+	if _, err := dxcc.ParseFile(writeFile(t, dir, "bad.dat", []byte("United States: 05: 08: NA"))); err == nil {
+		t.Errorf("expected an error for a truncated cty.dat")
+	}
+}
+
+func TestInstallAndLoad(t *testing.T) {
+	orig := dxcc.Entities
+	defer func() { dxcc.Entities = orig }()
+
+	src, err := ioutil.TempDir("", "cty")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %s", err)
+	}
+	defer os.RemoveAll(src)
+	dir, err := ioutil.TempDir("", "cty")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	if fn, err := dxcc.Load(dir); err != nil || fn != "" {
+		t.Fatalf("expected nothing loaded from an empty dir, got %s %v", fn, err)
+	}
+
+	bad := writeFile(t, src, "bad.csv", []byte("K,United States,291,XX,5,8,37.53,91.67,5.0,K;\n"))
+	if _, _, err := dxcc.Install(bad, dir); err == nil {
+		t.Errorf("expected an invalid continent to fail validation")
+	}
+
+	// an older country file that was already there is left alone
+	old := writeFile(t, dir, "cty.csv", []byte(testCSV))
+	hourAgo := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(old, hourAgo, hourAgo); err != nil {
+		t.Fatalf("error setting modification time: %s", err)
+	}
+	dst, n, err := dxcc.Install(writeFile(t, src, "new.dat", []byte(testDAT)), filepath.Join(dir, "sub"))
+	if err != nil {
+		t.Fatalf("error installing to a new directory: %s", err)
+	}
+	if n != 2 || dst != filepath.Join(dir, "sub", "cty.dat") {
+		t.Errorf("unexpected install of %d entities to %s", n, dst)
+	}
+	dst, _, err = dxcc.Install(writeFile(t, src, "new.dat", []byte(testDAT)), dir)
+	if err != nil {
+		t.Fatalf("error installing: %s", err)
+	}
+	if _, err := os.Stat(old); err != nil {
+		t.Errorf("expected the other country file to be kept, got %s", err)
+	}
+
+	fn, err := dxcc.Load(dir)
+	if err != nil {
+		t.Fatalf("error loading: %s", err)
+	}
+	if fn != dst {
+		t.Errorf("expected %s to be loaded, got %s", dst, fn)
+	}
+	if len(dxcc.Entities) != 2 {
+		t.Errorf("expected 2 entities, got %d", len(dxcc.Entities))
+	}
+	ent, ok := dxcc.Lookup("W1XYZ")
+	if !ok || ent.Entity != "United States" || ent.CQZone != 3 {
+		t.Errorf("unexpected lookup result %+v", ent)
+	}
+	if _, ok := dxcc.Lookup("DL1ABC"); ok {
+		t.Errorf("expected no entity for a prefix missing from the loaded file")
+	}
+
+	// the most recently modified country file is the one loaded
+	hourAhead := time.Now().Add(time.Hour)
+	if err := os.Chtimes(old, hourAhead, hourAhead); err != nil {
+		t.Fatalf("error setting modification time: %s", err)
+	}
+	if fn, err := dxcc.Load(dir); err != nil || fn != old {
+		t.Errorf("expected %s to be loaded, got %s %v", old, fn, err)
+	}
+}
+
+const testDatedXML = `<?xml version="1.0" encoding="UTF-8"?>
+<clublog date="2020-06-01T00:00:00+00:00" xmlns="https://clublog.org/cty/v1.2">
+<entities>
+<entity><adif>246</adif><name>SOVEREIGN MILITARY ORDER OF MALTA</name><prefix>1A</prefix><deleted>false</deleted><cqz>15</cqz><cont>EU</cont><long>12.43</long><lat>41.90</lat></entity>
+<entity><adif>291</adif><name>UNITED STATES OF AMERICA</name><prefix>K</prefix><deleted>false</deleted><cqz>5</cqz><cont>NA</cont><long>-91.67</long><lat>37.53</lat></entity>
+<entity><adif>230</adif><name>FEDERAL REPUBLIC OF GERMANY</name><prefix>DL</prefix><deleted>false</deleted><cqz>14</cqz><cont>EU</cont><long>10.00</long><lat>51.00</lat></entity>
+<entity><adif>229</adif><name>GERMAN DEMOCRATIC REPUBLIC</name><prefix>Y2</prefix><deleted>true</deleted><cqz>14</cqz><cont>EU</cont><long>13.40</long><lat>52.50</lat><end>1990-10-02T23:59:59+00:00</end></entity>
+</entities>
+<exceptions>
+<exception record="1"><call>K1DXP</call><entity>SOVEREIGN MILITARY ORDER OF MALTA</entity><adif>246</adif><cqz>15</cqz><cont>EU</cont><long>12.43</long><lat>41.90</lat><start>2019-01-01T00:00:00+00:00</start><end>2019-01-31T23:59:59+00:00</end></exception>
+</exceptions>
+<prefixes>
+<prefix record="1"><call>1A</call><entity>SOVEREIGN MILITARY ORDER OF MALTA</entity><adif>246</adif><cqz>15</cqz><cont>EU</cont><long>12.43</long><lat>41.90</lat></prefix>
+<prefix record="2"><call>K</call><entity>UNITED STATES OF AMERICA</entity><adif>291</adif><cqz>5</cqz><cont>NA</cont><long>-91.67</long><lat>37.53</lat></prefix>
+<prefix record="3"><call>DL</call><entity>FEDERAL REPUBLIC OF GERMANY</entity><adif>230</adif><cqz>14</cqz><cont>EU</cont><long>10.00</long><lat>51.00</lat></prefix>
+<prefix record="4"><call>Y2</call><entity>GERMAN DEMOCRATIC REPUBLIC</entity><adif>229</adif><cqz>14</cqz><cont>EU</cont><long>13.40</long><lat>52.50</lat><end>1990-10-02T23:59:59+00:00</end></prefix>
+<prefix record="5"><call>Y2</call><entity>FEDERAL REPUBLIC OF GERMANY</entity><adif>230</adif><cqz>14</cqz><cont>EU</cont><long>10.00</long><lat>51.00</lat><start>1990-10-03T00:00:00+00:00</start></prefix>
+</prefixes>
+<invalid_operations>
+<invalid record="1"><call>1A1BAD</call><start>2015-01-01T00:00:00+00:00</start><end>2015-12-31T23:59:59+00:00</end></invalid>
+</invalid_operations>
+<zone_exceptions>
+<zone_exception record="1"><call>K1XYZ</call><zone>4</zone><start>2018-01-01T00:00:00+00:00</start></zone_exception>
+</zone_exceptions>
+</clublog>
+`
+
+func date(s string) time.Time {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestLookupAt(t *testing.T) {
+	orig := dxcc.Entities
+	defer func() { dxcc.Entities = orig }()
+
+	dir, err := ioutil.TempDir("", "cty")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	writeFile(t, dir, "cty.xml", []byte(testDatedXML))
+	if _, err := dxcc.Load(dir); err != nil {
+		t.Fatalf("error loading: %s", err)
+	}
+	for _, ent := range dxcc.Entities {
+		if ent.DXCC == 229 {
+			t.Errorf("expected deleted entities to be excluded from the entity table")
+		}
+	}
+
+	td := []struct {
+		call   string
+		date   string
+		dxcc   int
+		cqZone int
+	}{
+		{"Y22ABC", "1985-06-01", 229, 14}, // deleted entity
+		{"Y22ABC", "2000-06-01", 230, 14},
+		{"K1DXP", "2019-01-15", 246, 15}, // DXpedition with a home call
+		{"K1DXP", "2020-01-15", 291, 5},
+		{"1A1BAD", "2015-06-01", 0, 0}, // invalid operation
+		{"1A1BAD", "2016-06-01", 246, 15},
+		{"K1XYZ", "2010-06-01", 291, 5},
+		{"K1XYZ", "2019-06-01", 291, 4}, // zone exception
+	}
+	for _, tc := range td {
+		ent, ok := dxcc.LookupAt(tc.call, date(tc.date))
+		if ok != (tc.dxcc != 0) {
+			t.Errorf("%s on %s: expected found = %v, got %v", tc.call, tc.date, tc.dxcc != 0, ok)
+			continue
+		}
+		if ent.DXCC != tc.dxcc || ent.CQZone != tc.cqZone {
+			t.Errorf("%s on %s: expected %d (CQ %d), got %d (CQ %d)", tc.call, tc.date, tc.dxcc, tc.cqZone, ent.DXCC, ent.CQZone)
+		}
+	}
+	if ent, ok := dxcc.Lookup("Y22ABC"); !ok || ent.DXCC != 230 {
+		t.Errorf("expected a current lookup to find 230, got %d", ent.DXCC)
+	}
+
+	// replacing the entity table drops the dated records that went with it
+	dxcc.Entities = orig
+	if ent, ok := dxcc.LookupAt("K1DXP", date("2019-01-15")); !ok || ent.DXCC != 291 {
+		t.Errorf("expected dated records to be dropped, got %d", ent.DXCC)
+	}
+}
+
+func TestOverrides(t *testing.T) {
+	orig := dxcc.Entities
+	defer func() { dxcc.Entities = orig }()
+
+	dir, err := ioutil.TempDir("", "cty")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	writeFile(t, dir, "cty.csv", []byte(testCSV+
+		"KH6,Hawaii,110,OC,31,61,21.12,157.48,10.0,KH6 =KH6XYZ(32)[62]<19.50/155.00>{NA}~9.5~;\n"))
+	if _, err := dxcc.Load(dir); err != nil {
+		t.Fatalf("error loading: %s", err)
+	}
+
+	ent, ok := dxcc.Lookup("KH6XYZ")
+	if !ok {
+		t.Fatalf("expected to find KH6XYZ")
+	}
+	if ent.CQZone != 32 || ent.ITUZone != 62 || ent.Continent != "NA" {
+		t.Errorf("expected CQ 32, ITU 62, NA, got CQ %d, ITU %d, %s", ent.CQZone, ent.ITUZone, ent.Continent)
+	}
+	if ent.Latitude != 19.5 || ent.Longitude != -155 || ent.UTCOffset != -9.5 {
+		t.Errorf("expected 19.5/-155 UTC-9.5, got %f/%f UTC%f", ent.Latitude, ent.Longitude, ent.UTCOffset)
+	}
+	if ent, _ := dxcc.Lookup("KH6ABC"); ent.Latitude != 21.12 || ent.UTCOffset != -10 {
+		t.Errorf("expected the entity location and offset for KH6ABC, got %f UTC%f", ent.Latitude, ent.UTCOffset)
+	}
+
+	// Club Log records with their own location
+	os.Remove(filepath.Join(dir, "cty.csv"))
+	writeFile(t, dir, "cty.xml", []byte(testXML))
+	if _, err := dxcc.Load(dir); err != nil {
+		t.Fatalf("error loading: %s", err)
+	}
+	if ent, _ := dxcc.Lookup("W1XYZ"); ent.Latitude != 45 || ent.Longitude != -120 {
+		t.Errorf("expected W1XYZ at 45/-120, got %f/%f", ent.Latitude, ent.Longitude)
+	}
+}
+
+func TestLocalTime(t *testing.T) {
+	ent := dxcc.Entity{UTCOffset: 5.5}
+	lt := ent.LocalTime(time.Date(2020, 6, 1, 23, 0, 0, 0, time.UTC))
+	if got := lt.Format("2006-01-02 15:04"); got != "2020-06-02 04:30" {
+		t.Errorf("expected 2020-06-02 04:30, got %s", got)
+	}
+	for offset, exp := range map[float64]string{0: "UTC+0", -5: "UTC-5", 5.5: "UTC+5:30", -3.5: "UTC-3:30", 12.75: "UTC+12:45"} {
+		if got := dxcc.FormatUTCOffset(offset); got != exp {
+			t.Errorf("expected %s for %f, got %s", exp, offset, got)
+		}
+	}
+}
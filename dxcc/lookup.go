@@ -2,9 +2,12 @@ package dxcc
 
 import (
 	"errors"
+	"fmt"
+	"math"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 )
 
 func init() {
@@ -13,24 +16,12 @@ func init() {
 	})
 }
 
+// Lookup returns the DXCC entity for a call sign.  Stations operating from
+// another entity (e.g. KH6/W1AW or W1AW/KH6) are looked up by that entity's
+// prefix, and maritime and aeronautical mobile stations (W1AW/MM, W1AW/AM) are
+// in no entity.
 func Lookup(callsign string) (Entity, bool) {
-	callsign = strings.TrimSpace(strings.ToUpper(callsign))
-	matchedEntities := []Entity{}
-	for _, ent := range Entities {
-		// only look deeper if the prefix matches
-		if ent.PrefixRegexp.MatchString(callsign) {
-			if matched, ok := ent.Match(callsign); ok {
-				matchedEntities = append(matchedEntities, matched)
-			}
-		}
-	}
-	sort.Slice(matchedEntities, func(i, j int) bool {
-		return matchedEntities[i].Score > matchedEntities[j].Score
-	})
-	if len(matchedEntities) > 0 {
-		return matchedEntities[0], true
-	}
-	return Entity{}, false
+	return LookupAt(callsign, time.Now())
 }
 
 var markers = []byte{'(', '[', '<', '{', '~'}
@@ -79,61 +70,88 @@ func (e Entity) Match(callsign string) (Entity, bool) {
 	return e, false
 }
 
+// applyOverrides applies the override markers that follow a prefix or exact
+// call to an entity.  Longitudes and UTC offsets are positive to the west in
+// the country files, so they're converted to the normal form.
 func applyOverrides(pfx string, ent *Entity) {
-	i := 0
-	for i < len(pfx) {
-		/*
-			(#)	Override CQ Zone
-			[#]	Override ITU Zone
-			<#/#>	Override latitude/longitude
-			{aa}	Override Continent
-			~#~	Override local time offset from GMT
-		*/
-		for _, oc := range markers {
-			if pfx[i] == oc {
-				ec := byte(')')
-				switch oc {
-				case '(':
-					ec = ')'
-				case '[':
-					ec = ']'
-				case '<':
-					ec = '>'
-				case '{':
-					ec = '}'
-				case '~':
-					ec = '~'
-				}
-				i++
-
-				j := i
-				for pfx[j] != ec {
-					j++
-				}
-
-				switch oc {
-				case '(':
-					value, err := strconv.ParseInt(pfx[i:j], 10, 64)
-					if err == nil {
-						ent.CQZone = int(value)
-					}
-				case '[':
-					value, err := strconv.ParseFloat(pfx[i:j], 64)
-					if err == nil {
-						ent.ITUZone = int(value)
-					}
-				case '<':
-					// no usages of this yet
-				case '{':
-					// no usages of this yet
-				case '~':
+	/*
+		(#)	Override CQ Zone
+		[#]	Override ITU Zone
+		<#/#>	Override latitude/longitude
+		{aa}	Override Continent
+		~#~	Override local time offset from GMT
+	*/
+	for i := 0; i < len(pfx); i++ {
+		var ec byte
+		switch pfx[i] {
+		case '(':
+			ec = ')'
+		case '[':
+			ec = ']'
+		case '<':
+			ec = '>'
+		case '{':
+			ec = '}'
+		case '~':
+			ec = '~'
+		default:
+			continue
+		}
+		j := strings.IndexByte(pfx[i+1:], ec)
+		if j == -1 {
+			// unterminated override
+			return
+		}
+		value := pfx[i+1 : i+1+j]
 
+		switch pfx[i] {
+		case '(':
+			if v, err := strconv.Atoi(value); err == nil {
+				ent.CQZone = v
+			}
+		case '[':
+			if v, err := strconv.Atoi(value); err == nil {
+				ent.ITUZone = v
+			}
+		case '<':
+			sp := strings.Split(value, "/")
+			if len(sp) == 2 {
+				lat, latErr := strconv.ParseFloat(sp[0], 64)
+				lon, lonErr := strconv.ParseFloat(sp[1], 64)
+				if latErr == nil && lonErr == nil {
+					ent.Latitude = lat
+					ent.Longitude = -lon
 				}
-
+			}
+		case '{':
+			ent.Continent = value
+		case '~':
+			if v, err := strconv.ParseFloat(value, 64); err == nil {
+				ent.UTCOffset = -v
 			}
 		}
-		i++
+		i += j + 1
+	}
+}
+
+// LocalTime returns the standard (not daylight saving) time in the entity at
+// time t.
+func (e Entity) LocalTime(t time.Time) time.Time {
+	return t.In(time.FixedZone(FormatUTCOffset(e.UTCOffset), int(e.UTCOffset*3600)))
+}
+
+// FormatUTCOffset formats an offset from UTC in hours, e.g. UTC+5:30.
+func FormatUTCOffset(offset float64) string {
+	sign := "+"
+	if offset < 0 {
+		sign = "-"
+		offset = -offset
+	}
+	minutes := int(math.Round(offset * 60))
+	if minutes%60 == 0 {
+		return fmt.Sprintf("UTC%s%d", sign, minutes/60)
 	}
+	return fmt.Sprintf("UTC%s%d:%02d", sign, minutes/60, minutes%60)
 }
 
 func LookupEntity(name string) (Entity, error) {
@@ -0,0 +1,35 @@
+package geo
+
+import (
+	"strconv"
+
+	"github.com/tzneal/ham-go/adif"
+)
+
+// RecordLocation returns the location of the station in a record from its
+// grid square.
+func RecordLocation(rec adif.Record) (Point, bool) {
+	pt, err := ParseGrid(rec.Get(adif.GridSquare))
+	return pt, err == nil
+}
+
+// FillRecord adds the distance and short path beam heading from home to the
+// station at dx to a record, unless the record already has a distance.
+func FillRecord(rec adif.Record, home, dx Point) adif.Record {
+	if rec.Get(adif.Distance) != "" {
+		return rec
+	}
+	set := func(name adif.Identifier, value string) {
+		for i := range rec {
+			if rec[i].Name == name {
+				rec[i].Value = value
+				return
+			}
+		}
+		rec = append(rec, adif.Field{Name: name, Value: value})
+	}
+	set(adif.Distance, strconv.FormatFloat(home.Distance(dx), 'f', 1, 64))
+	set(adif.AntennaAz, strconv.FormatFloat(home.Bearing(dx), 'f', 0, 64))
+	set(adif.AntennaPath, "S")
+	return rec
+}
@@ -0,0 +1,45 @@
+package geo_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/tzneal/ham-go/adif"
+	"github.com/tzneal/ham-go/geo"
+)
+
+func TestFillRecord(t *testing.T) {
+	home := geo.NewPoint(40.7128, -74.0060)
+	rec := adif.Record{
+		{Name: adif.Call, Value: "G4ABC"},
+		{Name: adif.GridSquare, Value: "IO91wm"},
+	}
+	dx, ok := geo.RecordLocation(rec)
+	if !ok {
+		t.Fatalf("expected a location from the grid square")
+	}
+	rec = geo.FillRecord(rec, home, dx)
+	if d, _ := strconv.ParseFloat(rec.Get(adif.Distance), 64); !near(d, 5570, 10) {
+		t.Errorf("expected a distance of about 5570km, got %s", rec.Get(adif.Distance))
+	}
+	if got := rec.Get(adif.AntennaAz); got != "51" {
+		t.Errorf("expected an azimuth of 51, got %s", got)
+	}
+	if got := rec.Get(adif.AntennaPath); got != "S" {
+		t.Errorf("expected the short path, got %s", got)
+	}
+
+	// an existing distance is kept
+	rec = adif.Record{
+		{Name: adif.Call, Value: "G4ABC"},
+		{Name: adif.Distance, Value: "5000.0"},
+	}
+	rec = geo.FillRecord(rec, home, dx)
+	if got := rec.Get(adif.Distance); got != "5000.0" || rec.Get(adif.AntennaAz) != "" {
+		t.Errorf("expected the record to be unchanged, got %v", rec)
+	}
+
+	if _, ok := geo.RecordLocation(adif.Record{{Name: adif.Call, Value: "G4ABC"}}); ok {
+		t.Errorf("expected no location without a grid square")
+	}
+}
@@ -0,0 +1,69 @@
+// Package geo provides the great circle distances, beam headings and
+// Maidenhead grid locator math used for logging and spotting.
+package geo
+
+import "math"
+
+// EarthRadius is the mean radius of the earth in kilometers.
+const EarthRadius = 6371.0
+
+// Point is a location in decimal degrees, with north and east positive.
+type Point struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// NewPoint constructs a new point.
+func NewPoint(latitude, longitude float64) Point {
+	return Point{Latitude: latitude, Longitude: longitude}
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+func degrees(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
+
+// normalizeBearing returns a bearing in the range [0, 360).
+func normalizeBearing(deg float64) float64 {
+	deg = math.Mod(deg, 360)
+	if deg < 0 {
+		deg += 360
+	}
+	return deg
+}
+
+// Distance returns the short path great circle distance to another point in
+// kilometers.
+func (p Point) Distance(o Point) float64 {
+	lat1, lat2 := radians(p.Latitude), radians(o.Latitude)
+	dLat := lat2 - lat1
+	dLon := radians(o.Longitude - p.Longitude)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * EarthRadius * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
+
+// LongPathDistance returns the long path great circle distance to another
+// point in kilometers.
+func (p Point) LongPathDistance(o Point) float64 {
+	return 2*math.Pi*EarthRadius - p.Distance(o)
+}
+
+// Bearing returns the short path initial bearing to another point in degrees
+// from true north.
+func (p Point) Bearing(o Point) float64 {
+	lat1, lat2 := radians(p.Latitude), radians(o.Latitude)
+	dLon := radians(o.Longitude - p.Longitude)
+	y := math.Sin(dLon) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(dLon)
+	return normalizeBearing(degrees(math.Atan2(y, x)))
+}
+
+// LongPathBearing returns the long path initial bearing to another point in
+// degrees from true north, which is opposite the short path.
+func (p Point) LongPathBearing(o Point) float64 {
+	return normalizeBearing(p.Bearing(o) + 180)
+}
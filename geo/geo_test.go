@@ -0,0 +1,41 @@
+package geo_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tzneal/ham-go/geo"
+)
+
+func near(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance
+}
+
+func TestDistanceAndBearing(t *testing.T) {
+	newYork := geo.NewPoint(40.7128, -74.0060)
+	london := geo.NewPoint(51.5074, -0.1278)
+
+	if d := newYork.Distance(london); !near(d, 5570, 1) {
+		t.Errorf("expected a distance of 5570km, got %f", d)
+	}
+	if d := newYork.LongPathDistance(london); !near(d, 40030-5570, 1) {
+		t.Errorf("expected a long path distance of %dkm, got %f", 40030-5570, d)
+	}
+	if b := newYork.Bearing(london); !near(b, 51.2, 0.1) {
+		t.Errorf("expected a bearing of 51.2, got %f", b)
+	}
+	if b := london.Bearing(newYork); !near(b, 288.3, 0.1) {
+		t.Errorf("expected a bearing of 288.3, got %f", b)
+	}
+	if b := newYork.LongPathBearing(london); !near(b, 231.2, 0.1) {
+		t.Errorf("expected a long path bearing of 231.2, got %f", b)
+	}
+	if d := london.Distance(london); d != 0 {
+		t.Errorf("expected no distance to the same point, got %f", d)
+	}
+
+	// due south across the equator
+	if b := geo.NewPoint(10, 20).Bearing(geo.NewPoint(-10, 20)); !near(b, 180, 1e-9) {
+		t.Errorf("expected a bearing of 180, got %f", b)
+	}
+}
@@ -0,0 +1,171 @@
+package geo
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// gridDivisions are the number of divisions of the enclosing area for each pair
+// of characters in a grid locator: fields (A-R), squares (0-9), subsquares
+// (a-x), extended squares (0-9) and extended subsquares (a-x).
+var gridDivisions = []int{18, 10, 24, 10, 24}
+
+// Bounds is the area covered by a grid locator.
+type Bounds struct {
+	South, West, North, East float64
+}
+
+// Center returns the point at the center of the bounds.
+func (b Bounds) Center() Point {
+	return Point{
+		Latitude:  (b.South + b.North) / 2,
+		Longitude: (b.West + b.East) / 2,
+	}
+}
+
+// Contains returns true if a point is within the bounds.
+func (b Bounds) Contains(p Point) bool {
+	return p.Latitude >= b.South && p.Latitude < b.North &&
+		p.Longitude >= b.West && p.Longitude < b.East
+}
+
+// gridDigit returns the index of a grid locator character for a pair, or -1 if
+// it isn't valid there.
+func gridDigit(c byte, pair int) int {
+	if pair%2 == 1 {
+		if c >= '0' && c <= '9' {
+			return int(c - '0')
+		}
+		return -1
+	}
+	if c >= 'a' && c <= 'z' {
+		c -= 'a' - 'A'
+	}
+	idx := int(c - 'A')
+	if c < 'A' || idx >= gridDivisions[pair] {
+		return -1
+	}
+	return idx
+}
+
+// ValidGrid returns true if a grid locator is a valid 4, 6, 8 or 10 character
+// locator.  Case doesn't matter.
+func ValidGrid(grid string) bool {
+	if len(grid) < 4 || len(grid) > 10 || len(grid)%2 != 0 {
+		return false
+	}
+	for i := 0; i < len(grid); i++ {
+		if gridDigit(grid[i], i/2) == -1 {
+			return false
+		}
+	}
+	return true
+}
+
+// NormalizeGrid returns a grid locator in the conventional case, with the field
+// in upper case and the subsquares in lower case (e.g. FN31pr).
+func NormalizeGrid(grid string) (string, error) {
+	grid = strings.TrimSpace(grid)
+	if !ValidGrid(grid) {
+		return "", fmt.Errorf("invalid grid locator %q", grid)
+	}
+	b := []byte(strings.ToLower(grid))
+	b[0] -= 'a' - 'A'
+	b[1] -= 'a' - 'A'
+	return string(b), nil
+}
+
+// GridBounds returns the area covered by a grid locator.
+func GridBounds(grid string) (Bounds, error) {
+	grid = strings.TrimSpace(grid)
+	if !ValidGrid(grid) {
+		return Bounds{}, fmt.Errorf("invalid grid locator %q", grid)
+	}
+	lonSize, latSize := 360.0, 180.0
+	lon, lat := -180.0, -90.0
+	for pair := 0; pair < len(grid)/2; pair++ {
+		lonSize /= float64(gridDivisions[pair])
+		latSize /= float64(gridDivisions[pair])
+		lon += float64(gridDigit(grid[pair*2], pair)) * lonSize
+		lat += float64(gridDigit(grid[pair*2+1], pair)) * latSize
+	}
+	return Bounds{South: lat, West: lon, North: lat + latSize, East: lon + lonSize}, nil
+}
+
+// ParseGrid returns the point at the center of a grid locator.
+func ParseGrid(grid string) (Point, error) {
+	b, err := GridBounds(grid)
+	if err != nil {
+		return Point{}, err
+	}
+	return b.Center(), nil
+}
+
+// Grid returns the grid locator containing the point with a precision of 4, 6,
+// 8 or 10 characters.
+func (p Point) Grid(precision int) (string, error) {
+	if precision < 4 || precision > 10 || precision%2 != 0 {
+		return "", fmt.Errorf("invalid grid precision %d", precision)
+	}
+	if p.Latitude < -90 || p.Latitude > 90 || p.Longitude < -180 || p.Longitude > 180 {
+		return "", fmt.Errorf("invalid location %f, %f", p.Latitude, p.Longitude)
+	}
+	lon, lat := p.Longitude+180, p.Latitude+90
+	lonSize, latSize := 360.0, 180.0
+	b := make([]byte, 0, precision)
+	for pair := 0; pair < precision/2; pair++ {
+		div := gridDivisions[pair]
+		lonSize /= float64(div)
+		latSize /= float64(div)
+		// the east and north edges belong to the last division
+		x := int(math.Min(math.Floor(lon/lonSize), float64(div-1)))
+		y := int(math.Min(math.Floor(lat/latSize), float64(div-1)))
+		lon -= float64(x) * lonSize
+		lat -= float64(y) * latSize
+
+		switch {
+		case pair == 0:
+			b = append(b, byte('A'+x), byte('A'+y))
+		case pair%2 == 1:
+			b = append(b, byte('0'+x), byte('0'+y))
+		default:
+			b = append(b, byte('a'+x), byte('a'+y))
+		}
+	}
+	return string(b), nil
+}
+
+// GridNeighbors returns the grid locators of the same precision surrounding a
+// grid, clockwise starting from the north.  Longitude wraps around, but there
+// are no neighbors beyond the poles.
+func GridNeighbors(grid string) ([]string, error) {
+	b, err := GridBounds(grid)
+	if err != nil {
+		return nil, err
+	}
+	c := b.Center()
+	height := b.North - b.South
+	width := b.East - b.West
+
+	offsets := [][2]int{{1, 0}, {1, 1}, {0, 1}, {-1, 1}, {-1, 0}, {-1, -1}, {0, -1}, {1, -1}}
+	var neighbors []string
+	for _, o := range offsets {
+		lat := c.Latitude + float64(o[0])*height
+		if lat < -90 || lat > 90 {
+			continue
+		}
+		lon := c.Longitude + float64(o[1])*width
+		if lon >= 180 {
+			lon -= 360
+		} else if lon < -180 {
+			lon += 360
+		}
+		n, err := NewPoint(lat, lon).Grid(len(grid))
+		if err != nil {
+			return nil, err
+		}
+		neighbors = append(neighbors, n)
+	}
+	return neighbors, nil
+}
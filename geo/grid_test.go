@@ -0,0 +1,115 @@
+package geo_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tzneal/ham-go/geo"
+)
+
+func TestValidGrid(t *testing.T) {
+	for grid, exp := range map[string]bool{
+		"FN31":         true,
+		"fn31PR":       true,
+		"FN31pr21":     true,
+		"FN31pr21rn":   true,
+		"RR99xx99xx":   true,
+		"FN":           false,
+		"FN3":          false,
+		"FN31p":        false,
+		"SN31":         false,
+		"FN3A":         false,
+		"FN31py":       false,
+		"FN31prA1":     false,
+		"FN31pr21rn00": false,
+		"":             false,
+	} {
+		if got := geo.ValidGrid(grid); got != exp {
+			t.Errorf("%q: expected valid = %v, got %v", grid, exp, got)
+		}
+	}
+}
+
+func TestGrid(t *testing.T) {
+	pt := geo.NewPoint(41.714775, -72.727260)
+	for precision, exp := range map[int]string{
+		4:  "FN31",
+		6:  "FN31pr",
+		8:  "FN31pr21",
+		10: "FN31pr21rn",
+	} {
+		grid, err := pt.Grid(precision)
+		if err != nil {
+			t.Errorf("error converting to %d characters: %s", precision, err)
+		}
+		if grid != exp {
+			t.Errorf("expected %s, got %s", exp, grid)
+		}
+	}
+	if _, err := pt.Grid(5); err == nil {
+		t.Errorf("expected an error for an odd precision")
+	}
+	if grid, _ := geo.NewPoint(90, 180).Grid(6); grid != "RR99xx" {
+		t.Errorf("expected the north east corner to be RR99xx, got %s", grid)
+	}
+	if grid, _ := geo.NewPoint(-90, -180).Grid(6); grid != "AA00aa" {
+		t.Errorf("expected the south west corner to be AA00aa, got %s", grid)
+	}
+}
+
+func TestParseGrid(t *testing.T) {
+	b, err := geo.GridBounds("jo01")
+	if err != nil {
+		t.Fatalf("error parsing grid: %s", err)
+	}
+	if b != (geo.Bounds{South: 51, West: 0, North: 52, East: 2}) {
+		t.Errorf("unexpected bounds %+v", b)
+	}
+
+	pt, err := geo.ParseGrid("FN31pr")
+	if err != nil {
+		t.Fatalf("error parsing grid: %s", err)
+	}
+	if !near(pt.Latitude, 41.729167, 1e-6) || !near(pt.Longitude, -72.708333, 1e-6) {
+		t.Errorf("unexpected center %+v", pt)
+	}
+	// the center of each grid converts back to the grid
+	for _, grid := range []string{"FN31", "FN31pr", "FN31pr21", "FN31pr21rn", "AA00aa", "RR99xx99xx"} {
+		pt, err := geo.ParseGrid(grid)
+		if err != nil {
+			t.Errorf("error parsing %s: %s", grid, err)
+			continue
+		}
+		if got, _ := pt.Grid(len(grid)); got != grid {
+			t.Errorf("expected %s, got %s", grid, got)
+		}
+	}
+	if _, err := geo.ParseGrid("FN3"); err == nil {
+		t.Errorf("expected an error for an invalid grid")
+	}
+	if grid, _ := geo.NormalizeGrid(" fn31PR21RN "); grid != "FN31pr21rn" {
+		t.Errorf("expected FN31pr21rn, got %s", grid)
+	}
+}
+
+func TestGridNeighbors(t *testing.T) {
+	td := []struct {
+		grid string
+		exp  []string
+	}{
+		{"FN31pr", []string{"FN31ps", "FN31qs", "FN31qr", "FN31qq", "FN31pq", "FN31oq", "FN31or", "FN31os"}},
+		{"FN31", []string{"FN32", "FN42", "FN41", "FN40", "FN30", "FN20", "FN21", "FN22"}},
+		// wraps around in longitude and stops at the pole
+		{"AA00", []string{"AA01", "AA11", "AA10", "RA90", "RA91"}},
+	}
+	for _, tc := range td {
+		got, err := geo.GridNeighbors(tc.grid)
+		if err != nil {
+			t.Errorf("error finding neighbors of %s: %s", tc.grid, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tc.exp) {
+			t.Errorf("%s: expected %v, got %v", tc.grid, tc.exp, got)
+		}
+	}
+}
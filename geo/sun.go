@@ -0,0 +1,124 @@
+package geo
+
+import (
+	"math"
+	"time"
+)
+
+// sunriseElevation is the elevation of the sun at sunrise and sunset in
+// degrees, accounting for refraction and the size of the sun.
+const sunriseElevation = -0.833
+
+// GreylineElevation is how far in degrees the sun can be above or below the
+// horizon for a location to be considered in the greyline, which is roughly
+// the civil twilight either side of sunrise and sunset.
+const GreylineElevation = 6.0
+
+// Daylight is whether it's day or night at a location.
+type Daylight int
+
+// Daylight constants
+const (
+	Night Daylight = iota
+	Greyline
+	Day
+)
+
+func (d Daylight) String() string {
+	switch d {
+	case Night:
+		return "night"
+	case Greyline:
+		return "greyline"
+	case Day:
+		return "day"
+	}
+	return "unknown"
+}
+
+// ParseDaylight parses the name of a daylight state as returned by String.
+func ParseDaylight(s string) (Daylight, bool) {
+	for _, d := range []Daylight{Night, Greyline, Day} {
+		if d.String() == s {
+			return d, true
+		}
+	}
+	return Night, false
+}
+
+// SunTimes are the times of sunrise and sunset at a location for a day.
+type SunTimes struct {
+	Sunrise time.Time
+	Sunset  time.Time
+	// the sun doesn't rise or set near the poles for part of the year, in
+	// which case Sunrise and Sunset are zero
+	AlwaysUp   bool
+	AlwaysDown bool
+}
+
+// solarPosition returns the sun's declination in radians and the equation of
+// time in minutes at time t, using the NOAA approximations.
+func solarPosition(t time.Time) (decl, eqTime float64) {
+	t = t.UTC()
+	hour := float64(t.Hour()) + float64(t.Minute())/60 + float64(t.Second())/3600
+	g := 2 * math.Pi / 365 * (float64(t.YearDay()-1) + (hour-12)/24)
+	eqTime = 229.18 * (0.000075 + 0.001868*math.Cos(g) - 0.032077*math.Sin(g) -
+		0.014615*math.Cos(2*g) - 0.040849*math.Sin(2*g))
+	decl = 0.006918 - 0.399912*math.Cos(g) + 0.070257*math.Sin(g) -
+		0.006758*math.Cos(2*g) + 0.000907*math.Sin(2*g) -
+		0.002697*math.Cos(3*g) + 0.00148*math.Sin(3*g)
+	return decl, eqTime
+}
+
+// SunElevation returns the elevation of the sun above the horizon in degrees
+// at time t.
+func (p Point) SunElevation(t time.Time) float64 {
+	decl, eqTime := solarPosition(t)
+	t = t.UTC()
+	minutes := float64(t.Hour()*60+t.Minute()) + float64(t.Second())/60
+	// true solar time gives the hour angle, which is zero at solar noon
+	solarTime := minutes + eqTime + 4*p.Longitude
+	hourAngle := radians(solarTime/4 - 180)
+	lat := radians(p.Latitude)
+	cosZenith := math.Sin(lat)*math.Sin(decl) + math.Cos(lat)*math.Cos(decl)*math.Cos(hourAngle)
+	cosZenith = math.Max(-1, math.Min(1, cosZenith))
+	return 90 - degrees(math.Acos(cosZenith))
+}
+
+// Daylight returns whether it's day, night or in the greyline at time t.
+func (p Point) Daylight(t time.Time) Daylight {
+	elevation := p.SunElevation(t)
+	switch {
+	case elevation > GreylineElevation:
+		return Day
+	case elevation < -GreylineElevation:
+		return Night
+	}
+	return Greyline
+}
+
+// SunTimes returns the times of sunrise and sunset in UTC on the UTC date of t.
+func (p Point) SunTimes(t time.Time) SunTimes {
+	t = t.UTC()
+	noon := time.Date(t.Year(), t.Month(), t.Day(), 12, 0, 0, 0, time.UTC)
+	decl, eqTime := solarPosition(noon)
+	lat := radians(p.Latitude)
+	cosHA := math.Cos(radians(90-sunriseElevation))/(math.Cos(lat)*math.Cos(decl)) -
+		math.Tan(lat)*math.Tan(decl)
+	switch {
+	case cosHA < -1:
+		return SunTimes{AlwaysUp: true}
+	case cosHA > 1:
+		return SunTimes{AlwaysDown: true}
+	}
+	ha := degrees(math.Acos(cosHA))
+
+	midnight := noon.Add(-12 * time.Hour)
+	at := func(minutes float64) time.Time {
+		return midnight.Add(time.Duration(minutes * float64(time.Minute))).Truncate(time.Second)
+	}
+	return SunTimes{
+		Sunrise: at(720 - 4*(p.Longitude+ha) - eqTime),
+		Sunset:  at(720 - 4*(p.Longitude-ha) - eqTime),
+	}
+}
@@ -0,0 +1,67 @@
+package geo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tzneal/ham-go/geo"
+)
+
+func TestSunTimes(t *testing.T) {
+	midsummer := time.Date(2020, 6, 21, 15, 0, 0, 0, time.UTC)
+	td := []struct {
+		name    string
+		pt      geo.Point
+		sunrise string
+		sunset  string
+	}{
+		{"London", geo.NewPoint(51.5074, -0.1278), "2020-06-21 03:43", "2020-06-21 20:21"},
+		// sunset is after midnight UTC
+		{"New York", geo.NewPoint(40.7128, -74.0060), "2020-06-21 09:25", "2020-06-22 00:31"},
+		{"Sydney", geo.NewPoint(-33.8688, 151.2093), "2020-06-20 21:00", "2020-06-21 06:54"},
+	}
+	for _, tc := range td {
+		st := tc.pt.SunTimes(midsummer)
+		// the approximation is good to a couple of minutes
+		for _, c := range []struct {
+			label string
+			got   time.Time
+			exp   string
+		}{{"sunrise", st.Sunrise, tc.sunrise}, {"sunset", st.Sunset, tc.sunset}} {
+			exp, _ := time.Parse("2006-01-02 15:04", c.exp)
+			if d := c.got.Sub(exp); d < -2*time.Minute || d > 2*time.Minute {
+				t.Errorf("%s: expected %s at %s, got %s", tc.name, c.label, c.exp, c.got)
+			}
+		}
+	}
+
+	tromso := geo.NewPoint(69.6492, 18.9553)
+	if st := tromso.SunTimes(midsummer); !st.AlwaysUp {
+		t.Errorf("expected the midnight sun in Tromsø, got %+v", st)
+	}
+	if st := tromso.SunTimes(midsummer.AddDate(0, 6, 0)); !st.AlwaysDown {
+		t.Errorf("expected the polar night in Tromsø, got %+v", st)
+	}
+}
+
+func TestDaylight(t *testing.T) {
+	london := geo.NewPoint(51.5074, -0.1278)
+	td := []struct {
+		time string
+		exp  geo.Daylight
+	}{
+		{"2020-06-21 12:00", geo.Day},
+		{"2020-06-21 00:30", geo.Night},
+		{"2020-06-21 03:43", geo.Greyline},
+		{"2020-06-21 20:21", geo.Greyline},
+	}
+	for _, tc := range td {
+		tm, _ := time.Parse("2006-01-02 15:04", tc.time)
+		if got := london.Daylight(tm); got != tc.exp {
+			t.Errorf("%s: expected %s, got %s (elevation %f)", tc.time, tc.exp, got, london.SunElevation(tm))
+		}
+	}
+	if d, ok := geo.ParseDaylight("greyline"); !ok || d != geo.Greyline {
+		t.Errorf("expected to parse greyline")
+	}
+}
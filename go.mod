@@ -10,7 +10,6 @@ require (
 	github.com/go-git/go-git/v5 v5.0.0
 	github.com/mattn/go-runewidth v0.0.9 // indirect
 	github.com/nsf/termbox-go v0.0.0-20200418040025-38ba6e5628f1
-	github.com/pd0mz/go-maidenhead v0.0.0-20170221185439-faa09c24425e
 	go.etcd.io/bbolt v1.3.4
 	golang.org/x/crypto v0.0.0-20200429183012-4b2356b1ed79 // indirect
 	golang.org/x/net v0.0.0-20200501053045-e0ff5e5a1de5 // indirect
@@ -0,0 +1,183 @@
+package pota
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/tzneal/ham-go/adif"
+)
+
+// RequiredQSOs is the number of QSOs required from a single park in a single
+// UTC day for a valid activation.
+const RequiredQSOs = 10
+
+// SIG is the ADIF sig/my_sig value for POTA.
+const SIG = "POTA"
+
+// Activation is the progress of activating a park on a UTC day.
+type Activation struct {
+	Park string
+	Date string // UTC date in YYYYMMDD format
+
+	// ParkToPark is the number of valid QSOs that were with another park
+	ParkToPark int
+
+	// contacts is the set of call/band/mode combinations worked, as a station
+	// only counts once per band and mode
+	contacts map[contactKey]struct{}
+}
+
+type contactKey struct {
+	call string
+	band string
+	mode string
+}
+
+// QSOs returns the number of QSOs that count towards the activation.
+func (a *Activation) QSOs() int {
+	return len(a.contacts)
+}
+
+// Valid returns true if the activation has the required number of QSOs.
+func (a *Activation) Valid() bool {
+	return a.QSOs() >= RequiredQSOs
+}
+
+// Activator tracks activations by park and UTC day.
+type Activator struct {
+	activations map[activationKey]*Activation
+}
+
+type activationKey struct {
+	park string
+	date string
+}
+
+// NewActivator constructs a new activation tracker.
+func NewActivator() *Activator {
+	return &Activator{
+		activations: map[activationKey]*Activation{},
+	}
+}
+
+// ActivatedParks returns the parks that a QSO was made from, using either
+// my_pota_ref or my_sig_info when my_sig is POTA.
+func ActivatedParks(rec adif.Record) []string {
+	if ref := rec.Get(adif.MyPOTARef); ref != "" {
+		return References(ref)
+	}
+	if strings.EqualFold(rec.Get(adif.MySIG), SIG) {
+		return References(rec.Get(adif.MySIGInfo))
+	}
+	return nil
+}
+
+// HuntedParks returns the parks that were worked in a QSO, using either
+// pota_ref or sig_info when sig is POTA.
+func HuntedParks(rec adif.Record) []string {
+	if ref := rec.Get(adif.POTARef); ref != "" {
+		return References(ref)
+	}
+	if strings.EqualFold(rec.Get(adif.SIG), SIG) {
+		return References(rec.Get(adif.SIGInfo))
+	}
+	return nil
+}
+
+// Add adds a QSO to the tracker.  QSOs not made from a park are ignored, and a
+// QSO made from multiple parks counts for each of them.
+func (a *Activator) Add(rec adif.Record) {
+	date := rec.Get(adif.QSODateStart)
+	if date == "" {
+		return
+	}
+	ck := contactKey{
+		call: strings.ToUpper(rec.Get(adif.Call)),
+		band: rec.GetBand(),
+		mode: strings.ToUpper(rec.Get(adif.AMode)),
+	}
+	p2p := len(HuntedParks(rec)) > 0
+	for _, park := range ActivatedParks(rec) {
+		key := activationKey{park, date}
+		act, ok := a.activations[key]
+		if !ok {
+			act = &Activation{
+				Park:     park,
+				Date:     date,
+				contacts: map[contactKey]struct{}{},
+			}
+			a.activations[key] = act
+		}
+		if _, dupe := act.contacts[ck]; dupe {
+			continue
+		}
+		act.contacts[ck] = struct{}{}
+		if p2p {
+			act.ParkToPark++
+		}
+	}
+}
+
+// Activation returns the activation of a park on a UTC date (YYYYMMDD). If
+// there are no QSOs, an empty activation is returned.
+func (a *Activator) Activation(park, date string) *Activation {
+	park = NormalizeReference(park)
+	if act, ok := a.activations[activationKey{park, date}]; ok {
+		return act
+	}
+	return &Activation{Park: park, Date: date}
+}
+
+// Activations returns all of the activations ordered by date and park.
+func (a *Activator) Activations() []*Activation {
+	ret := []*Activation{}
+	for _, act := range a.activations {
+		ret = append(ret, act)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Date != ret[j].Date {
+			return ret[i].Date < ret[j].Date
+		}
+		return ret[i].Park < ret[j].Park
+	})
+	return ret
+}
+
+// Hunter tracks the unique parks that have been worked.
+type Hunter struct {
+	parks map[string]int
+}
+
+// NewHunter constructs a new hunter tracker.
+func NewHunter() *Hunter {
+	return &Hunter{
+		parks: map[string]int{},
+	}
+}
+
+// Add adds a QSO to the tracker. QSOs that weren't with a park are ignored.
+func (h *Hunter) Add(rec adif.Record) {
+	for _, park := range HuntedParks(rec) {
+		h.parks[park]++
+	}
+}
+
+// Parks returns the sorted list of unique parks worked.
+func (h *Hunter) Parks() []string {
+	ret := []string{}
+	for p := range h.parks {
+		ret = append(ret, p)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// QSOs returns the number of QSOs with a park.
+func (h *Hunter) QSOs(park string) int {
+	return h.parks[NormalizeReference(park)]
+}
+
+// Worked returns true if the park has been worked.
+func (h *Hunter) Worked(park string) bool {
+	return h.QSOs(park) > 0
+}
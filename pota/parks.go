@@ -0,0 +1,116 @@
+// Package pota tracks Parks on the Air activations and hunted parks.
+package pota
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Park is a single park from the POTA park list.
+type Park struct {
+	Reference string
+	Name      string
+	Location  string
+	Grid      string
+	Latitude  float64
+	Longitude float64
+	Active    bool
+}
+
+// Parks is a set of parks keyed by reference.
+type Parks map[string]Park
+
+// LoadParksFile loads a park list in the format of the POTA all_parks.csv
+// export.
+func LoadParksFile(filename string) (Parks, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return LoadParks(f)
+}
+
+// LoadParks loads a park list in the format of the POTA all_parks.csv export.
+// The header row is used to locate the columns, and only the reference and
+// name columns are required.
+func LoadParks(r io.Reader) (Parks, error) {
+	rdr := csv.NewReader(r)
+	rdr.FieldsPerRecord = -1
+	header, err := rdr.Read()
+	if err != nil {
+		return nil, err
+	}
+	cols := map[string]int{}
+	for i, h := range header {
+		cols[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	refCol, ok := cols["reference"]
+	if !ok {
+		return nil, errors.New("park list is missing the reference column")
+	}
+	nameCol, ok := cols["name"]
+	if !ok {
+		return nil, errors.New("park list is missing the name column")
+	}
+	get := func(rec []string, col string) string {
+		if i, ok := cols[col]; ok && i < len(rec) {
+			return strings.TrimSpace(rec[i])
+		}
+		return ""
+	}
+
+	parks := Parks{}
+	for {
+		rec, err := rdr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if refCol >= len(rec) || nameCol >= len(rec) {
+			continue
+		}
+		p := Park{
+			Reference: NormalizeReference(rec[refCol]),
+			Name:      strings.TrimSpace(rec[nameCol]),
+			Location:  get(rec, "locationdesc"),
+			Grid:      get(rec, "grid"),
+			Active:    get(rec, "active") != "0",
+		}
+		p.Latitude, _ = strconv.ParseFloat(get(rec, "latitude"), 64)
+		p.Longitude, _ = strconv.ParseFloat(get(rec, "longitude"), 64)
+		parks[p.Reference] = p
+	}
+	return parks, nil
+}
+
+// Name returns the name of a park, or the empty string if the park isn't
+// known.
+func (p Parks) Name(ref string) string {
+	return p[NormalizeReference(ref)].Name
+}
+
+// NormalizeReference returns the canonical upper case form of a park
+// reference.
+func NormalizeReference(ref string) string {
+	return strings.ToUpper(strings.TrimSpace(ref))
+}
+
+// References splits a sig_info value that may contain multiple comma separated
+// park references (e.g. a two-fer) into individual references.
+func References(sigInfo string) []string {
+	ret := []string{}
+	for _, ref := range strings.Split(sigInfo, ",") {
+		ref = NormalizeReference(ref)
+		if ref != "" {
+			ret = append(ret, ref)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,130 @@
+package pota_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tzneal/ham-go/adif"
+	"github.com/tzneal/ham-go/pota"
+)
+
+const parkList = `"reference","name","active","entityId","locationDesc","latitude","longitude","grid"
+"K-0001","Acadia National Park","1","291","US-ME","44.31","-68.2034","FN54vh"
+"K-0039","Yellowstone National Park","1","291","US-WY,US-MT,US-ID","44.6","-110.5","DN44xo"
+`
+
+func TestLoadParks(t *testing.T) {
+	parks, err := pota.LoadParks(strings.NewReader(parkList))
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+	if len(parks) != 2 {
+		t.Errorf("expected 2 parks, got %d", len(parks))
+	}
+	if got := parks.Name("k-0039"); got != "Yellowstone National Park" {
+		t.Errorf("expected Yellowstone National Park, got %s", got)
+	}
+	p := parks["K-0001"]
+	if p.Grid != "FN54vh" || p.Latitude != 44.31 || p.Location != "US-ME" {
+		t.Errorf("unexpected park %+v", p)
+	}
+	if _, err := pota.LoadParks(strings.NewReader("foo,bar\n1,2\n")); err == nil {
+		t.Errorf("expected an error for a list without a reference column")
+	}
+}
+
+func TestActivation(t *testing.T) {
+	act := pota.NewActivator()
+	calls := []string{"W1AW", "K1ABC", "N1XYZ", "W2AB", "K2CD", "N2EF", "W3GH", "K3IJ", "N3KL"}
+	for _, c := range calls {
+		act.Add(adif.Record{
+			{Name: adif.Call, Value: c},
+			{Name: adif.QSODateStart, Value: "20200704"},
+			{Name: adif.ABand, Value: "20m"},
+			{Name: adif.AMode, Value: "SSB"},
+			{Name: adif.MySIG, Value: "POTA"},
+			{Name: adif.MySIGInfo, Value: "K-0001,K-0002"},
+		})
+	}
+	// dupe on the same band/mode doesn't count
+	act.Add(adif.Record{
+		{Name: adif.Call, Value: "W1AW"},
+		{Name: adif.QSODateStart, Value: "20200704"},
+		{Name: adif.ABand, Value: "20m"},
+		{Name: adif.AMode, Value: "SSB"},
+		{Name: adif.MySIG, Value: "POTA"},
+		{Name: adif.MySIGInfo, Value: "K-0001,K-0002"},
+	})
+	a := act.Activation("K-0001", "20200704")
+	if a.QSOs() != 9 || a.Valid() {
+		t.Errorf("expected 9 QSOs and an invalid activation, got %d", a.QSOs())
+	}
+
+	// the same call on another band does count, park to park
+	act.Add(adif.Record{
+		{Name: adif.Call, Value: "W1AW"},
+		{Name: adif.QSODateStart, Value: "20200704"},
+		{Name: adif.ABand, Value: "40m"},
+		{Name: adif.AMode, Value: "SSB"},
+		{Name: adif.MySIG, Value: "pota"},
+		{Name: adif.MySIGInfo, Value: "K-0001,K-0002"},
+		{Name: adif.SIG, Value: "POTA"},
+		{Name: adif.SIGInfo, Value: "K-0039"},
+	})
+	for _, park := range []string{"K-0001", "k-0002"} {
+		a = act.Activation(park, "20200704")
+		if a.QSOs() != 10 || !a.Valid() {
+			t.Errorf("expected a valid activation of %s, got %d QSOs", park, a.QSOs())
+		}
+		if a.ParkToPark != 1 {
+			t.Errorf("expected 1 park to park QSO, got %d", a.ParkToPark)
+		}
+	}
+
+	// a new UTC day is a new activation
+	act.Add(adif.Record{
+		{Name: adif.Call, Value: "W1AW"},
+		{Name: adif.QSODateStart, Value: "20200705"},
+		{Name: adif.ABand, Value: "20m"},
+		{Name: adif.AMode, Value: "SSB"},
+		{Name: adif.MyPOTARef, Value: "K-0001"},
+	})
+	if got := act.Activation("K-0001", "20200705").QSOs(); got != 1 {
+		t.Errorf("expected 1 QSO, got %d", got)
+	}
+	if got := len(act.Activations()); got != 3 {
+		t.Errorf("expected 3 activations, got %d", got)
+	}
+	if got := act.Activation("K-9999", "20200705").QSOs(); got != 0 {
+		t.Errorf("expected 0 QSOs, got %d", got)
+	}
+}
+
+func TestHunter(t *testing.T) {
+	h := pota.NewHunter()
+	h.Add(adif.Record{
+		{Name: adif.Call, Value: "W1AW"},
+		{Name: adif.SIG, Value: "POTA"},
+		{Name: adif.SIGInfo, Value: "K-0001"},
+	})
+	h.Add(adif.Record{
+		{Name: adif.Call, Value: "W1AW"},
+		{Name: adif.SIG, Value: "POTA"},
+		{Name: adif.SIGInfo, Value: "K-0001"},
+	})
+	h.Add(adif.Record{{Name: adif.Call, Value: "K1ABC"}, {Name: adif.POTARef, Value: "K-0039,K-0040"}})
+	h.Add(adif.Record{
+		{Name: adif.Call, Value: "K1ABC"},
+		{Name: adif.SIG, Value: "WWFF"},
+		{Name: adif.SIGInfo, Value: "KFF-0001"},
+	})
+	if got := h.Parks(); len(got) != 3 || got[0] != "K-0001" || got[2] != "K-0040" {
+		t.Errorf("expected [K-0001 K-0039 K-0040], got %v", got)
+	}
+	if h.QSOs("K-0001") != 2 {
+		t.Errorf("expected 2 QSOs, got %d", h.QSOs("K-0001"))
+	}
+	if h.Worked("KFF-0001") {
+		t.Errorf("expected WWFF reference to be ignored")
+	}
+}
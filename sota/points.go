@@ -0,0 +1,259 @@
+package sota
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tzneal/ham-go/adif"
+)
+
+// RequiredQSOs is the number of QSOs with different stations required for an
+// activation to earn points.
+const RequiredQSOs = 4
+
+// Season is a yearly date range (inclusive) that may span the new year.
+type Season struct {
+	StartMonth time.Month
+	StartDay   int
+	EndMonth   time.Month
+	EndDay     int
+}
+
+// Contains returns true if the date falls within the season.
+func (s Season) Contains(t time.Time) bool {
+	md := int(t.Month())*100 + t.Day()
+	start := int(s.StartMonth)*100 + s.StartDay
+	end := int(s.EndMonth)*100 + s.EndDay
+	if start <= end {
+		return md >= start && md <= end
+	}
+	return md >= start || md <= end
+}
+
+// Default seasonal bonus periods, used for associations that aren't listed in
+// Tracker.Seasons.
+var (
+	NorthernSeason = Season{time.December, 1, time.March, 15}
+	SouthernSeason = Season{time.June, 1, time.September, 15}
+)
+
+// Activation is an activation of a summit on a UTC day.
+type Activation struct {
+	Summit string
+	Date   string // UTC date in YYYYMMDD format
+	S2S    int    // number of summit to summit QSOs
+
+	calls map[string]struct{}
+	bonus bool
+}
+
+// QSOs returns the number of QSOs with different stations.
+func (a *Activation) QSOs() int {
+	return len(a.calls)
+}
+
+// Qualifies returns true if the activation has enough QSOs to earn points.
+func (a *Activation) Qualifies() bool {
+	return a.QSOs() >= RequiredQSOs
+}
+
+// Tracker computes SOTA activator and chaser points from QSOs.
+type Tracker struct {
+	// Seasons overrides the seasonal bonus period by association prefix
+	// (e.g. "W7A")
+	Seasons map[string]Season
+
+	summits     Summits
+	activations map[summitDay]*Activation
+	chases      map[summitDay]struct{}
+	s2s         int
+}
+
+type summitDay struct {
+	summit string
+	date   string
+}
+
+// NewTracker constructs a new points tracker that validates references
+// against a summits list.
+func NewTracker(summits Summits) *Tracker {
+	return &Tracker{
+		Seasons:     map[string]Season{},
+		summits:     summits,
+		activations: map[summitDay]*Activation{},
+		chases:      map[summitDay]struct{}{},
+	}
+}
+
+func (t *Tracker) inBonusSeason(s Summit, date time.Time) bool {
+	assoc := s.Reference
+	if idx := strings.Index(assoc, "/"); idx != -1 {
+		assoc = assoc[:idx]
+	}
+	season, ok := t.Seasons[assoc]
+	if !ok {
+		season = NorthernSeason
+		if s.Latitude < 0 {
+			season = SouthernSeason
+		}
+	}
+	return season.Contains(date)
+}
+
+// summit returns a summit, or an error if it wasn't valid on the date.  Without
+// a summits list references can't be validated, so they're all accepted.
+func (t *Tracker) summit(ref string, date time.Time) (Summit, error) {
+	if len(t.summits) == 0 {
+		return Summit{Reference: NormalizeReference(ref)}, nil
+	}
+	if err := t.summits.Validate(ref, date); err != nil {
+		return Summit{}, err
+	}
+	sm, _ := t.summits.Lookup(ref)
+	return sm, nil
+}
+
+// Add adds a QSO to the tracker, returning an error if either the sota_ref or
+// my_sota_ref is not a valid summit on the date of the QSO.  QSOs with invalid
+// references don't earn points.  If the tracker has no summits list, the
+// references aren't validated and activations and chases are counted, but
+// earn no points.
+func (t *Tracker) Add(rec adif.Record) error {
+	mine := rec.Get(adif.MySOTARef)
+	theirs := rec.Get(adif.SOTA_Reference)
+	if mine == "" && theirs == "" {
+		return nil
+	}
+	date, err := rec.GetTimeOn()
+	if err != nil {
+		return err
+	}
+	day := rec.Get(adif.QSODateStart)
+
+	mySummit, myErr := t.summit(mine, date)
+	myOK := mine != "" && myErr == nil
+	theirSummit, theirErr := t.summit(theirs, date)
+	theirOK := theirs != "" && theirErr == nil
+
+	if myOK {
+		key := summitDay{mySummit.Reference, day}
+		act, ok := t.activations[key]
+		if !ok {
+			act = &Activation{
+				Summit: mySummit.Reference,
+				Date:   day,
+				calls:  map[string]struct{}{},
+				bonus:  t.inBonusSeason(mySummit, date),
+			}
+			t.activations[key] = act
+		}
+		act.calls[strings.ToUpper(rec.Get(adif.Call))] = struct{}{}
+		if theirOK {
+			act.S2S++
+			t.s2s++
+		}
+	}
+
+	// summit to summit QSOs earn chaser points too
+	if theirOK {
+		t.chases[summitDay{theirSummit.Reference, day}] = struct{}{}
+	}
+	if mine != "" && myErr != nil {
+		return myErr
+	}
+	if theirs != "" {
+		return theirErr
+	}
+	return nil
+}
+
+// Activations returns the activations ordered by date and summit.
+func (t *Tracker) Activations() []*Activation {
+	ret := []*Activation{}
+	for _, a := range t.activations {
+		ret = append(ret, a)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Date != ret[j].Date {
+			return ret[i].Date < ret[j].Date
+		}
+		return ret[i].Summit < ret[j].Summit
+	})
+	return ret
+}
+
+// ActivatorPoints returns the total activator points. A summit only earns
+// points once per calendar year, with the seasonal bonus added if any
+// qualifying activation that year was within the bonus period.
+func (t *Tracker) ActivatorPoints() int {
+	type summitYear struct {
+		summit string
+		year   string
+	}
+	best := map[summitYear]int{}
+	for _, a := range t.activations {
+		if !a.Qualifies() || len(a.Date) < 4 {
+			continue
+		}
+		s := t.summits[a.Summit]
+		pts := s.Points
+		if a.bonus {
+			pts += s.BonusPoints
+		}
+		key := summitYear{a.Summit, a.Date[0:4]}
+		if pts > best[key] {
+			best[key] = pts
+		}
+	}
+	total := 0
+	for _, pts := range best {
+		total += pts
+	}
+	return total
+}
+
+// ChaserPoints returns the total chaser points. A summit earns points once per
+// UTC day.
+func (t *Tracker) ChaserPoints() int {
+	total := 0
+	for sd := range t.chases {
+		total += t.summits[sd.summit].Points
+	}
+	return total
+}
+
+// S2S returns the number of summit to summit QSOs.
+func (t *Tracker) S2S() int {
+	return t.s2s
+}
+
+// ActivatedSummits returns the sorted list of unique summits with a
+// qualifying activation.
+func (t *Tracker) ActivatedSummits() []string {
+	uniq := map[string]struct{}{}
+	for _, a := range t.activations {
+		if a.Qualifies() {
+			uniq[a.Summit] = struct{}{}
+		}
+	}
+	return sortedKeys(uniq)
+}
+
+// ChasedSummits returns the sorted list of unique summits chased.
+func (t *Tracker) ChasedSummits() []string {
+	uniq := map[string]struct{}{}
+	for sd := range t.chases {
+		uniq[sd.summit] = struct{}{}
+	}
+	return sortedKeys(uniq)
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	ret := []string{}
+	for k := range m {
+		ret = append(ret, k)
+	}
+	sort.Strings(ret)
+	return ret
+}
@@ -0,0 +1,186 @@
+package sota_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tzneal/ham-go/adif"
+	"github.com/tzneal/ham-go/sota"
+)
+
+const summitsList = `SOTA Summits List (Date=01/01/2021)
+SummitCode,AssociationName,RegionName,SummitName,AltM,AltFt,GridRef1,GridRef2,Longitude,Latitude,Points,BonusPoints,ValidFrom,ValidTo,ActivationCount,ActivationDate,ActivationCall
+W4C/CM-001,USA (W4C),Central Mountains,Mount Mitchell,2037,6683,-82.2651,35.7650,-82.2651,35.7650,10,3,01/09/2010,31/12/2099,120,20/12/2020,K4ABC
+W4C/CM-002,USA (W4C),Central Mountains,Mount Craig,2026,6647,-82.2618,35.7774,-82.2618,35.7774,10,3,01/09/2010,31/12/2099,50,20/12/2020,K4ABC
+W4C/WM-001,USA (W4C),Western Mountains,Retired Peak,1000,3281,-83.0,35.0,-83.0,35.0,4,0,01/09/2010,31/12/2015,1,20/12/2014,K4ABC
+VK3/VC-001,Australia (VK3),Central,Mount Bogong,1986,6516,147.3,-36.7,147.3,-36.7,10,3,01/01/2010,31/12/2099,0,,
+`
+
+func loadSummits(t *testing.T) sota.Summits {
+	summits, err := sota.LoadSummits(strings.NewReader(summitsList))
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+	return summits
+}
+
+func TestLoadSummits(t *testing.T) {
+	summits := loadSummits(t)
+	if len(summits) != 4 {
+		t.Errorf("expected 4 summits, got %d", len(summits))
+	}
+	s, ok := summits.Lookup("w4c/cm-001")
+	if !ok {
+		t.Fatalf("expected to find W4C/CM-001")
+	}
+	if s.Name != "Mount Mitchell" || s.Points != 10 || s.BonusPoints != 3 || s.AltM != 2037 {
+		t.Errorf("unexpected summit %+v", s)
+	}
+
+	date := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+	if err := summits.Validate("W4C/CM-001", date); err != nil {
+		t.Errorf("expected valid summit, got %s", err)
+	}
+	if err := summits.Validate("W4C/XX-999", date); !errors.Is(err, sota.ErrUnknownSummit) {
+		t.Errorf("expected unknown summit, got %v", err)
+	}
+	if err := summits.Validate("W4C/WM-001", date); err == nil {
+		t.Errorf("expected retired summit to be invalid")
+	}
+	if err := summits.Validate("W4C/WM-001", time.Date(2015, 12, 31, 12, 0, 0, 0, time.UTC)); err != nil {
+		t.Errorf("expected summit to be valid on its last day, got %s", err)
+	}
+}
+
+func TestSeason(t *testing.T) {
+	if !sota.NorthernSeason.Contains(time.Date(2020, 1, 10, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected January to be in the northern bonus season")
+	}
+	if !sota.NorthernSeason.Contains(time.Date(2020, 3, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected March 15 to be in the northern bonus season")
+	}
+	if sota.NorthernSeason.Contains(time.Date(2020, 7, 10, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected July to not be in the northern bonus season")
+	}
+	if !sota.SouthernSeason.Contains(time.Date(2020, 7, 10, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected July to be in the southern bonus season")
+	}
+}
+
+func TestPoints(t *testing.T) {
+	tr := sota.NewTracker(loadSummits(t))
+	activate := func(summit, date string, calls ...string) {
+		for _, c := range calls {
+			if err := tr.Add(adif.Record{
+				{Name: adif.Call, Value: c},
+				{Name: adif.QSODateStart, Value: date},
+				{Name: adif.TimeOn, Value: "1200"},
+				{Name: adif.MySOTARef, Value: summit},
+			}); err != nil {
+				t.Errorf("expected no error, got %s", err)
+			}
+		}
+	}
+	// winter activation with bonus
+	activate("W4C/CM-001", "20200110", "W1AW", "K1ABC", "N1XYZ", "W1AW", "K2ABC")
+	// second activation the same year earns no more points
+	activate("W4C/CM-001", "20200710", "W1AW", "K1ABC", "N1XYZ", "K2ABC")
+	// only three unique stations doesn't qualify
+	activate("W4C/CM-002", "20200710", "W1AW", "K1ABC", "N1XYZ", "N1XYZ")
+
+	if got := tr.ActivatorPoints(); got != 13 {
+		t.Errorf("expected 13 activator points, got %d", got)
+	}
+
+	// summit to summit
+	if err := tr.Add(adif.Record{
+		{Name: adif.Call, Value: "VK3ABC"},
+		{Name: adif.QSODateStart, Value: "20200710"},
+		{Name: adif.TimeOn, Value: "1300"},
+		{Name: adif.MySOTARef, Value: "W4C/CM-002"},
+		{Name: adif.SOTA_Reference, Value: "VK3/VC-001"},
+	}); err != nil {
+		t.Errorf("expected no error, got %s", err)
+	}
+	if got := tr.ActivatorPoints(); got != 23 {
+		t.Errorf("expected 23 activator points, got %d", got)
+	}
+	if tr.S2S() != 1 {
+		t.Errorf("expected 1 S2S QSO, got %d", tr.S2S())
+	}
+
+	// chasing the same summit twice in a day counts once
+	tr.Add(adif.Record{
+		{Name: adif.Call, Value: "K4ABC"},
+		{Name: adif.QSODateStart, Value: "20200711"},
+		{Name: adif.TimeOn, Value: "1300"},
+		{Name: adif.SOTA_Reference, Value: "W4C/CM-001"},
+	})
+	tr.Add(adif.Record{
+		{Name: adif.Call, Value: "K4XYZ"},
+		{Name: adif.QSODateStart, Value: "20200711"},
+		{Name: adif.TimeOn, Value: "1400"},
+		{Name: adif.SOTA_Reference, Value: "W4C/CM-001"},
+	})
+	tr.Add(adif.Record{
+		{Name: adif.Call, Value: "K4XYZ"},
+		{Name: adif.QSODateStart, Value: "20200712"},
+		{Name: adif.TimeOn, Value: "1400"},
+		{Name: adif.SOTA_Reference, Value: "W4C/CM-001"},
+	})
+	if got := tr.ChaserPoints(); got != 30 {
+		t.Errorf("expected 30 chaser points, got %d", got)
+	}
+
+	if err := tr.Add(adif.Record{
+		{Name: adif.Call, Value: "K4XYZ"},
+		{Name: adif.QSODateStart, Value: "20200712"},
+		{Name: adif.TimeOn, Value: "1400"},
+		{Name: adif.SOTA_Reference, Value: "W4C/WM-001"},
+	}); err == nil {
+		t.Errorf("expected an error for a retired summit")
+	}
+	if got := tr.ChaserPoints(); got != 30 {
+		t.Errorf("expected invalid summit to earn no points, got %d", got)
+	}
+
+	if got := tr.ActivatedSummits(); len(got) != 2 {
+		t.Errorf("expected 2 activated summits, got %v", got)
+	}
+	if got := tr.ChasedSummits(); len(got) != 2 || got[0] != "VK3/VC-001" || got[1] != "W4C/CM-001" {
+		t.Errorf("expected [VK3/VC-001 W4C/CM-001], got %v", got)
+	}
+}
+
+func TestPointsWithoutSummits(t *testing.T) {
+	tr := sota.NewTracker(sota.Summits{})
+	for _, c := range []string{"W1AW", "K1ABC", "N1XYZ", "K2ABC"} {
+		if err := tr.Add(adif.Record{
+			{Name: adif.Call, Value: c},
+			{Name: adif.QSODateStart, Value: "20200710"},
+			{Name: adif.TimeOn, Value: "1200"},
+			{Name: adif.MySOTARef, Value: "w4c/cm-001"},
+		}); err != nil {
+			t.Errorf("expected no error without a summits list, got %s", err)
+		}
+	}
+	if err := tr.Add(adif.Record{
+		{Name: adif.Call, Value: "K4XYZ"},
+		{Name: adif.QSODateStart, Value: "20200711"},
+		{Name: adif.TimeOn, Value: "1400"},
+		{Name: adif.SOTA_Reference, Value: "W4C/CM-002"},
+	}); err != nil {
+		t.Errorf("expected no error without a summits list, got %s", err)
+	}
+	if got := tr.ActivatedSummits(); len(got) != 1 || got[0] != "W4C/CM-001" {
+		t.Errorf("expected [W4C/CM-001], got %v", got)
+	}
+	if got := tr.ChasedSummits(); len(got) != 1 || got[0] != "W4C/CM-002" {
+		t.Errorf("expected [W4C/CM-002], got %v", got)
+	}
+	if tr.ActivatorPoints() != 0 || tr.ChaserPoints() != 0 {
+		t.Errorf("expected no points without a summits list")
+	}
+}
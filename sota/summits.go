@@ -0,0 +1,162 @@
+// Package sota computes Summits on the Air activator and chaser points.
+package sota
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Summit is a single summit from the SOTA summits list.
+type Summit struct {
+	Reference   string
+	Association string
+	Region      string
+	Name        string
+	AltM        int
+	AltFt       int
+	Latitude    float64
+	Longitude   float64
+	Points      int
+	BonusPoints int
+	ValidFrom   time.Time
+	ValidTo     time.Time
+}
+
+// ValidOn returns true if the summit was valid on a particular date.
+func (s Summit) ValidOn(t time.Time) bool {
+	if !s.ValidFrom.IsZero() && t.Before(s.ValidFrom) {
+		return false
+	}
+	// valid through the end of the last day
+	if !s.ValidTo.IsZero() && !t.Before(s.ValidTo.Add(24*time.Hour)) {
+		return false
+	}
+	return true
+}
+
+// Summits is a set of summits keyed by reference.
+type Summits map[string]Summit
+
+// ErrUnknownSummit is returned when a reference isn't in the summits list.
+var ErrUnknownSummit = errors.New("unknown summit")
+
+// LoadSummitsFile loads the official summitslist.csv.
+func LoadSummitsFile(filename string) (Summits, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return LoadSummits(f)
+}
+
+// LoadSummits loads a summits list in the official summitslist.csv format.
+// The title line that precedes the header in the official file is skipped if
+// present.
+func LoadSummits(r io.Reader) (Summits, error) {
+	br := bufio.NewReader(r)
+	peek, err := br.Peek(len("SummitCode"))
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(string(peek), "SummitCode") {
+		// skip the "SOTA Summits List (Date=...)" line
+		if _, err := br.ReadString('\n'); err != nil {
+			return nil, err
+		}
+	}
+
+	rdr := csv.NewReader(br)
+	rdr.FieldsPerRecord = -1
+	header, err := rdr.Read()
+	if err != nil {
+		return nil, err
+	}
+	cols := map[string]int{}
+	for i, h := range header {
+		cols[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	if _, ok := cols["summitcode"]; !ok {
+		return nil, errors.New("summits list is missing the SummitCode column")
+	}
+	get := func(rec []string, col string) string {
+		if i, ok := cols[col]; ok && i < len(rec) {
+			return strings.TrimSpace(rec[i])
+		}
+		return ""
+	}
+	getInt := func(rec []string, col string) int {
+		v, _ := strconv.Atoi(get(rec, col))
+		return v
+	}
+	getFloat := func(rec []string, col string) float64 {
+		v, _ := strconv.ParseFloat(get(rec, col), 64)
+		return v
+	}
+	getDate := func(rec []string, col string) time.Time {
+		t, _ := time.Parse("02/01/2006", get(rec, col))
+		return t
+	}
+
+	summits := Summits{}
+	for {
+		rec, err := rdr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		s := Summit{
+			Reference:   NormalizeReference(get(rec, "summitcode")),
+			Association: get(rec, "associationname"),
+			Region:      get(rec, "regionname"),
+			Name:        get(rec, "summitname"),
+			AltM:        getInt(rec, "altm"),
+			AltFt:       getInt(rec, "altft"),
+			Latitude:    getFloat(rec, "latitude"),
+			Longitude:   getFloat(rec, "longitude"),
+			Points:      getInt(rec, "points"),
+			BonusPoints: getInt(rec, "bonuspoints"),
+			ValidFrom:   getDate(rec, "validfrom"),
+			ValidTo:     getDate(rec, "validto"),
+		}
+		if s.Reference == "" {
+			continue
+		}
+		summits[s.Reference] = s
+	}
+	return summits, nil
+}
+
+// NormalizeReference returns the canonical upper case form of a summit
+// reference.
+func NormalizeReference(ref string) string {
+	return strings.ToUpper(strings.TrimSpace(ref))
+}
+
+// Lookup returns the summit for a reference.
+func (s Summits) Lookup(ref string) (Summit, bool) {
+	sm, ok := s[NormalizeReference(ref)]
+	return sm, ok
+}
+
+// Validate returns an error if the reference isn't a summit that was valid on
+// the given date.
+func (s Summits) Validate(ref string, t time.Time) error {
+	sm, ok := s.Lookup(ref)
+	if !ok {
+		return fmt.Errorf("%w %s", ErrUnknownSummit, NormalizeReference(ref))
+	}
+	if !sm.ValidOn(t) {
+		return fmt.Errorf("summit %s was not valid on %s", sm.Reference, t.Format("2006-01-02"))
+	}
+	return nil
+}
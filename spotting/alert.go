@@ -0,0 +1,208 @@
+package spotting
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultAlertCooldown is how long before a rule alerts for the same station
+// again if the rule doesn't set a cooldown.
+const DefaultAlertCooldown = 10 * time.Minute
+
+// AlertConfig configures the alerts raised for wanted spots.
+type AlertConfig struct {
+	Enabled bool
+	Rule    []AlertRule
+}
+
+// AlertRule raises an alert for spots that match all of its non-empty
+// criteria.  A criterion that is a list matches if any item in the list
+// matches.
+type AlertRule struct {
+	Name       string
+	Disabled   bool
+	Calls      []string // watched calls, which also match portable calls, e.g. W1AW/P
+	References []string // watched POTA/SOTA/WWFF references, a trailing * matches a prefix, e.g. W1/HA-*
+	Needed     string   // entity, band, mode or call
+	Sources    []string
+	Bands      []string
+	Modes      []string
+	// Command is run when the alert is raised, with the spot details in the
+	// SPOT_CALL, SPOT_FREQUENCY, SPOT_MODE, SPOT_BAND, SPOT_SOURCE,
+	// SPOT_SPOTTER, SPOT_COMMENT, SPOT_REFERENCE and ALERT environment
+	// variables.
+	Command         string
+	CooldownMinutes int // how long before the rule alerts for a station again
+}
+
+// Alert is raised when a spot matches an alert rule.
+type Alert struct {
+	Rule AlertRule
+	Spot Spot
+}
+
+// Message describes the alert.
+func (a Alert) Message() string {
+	s := a.Spot
+	msg := fmt.Sprintf("%s: %s on %.1f", a.Rule.Name, s.DXStation, s.Frequency)
+	if mode := s.InferredMode(); mode != "" {
+		msg += " " + mode
+	}
+	if s.Reference != "" {
+		msg += " at " + s.Reference
+	}
+	if s.Source != "" {
+		msg += " (" + s.Source + ")"
+	}
+	return msg
+}
+
+// Env returns the environment variables describing the alert for its command.
+func (a Alert) Env() []string {
+	s := a.Spot
+	return []string{
+		"ALERT=" + a.Rule.Name,
+		"SPOT_CALL=" + s.DXStation,
+		fmt.Sprintf("SPOT_FREQUENCY=%.1f", s.Frequency),
+		"SPOT_MODE=" + s.InferredMode(),
+		"SPOT_BAND=" + s.Band(),
+		"SPOT_SOURCE=" + s.Source,
+		"SPOT_SPOTTER=" + s.Spotter,
+		"SPOT_COMMENT=" + s.Comment,
+		"SPOT_REFERENCE=" + s.Reference,
+	}
+}
+
+// Alerter checks spots against alert rules.  It's safe for concurrent use.
+type Alerter struct {
+	mu      sync.Mutex
+	enabled bool
+	rules   []AlertRule
+	needed  NeededFunc
+	last    map[alertKey]time.Time
+}
+
+type alertKey struct {
+	rule int
+	call string
+}
+
+// NewAlerter constructs a new alerter, returning an error if a rule is
+// invalid.
+func NewAlerter(cfg AlertConfig) (*Alerter, error) {
+	a := &Alerter{
+		enabled: cfg.Enabled,
+		last:    map[alertKey]time.Time{},
+	}
+	for _, r := range cfg.Rule {
+		r.Needed = strings.ToLower(r.Needed)
+		switch r.Needed {
+		case "", NeededEntity, NeededBand, NeededMode, NeededCall:
+		default:
+			return nil, fmt.Errorf("alert %q has unknown needed status %q", r.Name, r.Needed)
+		}
+		if len(r.Calls) == 0 && len(r.References) == 0 && r.Needed == "" {
+			return nil, fmt.Errorf("alert %q requires calls, references or a needed status", r.Name)
+		}
+		if r.Name == "" {
+			r.Name = fmt.Sprintf("Alert %d", len(a.rules)+1)
+		}
+		a.rules = append(a.rules, r)
+	}
+	return a, nil
+}
+
+// SetNeeded sets the function used to determine if a station is needed.
+// Without it, rules with a needed status never match.
+func (a *Alerter) SetNeeded(fn NeededFunc) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.needed = fn
+}
+
+// Check returns the alerts raised by a spot received at time now.  A rule
+// doesn't alert for the same station again until its cooldown has passed.
+func (a *Alerter) Check(spot Spot, now time.Time) []Alert {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if !a.enabled {
+		return nil
+	}
+	var ret []Alert
+	for i, r := range a.rules {
+		if r.Disabled || !a.matches(r, spot) {
+			continue
+		}
+		cooldown := DefaultAlertCooldown
+		if r.CooldownMinutes > 0 {
+			cooldown = time.Duration(r.CooldownMinutes) * time.Minute
+		}
+		key := alertKey{i, strings.ToUpper(spot.DXStation)}
+		if last, ok := a.last[key]; ok && now.Sub(last) < cooldown {
+			continue
+		}
+		a.last[key] = now
+		ret = append(ret, Alert{Rule: r, Spot: spot})
+	}
+	return ret
+}
+
+func (a *Alerter) matches(r AlertRule, spot Spot) bool {
+	if len(r.Sources) > 0 && !containsFold(r.Sources, spot.Source) {
+		return false
+	}
+	if len(r.Bands) > 0 && !containsFold(r.Bands, spot.Band()) {
+		return false
+	}
+	if len(r.Modes) > 0 && !containsMode(r.Modes, spot.InferredMode()) {
+		return false
+	}
+	if len(r.Calls) > 0 && !watchedCall(r.Calls, spot.DXStation) {
+		return false
+	}
+	if len(r.References) > 0 && !watchedReference(r.References, spot) {
+		return false
+	}
+	if r.Needed != "" && (a.needed == nil || !a.needed(spot, r.Needed)) {
+		return false
+	}
+	return true
+}
+
+// watchedCall returns true if the call, or any part of a portable call, is in
+// the list.
+func watchedCall(calls []string, call string) bool {
+	for _, part := range strings.Split(call, "/") {
+		if part != "" && containsFold(calls, part) {
+			return true
+		}
+	}
+	return containsFold(calls, call)
+}
+
+// watchedReference returns true if the spot is at one of the references,
+// which are also looked for in the comment of spots from sources that don't
+// provide one (e.g. DX clusters).
+func watchedReference(refs []string, spot Spot) bool {
+	words := strings.Fields(strings.ToUpper(spot.Comment))
+	if spot.Reference != "" {
+		words = []string{strings.ToUpper(spot.Reference)}
+	}
+	for _, ref := range refs {
+		ref = strings.ToUpper(strings.TrimSpace(ref))
+		prefix := strings.HasSuffix(ref, "*")
+		ref = strings.TrimSuffix(ref, "*")
+		if ref == "" {
+			continue
+		}
+		for _, w := range words {
+			w = strings.Trim(w, ",.;:()[]")
+			if w == ref || (prefix && strings.HasPrefix(w, ref)) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,77 @@
+package spotting_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tzneal/ham-go/spotting"
+)
+
+func TestAlerter(t *testing.T) {
+	a, err := spotting.NewAlerter(spotting.AlertConfig{Enabled: true, Rule: []spotting.AlertRule{
+		{Name: "Friends", Calls: []string{"W1AW", "k1abc"}},
+		{Name: "Parks", References: []string{"K-0001", "W1/HA-*"}, CooldownMinutes: 1},
+		{Name: "New ones", Needed: "entity", Bands: []string{"20m"}},
+	}})
+	if err != nil {
+		t.Fatalf("error creating alerter: %s", err)
+	}
+	a.SetNeeded(func(spot spotting.Spot, kind string) bool {
+		return kind == spotting.NeededEntity && strings.HasPrefix(spot.DXStation, "3Y")
+	})
+
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	td := []struct {
+		spot spotting.Spot
+		at   time.Duration
+		exp  []string
+	}{
+		{spotting.Spot{DXStation: "W1AW/P", Frequency: 14025}, 0, []string{"Friends"}},
+		{spotting.Spot{DXStation: "W1AW/P", Frequency: 14025}, 5 * time.Minute, nil},
+		{spotting.Spot{DXStation: "K1ABC", Frequency: 7030, Reference: "K-0001"}, 0, []string{"Friends", "Parks"}},
+		{spotting.Spot{DXStation: "K1ABC", Frequency: 7030, Reference: "K-0001"}, 2 * time.Minute, []string{"Parks"}},
+		{spotting.Spot{DXStation: "N1XYZ", Frequency: 14062, Reference: "W1/HA-001"}, 0, []string{"Parks"}},
+		{spotting.Spot{DXStation: "N2XYZ", Frequency: 14244, Comment: "POTA K-0002"}, 0, nil},
+		{spotting.Spot{DXStation: "N3XYZ", Frequency: 14244, Comment: "POTA K-0001 loud"}, 0, []string{"Parks"}},
+		{spotting.Spot{DXStation: "3Y0J", Frequency: 14025}, 0, []string{"New ones"}},
+		{spotting.Spot{DXStation: "3Y0J", Frequency: 7025}, 30 * time.Minute, nil},
+		{spotting.Spot{DXStation: "W1AW", Frequency: 14025}, 10 * time.Minute, []string{"Friends"}},
+	}
+	for i, tc := range td {
+		var got []string
+		for _, alert := range a.Check(tc.spot, now.Add(tc.at)) {
+			got = append(got, alert.Rule.Name)
+		}
+		if strings.Join(got, ",") != strings.Join(tc.exp, ",") {
+			t.Errorf("%d %s: expected alerts %v, got %v", i, tc.spot.DXStation, tc.exp, got)
+		}
+	}
+}
+
+func TestAlert(t *testing.T) {
+	alert := spotting.Alert{
+		Rule: spotting.AlertRule{Name: "Parks"},
+		Spot: spotting.Spot{Source: "POTA", DXStation: "K1ABC", Frequency: 14062, Reference: "K-0001", Comment: "CW"},
+	}
+	if got := alert.Message(); got != "Parks: K1ABC on 14062.0 CW at K-0001 (POTA)" {
+		t.Errorf("unexpected message %q", got)
+	}
+	env := strings.Join(alert.Env(), " ")
+	for _, exp := range []string{"ALERT=Parks", "SPOT_CALL=K1ABC", "SPOT_FREQUENCY=14062.0", "SPOT_BAND=20m", "SPOT_REFERENCE=K-0001"} {
+		if !strings.Contains(env, exp) {
+			t.Errorf("expected %s in %s", exp, env)
+		}
+	}
+}
+
+func TestAlerterInvalid(t *testing.T) {
+	for _, r := range []spotting.AlertRule{
+		{Name: "nothing"},
+		{Name: "bad needed", Needed: "zone"},
+	} {
+		if _, err := spotting.NewAlerter(spotting.AlertConfig{Rule: []spotting.AlertRule{r}}); err == nil {
+			t.Errorf("expected an error for %+v", r)
+		}
+	}
+}
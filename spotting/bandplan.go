@@ -0,0 +1,70 @@
+package spotting
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Segment is a part of a band used for a class of modes.
+type Segment struct {
+	Start float64 // in kHz
+	End   float64 // in kHz
+	Mode  string  // CW, DATA or SSB
+}
+
+// BandPlan is a simplified HF and 6m band plan, used to infer the mode of a
+// spot from its frequency.
+var BandPlan = []Segment{
+	{1800, 1840, "CW"}, {1840, 1850, "DATA"}, {1850, 2000, "SSB"},
+	{3500, 3570, "CW"}, {3570, 3600, "DATA"}, {3600, 4000, "SSB"},
+	{7000, 7040, "CW"}, {7040, 7125, "DATA"}, {7125, 7300, "SSB"},
+	{10100, 10130, "CW"}, {10130, 10150, "DATA"},
+	{14000, 14070, "CW"}, {14070, 14150, "DATA"}, {14150, 14350, "SSB"},
+	{18068, 18095, "CW"}, {18095, 18110, "DATA"}, {18110, 18168, "SSB"},
+	{21000, 21070, "CW"}, {21070, 21200, "DATA"}, {21200, 21450, "SSB"},
+	{24890, 24915, "CW"}, {24915, 24930, "DATA"}, {24930, 24990, "SSB"},
+	{28000, 28070, "CW"}, {28070, 28300, "DATA"}, {28300, 29700, "SSB"},
+	{50000, 50100, "CW"}, {50100, 50300, "SSB"}, {50300, 50350, "DATA"},
+}
+
+// digitalFrequencies are the dial frequencies in kHz of the common weak signal
+// modes, whose signals are within 3kHz above them.
+var digitalFrequencies = map[string][]float64{
+	"FT8": {1840, 3573, 5357, 7074, 10136, 14074, 18100, 21074, 24915, 28074, 50313},
+	"FT4": {3575, 7047.5, 10140, 14080, 18104, 21140, 24919, 28180, 50318},
+}
+
+// commentModes maps words in spot comments to modes.
+var commentModes = map[string]string{
+	"CW": "CW", "SSB": "SSB", "USB": "SSB", "LSB": "SSB", "AM": "AM", "FM": "FM",
+	"FT8": "FT8", "FT4": "FT4", "RTTY": "RTTY", "PSK": "PSK", "PSK31": "PSK",
+	"PSK63": "PSK", "JS8": "JS8", "JT65": "JT65", "JT9": "JT9", "SSTV": "SSTV",
+	"OLIVIA": "OLIVIA", "MSK144": "MSK144", "Q65": "Q65",
+}
+
+// InferMode returns the mode of a spot on a frequency in kHz, from the comment
+// if it names one, the weak signal mode frequencies or otherwise the band plan.
+// It returns the empty string if the mode can't be determined.
+func InferMode(frequency float64, comment string) string {
+	words := strings.FieldsFunc(strings.ToUpper(comment), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, w := range words {
+		if mode, ok := commentModes[w]; ok {
+			return mode
+		}
+	}
+	for _, mode := range []string{"FT8", "FT4"} {
+		for _, f := range digitalFrequencies[mode] {
+			if frequency >= f && frequency <= f+3 {
+				return mode
+			}
+		}
+	}
+	for _, s := range BandPlan {
+		if frequency >= s.Start && frequency < s.End {
+			return s.Mode
+		}
+	}
+	return ""
+}
@@ -0,0 +1,19 @@
+package spotting
+
+import (
+	"time"
+
+	"github.com/tzneal/ham-go/dxcc"
+	"github.com/tzneal/ham-go/geo"
+)
+
+// Daylight returns whether it's day, night or greyline at time t where a
+// spotted station is, based on its DXCC entity.  It returns false if the call
+// isn't in an entity.
+func Daylight(call string, t time.Time) (geo.Daylight, bool) {
+	ent, ok := dxcc.LookupAt(call, t)
+	if !ok {
+		return geo.Night, false
+	}
+	return geo.NewPoint(ent.Latitude, ent.Longitude).Daylight(t), true
+}
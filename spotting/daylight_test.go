@@ -0,0 +1,36 @@
+package spotting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tzneal/ham-go/geo"
+	"github.com/tzneal/ham-go/spotting"
+)
+
+func TestDaylight(t *testing.T) {
+	td := []struct {
+		call string
+		time string
+		exp  geo.Daylight
+	}{
+		{"G4ABC", "2020-06-21 12:00", geo.Day},
+		{"JA1XYZ", "2020-06-21 12:00", geo.Night},
+		{"VE3ABC", "2020-06-21 09:00", geo.Greyline},
+		{"VE3ABC", "2020-06-21 00:50", geo.Greyline},
+	}
+	for _, tc := range td {
+		tm, _ := time.Parse("2006-01-02 15:04", tc.time)
+		got, ok := spotting.Daylight(tc.call, tm)
+		if !ok {
+			t.Errorf("%s: expected an entity", tc.call)
+			continue
+		}
+		if got != tc.exp {
+			t.Errorf("%s at %s: expected %s, got %s", tc.call, tc.time, tc.exp, got)
+		}
+	}
+	if _, ok := spotting.Daylight("W1AW/MM", time.Now()); ok {
+		t.Errorf("expected no entity for a maritime mobile station")
+	}
+}
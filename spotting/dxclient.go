@@ -1,23 +1,28 @@
 package spotting
 
 import (
-	"net"
+	"errors"
+	"fmt"
+	"log"
 	"strings"
+	"sync"
 	"time"
 
-	maidenhead "github.com/pd0mz/go-maidenhead"
 	"github.com/tzneal/ham-go/dxcc"
+	"github.com/tzneal/ham-go/geo"
 )
 
 // DXClusterClient is a DX Cluster client
 type DXClusterClient struct {
 	Spots chan DXClusterSpot
+	// Lines receives everything the cluster sends, including the responses to
+	// commands.  Lines are dropped if they aren't read.
+	Lines chan string
 
 	config   DXClusterConfig
 	shutdown chan struct{}
-	conn     net.Conn
-	curPos   int
-	buf      []byte
+	mu       sync.Mutex // guards conn, which is only replaced by run
+	conn     *telnetConn
 }
 
 // DXClusterConfig is the DX Cluster client config
@@ -26,6 +31,7 @@ type DXClusterConfig struct {
 	Address    string
 	Callsign   string
 	ZoneLookup bool
+	Commands   []string // sent after logging in, e.g. set/filter commands
 }
 
 // NewDXClusterClient constructs a new DX Cluster client
@@ -33,69 +39,52 @@ func NewDXClusterClient(cfg DXClusterConfig) *DXClusterClient {
 	client := &DXClusterClient{
 		config:   cfg,
 		Spots:    make(chan DXClusterSpot),
+		Lines:    make(chan string, 100),
 		shutdown: make(chan struct{}),
 	}
 	return client
 }
 
-func (c *DXClusterClient) isLoginPrompt(line string) bool {
-	for _, p := range []string{"enter your call", "login:"} {
-		if strings.Contains(line, p) {
-			return true
-		}
-	}
-	return false
-}
+// ErrNotConnected is returned when sending to a cluster that isn't connected.
+var ErrNotConnected = errors.New("not connected to the DX cluster")
 
-func (c *DXClusterClient) login(call string) {
-	try := 0
-	for {
-		try++
-		line, _ := c.readLine()
-		if c.isLoginPrompt(line) || try > 20 {
-			c.conn.Write([]byte(call + "\n"))
-			return
-		}
+// Send sends a command to the cluster, e.g. sh/dx or set/filter.
+func (c *DXClusterClient) Send(cmd string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.conn == nil {
+		return ErrNotConnected
 	}
+	_, err := c.conn.Write([]byte(strings.TrimSpace(cmd) + "\n"))
+	return err
 }
 
-func (c *DXClusterClient) readLine() (string, error) {
-	// try to return a line we've already got
-	for i := c.curPos; i < len(c.buf); i++ {
-		if c.buf[i] == '\n' {
-			ret := string(c.buf[c.curPos:i])
-			c.curPos = i + 1
-			return ret, nil
-		}
-	}
-
-	// need to read new
-	tmp := make([]byte, 8192)
-	remaining := len(c.buf) - c.curPos
-	for i := c.curPos; i < len(c.buf); i++ {
-		tmp[i-c.curPos] = c.buf[i]
+// SendSpot posts a spot of a station on a frequency in kHz to the cluster.
+func (c *DXClusterClient) SendSpot(frequency float64, call string, comment string) error {
+	if call == "" || frequency <= 0 {
+		return errors.New("a spot requires a call and frequency")
 	}
+	return c.Send(strings.TrimSpace(fmt.Sprintf("DX %.1f %s %s", frequency, call, comment)))
+}
 
-	// time out so we don't hang indefinitely
-	c.conn.SetReadDeadline(time.Now().Add(100 * time.Millisecond))
-	n, err := c.conn.Read(tmp[remaining:])
-	if err != nil {
-		// detect timeout and don't report it as an error
-		if err, ok := err.(net.Error); ok && err.Timeout() {
-			return "", nil
-		}
-		return "", err
+func (c *DXClusterClient) setConn(conn *telnetConn) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.conn != nil {
+		c.conn.Close()
 	}
-
-	c.buf = tmp[0 : n+remaining]
-	c.curPos = 0
-	return c.readLine()
+	c.conn = conn
 }
 
 // Close gracefully shuts down the client
 func (c *DXClusterClient) Close() error {
 	close(c.shutdown)
-	return c.conn.Close()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.conn != nil {
+		return c.conn.Close()
+	}
+	return nil
 }
 
 // Run is a non-blocking call that starts the client
@@ -107,45 +96,142 @@ func (c *DXClusterClient) run() {
 	for {
 		select {
 		case <-c.shutdown:
-			if c.conn != nil {
-				c.conn.Close()
-				c.conn = nil
-			}
+			c.setConn(nil)
 			return
 		default:
 			// not conected yet, or we are reconnecting
 			if c.conn == nil {
-				conn, err := net.Dial(c.config.Network, c.config.Address)
+				conn, err := dialTelnet(c.config.Network, c.config.Address)
 				if err != nil {
 					// connect failed, so sleep a while and try again
 					time.Sleep(30 * time.Second)
 					continue
 				}
-				c.conn = conn
-				c.login(c.config.Callsign)
+				conn.login(c.config.Callsign)
+				c.setConn(conn)
+				for _, cmd := range c.config.Commands {
+					c.Send(cmd)
+				}
 			}
 
-			line, err := c.readLine()
+			line, err := c.conn.readLine()
 			if err != nil {
 				// error on read, so just try to reconnect
-				c.conn.Close()
-				c.conn = nil
+				c.setConn(nil)
 				continue
 			}
+			if line = strings.TrimRight(line, "\r\a"); line != "" {
+				select {
+				case c.Lines <- line:
+				default:
+				}
+			}
 			spot, err := DXClusterParse(line)
 			if spot != nil && err == nil {
 				if c.config.ZoneLookup {
 					ent, ok := dxcc.Lookup(spot.Spotter)
 					if ok {
-						pt := maidenhead.NewPoint(ent.Latitude, ent.Longitude)
-						gs, err := pt.GridSquare()
+						gs, err := geo.NewPoint(ent.Latitude, ent.Longitude).Grid(4)
 						if err == nil {
-							spot.Location = gs[0:4] + " " + ent.Entity
+							spot.Location = gs + " " + ent.Entity
 						}
 					}
 				}
-				c.Spots <- *spot
+				select {
+				case c.Spots <- *spot:
+				case <-c.shutdown:
+				}
 			}
 		}
 	}
 }
+
+func init() {
+	RegisterSource("dxcluster", NewDXClusterSource)
+}
+
+// DXClusterSource is a DX cluster spot source.  Client can be used to send
+// commands and spots to the cluster.
+type DXClusterSource struct {
+	Client *DXClusterClient
+
+	name   string
+	onLine func(line string)
+	done   chan struct{}
+}
+
+// NewDXClusterSource constructs a DX cluster spot source.  The ZoneLookup
+// option adds the spotter's grid and entity as the spot location.
+func NewDXClusterSource(cfg SourceConfig) (Source, error) {
+	if cfg.Address == "" {
+		return nil, errors.New("a DX cluster source requires an address")
+	}
+	zoneLookup, err := cfg.boolOption("ZoneLookup", true)
+	if err != nil {
+		return nil, err
+	}
+	return &DXClusterSource{
+		Client: NewDXClusterClient(DXClusterConfig{
+			Network:    "tcp",
+			Address:    cfg.Address,
+			Callsign:   cfg.Callsign,
+			ZoneLookup: zoneLookup,
+			Commands:   cfg.Commands,
+		}),
+		name: cfg.name("DX"),
+		done: make(chan struct{}),
+	}, nil
+}
+
+// Name returns the name spots are shown with.
+func (s *DXClusterSource) Name() string {
+	return s.name
+}
+
+// OnLine sets a function that's called with every line the cluster sends, it
+// must be called before Run.
+func (s *DXClusterSource) OnLine(fn func(line string)) {
+	s.onLine = fn
+}
+
+// Run is a non-blocking call that starts the source.
+func (s *DXClusterSource) Run(spots chan<- Spot) {
+	s.Client.Run()
+	go func() {
+		for {
+			select {
+			case <-s.done:
+				return
+			case line := <-s.Client.Lines:
+				if s.onLine != nil {
+					s.onLine(line)
+				}
+			case spot := <-s.Client.Spots:
+				tm, err := spot.UTCTime(time.Now())
+				if err != nil {
+					log.Printf("error parsing DX time: %s", err)
+					continue
+				}
+				select {
+				case spots <- Spot{
+					Source:    s.name,
+					Frequency: spot.Frequency,
+					DXStation: spot.DXStation,
+					Spotter:   spot.Spotter,
+					Comment:   spot.Comment,
+					Time:      tm,
+					Location:  spot.Location,
+				}:
+				case <-s.done:
+					return
+				}
+			}
+		}
+	}()
+}
+
+// Close gracefully shuts down the source.
+func (s *DXClusterSource) Close() error {
+	close(s.done)
+	return s.Client.Close()
+}
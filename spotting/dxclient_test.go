@@ -1,5 +1,16 @@
 package spotting_test
 
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tzneal/ham-go/spotting"
+)
+
 /*
 func TestClient(t *testing.T) {
 	client, err := spotting.Dial("tcp", "dxspots.com:23")
@@ -12,3 +23,105 @@ func TestClient(t *testing.T) {
 	time.Sleep(10 * time.Second)
 }
 */
+
+// fakeCluster is a local DX cluster that records the commands sent to it
+// after the client logs in.
+type fakeCluster struct {
+	ln       net.Listener
+	received chan string
+	lines    chan string
+}
+
+func newFakeCluster(t *testing.T) *fakeCluster {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("error listening: %s", err)
+	}
+	f := &fakeCluster{
+		ln:       ln,
+		received: make(chan string, 100),
+		lines:    make(chan string, 100),
+	}
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		fmt.Fprint(conn, "Please enter your call: ")
+		go func() {
+			rdr := bufio.NewReader(conn)
+			for {
+				line, err := rdr.ReadString('\n')
+				if err != nil {
+					return
+				}
+				f.received <- strings.TrimSpace(line)
+			}
+		}()
+		for line := range f.lines {
+			fmt.Fprint(conn, line+"\r\n")
+		}
+	}()
+	return f
+}
+
+func (f *fakeCluster) Close() {
+	close(f.lines)
+	f.ln.Close()
+}
+
+func TestDXClusterClientCommands(t *testing.T) {
+	srv := newFakeCluster(t)
+	defer srv.Close()
+
+	client := spotting.NewDXClusterClient(spotting.DXClusterConfig{
+		Network:  "tcp",
+		Address:  srv.ln.Addr().String(),
+		Callsign: "KN4LHY",
+		Commands: []string{"set/filter dxbm/pass 20", "sh/wwv"},
+	})
+	if err := client.Send("sh/dx"); err != spotting.ErrNotConnected {
+		t.Errorf("expected an error sending before connecting, got %v", err)
+	}
+	client.Run()
+	defer client.Close()
+
+	expect := func(exp string) {
+		t.Helper()
+		select {
+		case got := <-srv.received:
+			if got != exp {
+				t.Errorf("expected %q, got %q", exp, got)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for %q", exp)
+		}
+	}
+	expect("KN4LHY")
+	expect("set/filter dxbm/pass 20")
+	expect("sh/wwv")
+
+	if err := client.Send("sh/dx 5"); err != nil {
+		t.Errorf("error sending: %s", err)
+	}
+	expect("sh/dx 5")
+	if err := client.SendSpot(14025.04, "OH2BH", "tnx QSO"); err != nil {
+		t.Errorf("error spotting: %s", err)
+	}
+	expect("DX 14025.0 OH2BH tnx QSO")
+	if err := client.SendSpot(0, "OH2BH", ""); err == nil {
+		t.Errorf("expected an error for a spot without a frequency")
+	}
+
+	// responses are passed on for display
+	srv.lines <- "Date        Hour   SFI   A   K Exp.K   R SA    GMF   Aurora"
+	select {
+	case line := <-client.Lines:
+		if line != "Date        Hour   SFI   A   K Exp.K   R SA    GMF   Aurora" {
+			t.Errorf("unexpected line %q", line)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for a response")
+	}
+}
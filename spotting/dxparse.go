@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 	"unicode"
 )
 
@@ -18,6 +19,13 @@ type DXClusterSpot struct {
 	Location  string
 }
 
+// UTCTime returns the time of the spot, which only has the hour and minute,
+// on the UTC date of now.  A spot from just before midnight that arrives after
+// it is given the previous day.
+func (s DXClusterSpot) UTCTime(now time.Time) (time.Time, error) {
+	return spotTime(s.Time, now)
+}
+
 func trim(s string) string {
 	return strings.TrimFunc(s, func(r rune) bool {
 		// trim non-printable and space chars
@@ -0,0 +1,280 @@
+package spotting
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tzneal/ham-go/adif"
+	"github.com/tzneal/ham-go/geo"
+)
+
+// Filter rule actions
+const (
+	FilterInclude = "include"
+	FilterExclude = "exclude"
+)
+
+// Kinds of needed status a filter rule can match on
+const (
+	NeededEntity = "entity" // the DX entity hasn't been worked
+	NeededBand   = "band"   // the DX entity hasn't been worked on the band
+	NeededMode   = "mode"   // the DX entity hasn't been worked on the mode
+	NeededCall   = "call"   // the station hasn't been worked
+)
+
+// FilterConfig configures the filtering of spots before they are displayed.
+type FilterConfig struct {
+	Enabled bool
+	Rule    []FilterRule
+}
+
+// FilterRule matches spots on all of its non-empty criteria.  A criterion that
+// is a list matches if any item in the list matches.
+type FilterRule struct {
+	Name              string
+	Action            string // include or exclude, defaults to include
+	Disabled          bool
+	Sources           []string // e.g. DX, RBN, POTA, SOTA
+	Bands             []string // e.g. 20m
+	Modes             []string // e.g. CW, SSB, FT8 or a category, CW, PHONE or DATA
+	SpotterContinents []string // e.g. NA
+	DXContinents      []string
+	Entities          []string // entity names or DXCC codes
+	Call              string   // regular expression matched against the DX call
+	Keywords          []string // words to look for in the comment
+	Needed            string   // entity, band, mode or call
+	DXDaylight        []string // day, night or greyline at the DX station
+	HomeDaylight      []string // day, night or greyline at the operator's grid
+}
+
+// NeededFunc returns true if the spotted station is needed for a kind of
+// needed status (NeededEntity, NeededBand, etc.)
+type NeededFunc func(spot Spot, kind string) bool
+
+// Filter includes and excludes spots using a list of rules.  Any spot that
+// matches an exclude rule is dropped, and if there are any include rules a
+// spot must match at least one of them.  It's safe for concurrent use.
+type Filter struct {
+	mu      sync.Mutex
+	enabled bool
+	rules   []FilterRule
+	calls   []*regexp.Regexp
+	needed  NeededFunc
+	home    *geo.Point
+}
+
+// NewFilter constructs a new filter, returning an error if a rule is invalid.
+func NewFilter(cfg FilterConfig) (*Filter, error) {
+	f := &Filter{enabled: cfg.Enabled}
+	for _, r := range cfg.Rule {
+		r.Action = strings.ToLower(r.Action)
+		switch r.Action {
+		case "":
+			r.Action = FilterInclude
+		case FilterInclude, FilterExclude:
+		default:
+			return nil, fmt.Errorf("filter %q has unknown action %q", r.Name, r.Action)
+		}
+		r.Needed = strings.ToLower(r.Needed)
+		switch r.Needed {
+		case "", NeededEntity, NeededBand, NeededMode, NeededCall:
+		default:
+			return nil, fmt.Errorf("filter %q has unknown needed status %q", r.Name, r.Needed)
+		}
+		for _, d := range append(append([]string(nil), r.DXDaylight...), r.HomeDaylight...) {
+			if _, ok := geo.ParseDaylight(strings.ToLower(strings.TrimSpace(d))); !ok {
+				return nil, fmt.Errorf("filter %q has unknown daylight %q", r.Name, d)
+			}
+		}
+
+		var re *regexp.Regexp
+		if r.Call != "" {
+			var err error
+			if re, err = regexp.Compile("(?i)" + r.Call); err != nil {
+				return nil, fmt.Errorf("filter %q has an invalid call: %s", r.Name, err)
+			}
+		}
+		if r.Name == "" {
+			r.Name = fmt.Sprintf("Rule %d", len(f.rules)+1)
+		}
+		f.rules = append(f.rules, r)
+		f.calls = append(f.calls, re)
+	}
+	return f, nil
+}
+
+// SetNeeded sets the function used to determine if a station is needed.
+// Without it, rules with a needed status never match.
+func (f *Filter) SetNeeded(fn NeededFunc) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.needed = fn
+}
+
+// SetHome sets the operator's location.  Without it, rules with a home
+// daylight never match.
+func (f *Filter) SetHome(pt geo.Point) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.home = &pt
+}
+
+// Enabled returns true if the filter is being applied.
+func (f *Filter) Enabled() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.enabled
+}
+
+// SetEnabled turns the filter on or off, if off all spots are allowed.
+func (f *Filter) SetEnabled(b bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.enabled = b
+}
+
+// Rules returns a copy of the filter rules.
+func (f *Filter) Rules() []FilterRule {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FilterRule(nil), f.rules...)
+}
+
+// SetRuleEnabled turns an individual rule on or off.
+func (f *Filter) SetRuleEnabled(idx int, b bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if idx >= 0 && idx < len(f.rules) {
+		f.rules[idx].Disabled = !b
+	}
+}
+
+// Allow returns true if the spot passes the filter.
+func (f *Filter) Allow(spot Spot) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !f.enabled {
+		return true
+	}
+
+	hasInclude := false
+	included := false
+	for i, r := range f.rules {
+		if r.Disabled {
+			continue
+		}
+		if r.Action == FilterInclude {
+			hasInclude = true
+			if !included && f.matches(i, spot) {
+				included = true
+			}
+		} else if f.matches(i, spot) {
+			return false
+		}
+	}
+	return included || !hasInclude
+}
+
+// matches returns true if the spot matches the rule at idx.
+func (f *Filter) matches(idx int, spot Spot) bool {
+	r := f.rules[idx]
+	if len(r.Sources) > 0 && !containsFold(r.Sources, spot.Source) {
+		return false
+	}
+	if len(r.Bands) > 0 && !containsFold(r.Bands, spot.Band()) {
+		return false
+	}
+	if len(r.Modes) > 0 && !containsMode(r.Modes, spot.InferredMode()) {
+		return false
+	}
+	if len(r.SpotterContinents) > 0 {
+		ent, ok := spot.SpotterEntity()
+		if !ok || !containsFold(r.SpotterContinents, ent.Continent) {
+			return false
+		}
+	}
+	if len(r.DXContinents) > 0 || len(r.Entities) > 0 {
+		ent, ok := spot.DXEntity()
+		if !ok {
+			return false
+		}
+		if len(r.DXContinents) > 0 && !containsFold(r.DXContinents, ent.Continent) {
+			return false
+		}
+		if len(r.Entities) > 0 && !containsFold(r.Entities, ent.Entity) &&
+			!containsFold(r.Entities, strconv.Itoa(ent.DXCC)) {
+			return false
+		}
+	}
+	if re := f.calls[idx]; re != nil && !re.MatchString(spot.DXStation) {
+		return false
+	}
+	if len(r.Keywords) > 0 {
+		comment := strings.ToLower(spot.Comment)
+		found := false
+		for _, kw := range r.Keywords {
+			if strings.Contains(comment, strings.ToLower(kw)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(r.DXDaylight) > 0 {
+		d, ok := Daylight(spot.DXStation, filterTime(spot))
+		if !ok || !containsFold(r.DXDaylight, d.String()) {
+			return false
+		}
+	}
+	if len(r.HomeDaylight) > 0 {
+		if f.home == nil || !containsFold(r.HomeDaylight, f.home.Daylight(filterTime(spot)).String()) {
+			return false
+		}
+	}
+	if r.Needed != "" && (f.needed == nil || !f.needed(spot, r.Needed)) {
+		return false
+	}
+	return true
+}
+
+// filterTime returns the time of the spot, or the current time if the source
+// didn't provide it.
+func filterTime(spot Spot) time.Time {
+	if spot.Time.IsZero() {
+		return time.Now()
+	}
+	return spot.Time
+}
+
+// containsMode returns true if the list has the mode.  Either can be a mode
+// category (CW, PHONE or DATA), so a rule for DATA matches an FT8 spot and a
+// rule for FT8 matches a spot the band plan says is DATA.
+func containsMode(list []string, mode string) bool {
+	mode = strings.ToUpper(mode)
+	category := adif.ModeCategory(mode)
+	for _, v := range list {
+		v = strings.ToUpper(strings.TrimSpace(v))
+		if v == mode {
+			return true
+		}
+		if category != "" && adif.ModeCategory(v) == category && (v == category || mode == category) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(list []string, s string) bool {
+	for _, v := range list {
+		if strings.EqualFold(strings.TrimSpace(v), s) {
+			return true
+		}
+	}
+	return false
+}
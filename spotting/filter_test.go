@@ -0,0 +1,161 @@
+package spotting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tzneal/ham-go/geo"
+	"github.com/tzneal/ham-go/spotting"
+)
+
+func TestInferMode(t *testing.T) {
+	td := []struct {
+		freq    float64
+		comment string
+		exp     string
+	}{
+		{14025, "", "CW"},
+		{14075.5, "", "FT8"},
+		{7048, "", "FT4"},
+		{14100, "", "DATA"},
+		{14250, "", "SSB"},
+		{14250, "up 5 cw", "CW"},
+		{14085, "RTTY contest", "RTTY"},
+		{144200, "", ""},
+	}
+	for _, tc := range td {
+		if got := spotting.InferMode(tc.freq, tc.comment); got != tc.exp {
+			t.Errorf("%v %q: expected %q, got %q", tc.freq, tc.comment, tc.exp, got)
+		}
+	}
+}
+
+func TestFilter(t *testing.T) {
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	spots := []spotting.Spot{
+		{Source: "DX", Frequency: 14025, DXStation: "JA1ABC", Spotter: "W1AW", Time: now},
+		{Source: "RBN", Frequency: 7010, DXStation: "G4ABC", Spotter: "DL1ABC-#", Time: now},
+		{Source: "POTA", Frequency: 14250, DXStation: "K1ABC", Spotter: "K1ABC", Comment: "QRT soon", Time: now},
+		{Source: "DX", Frequency: 21074, DXStation: "VK2ABC", Spotter: "JA1XYZ-2", Time: now},
+	}
+	td := []struct {
+		name  string
+		rules []spotting.FilterRule
+		exp   []bool
+	}{
+		{"none", nil, []bool{true, true, true, true}},
+		{"exclude source", []spotting.FilterRule{{Action: "exclude", Sources: []string{"rbn"}}}, []bool{true, false, true, true}},
+		{"include band", []spotting.FilterRule{{Bands: []string{"20m"}}}, []bool{true, false, true, false}},
+		{"include mode", []spotting.FilterRule{{Modes: []string{"CW", "FT8"}}}, []bool{true, true, false, true}},
+		{"spotter continent", []spotting.FilterRule{{SpotterContinents: []string{"NA"}}}, []bool{true, false, true, false}},
+		{"dx continent", []spotting.FilterRule{{DXContinents: []string{"AS", "OC"}}}, []bool{true, false, false, true}},
+		{"entity", []spotting.FilterRule{{Entities: []string{"England", "150"}}}, []bool{false, true, false, true}},
+		{"call", []spotting.FilterRule{{Call: "^k"}}, []bool{false, false, true, false}},
+		{"keywords", []spotting.FilterRule{{Action: "exclude", Keywords: []string{"qrt"}}}, []bool{true, true, false, true}},
+		{"include and exclude", []spotting.FilterRule{
+			{Bands: []string{"20m", "40m"}},
+			{Action: "exclude", Modes: []string{"SSB"}},
+		}, []bool{true, true, false, false}},
+		{"disabled", []spotting.FilterRule{{Disabled: true, Bands: []string{"15m"}}}, []bool{true, true, true, true}},
+		{"needed", []spotting.FilterRule{{Needed: "entity"}}, []bool{true, false, false, false}},
+		{"dx daylight", []spotting.FilterRule{{DXDaylight: []string{"Night", "greyline"}}}, []bool{true, false, false, true}},
+		{"no home", []spotting.FilterRule{{HomeDaylight: []string{"day"}}}, []bool{false, false, false, false}},
+	}
+	for _, tc := range td {
+		f, err := spotting.NewFilter(spotting.FilterConfig{Enabled: true, Rule: tc.rules})
+		if err != nil {
+			t.Fatalf("%s: %s", tc.name, err)
+		}
+		f.SetNeeded(func(spot spotting.Spot, kind string) bool {
+			return kind == spotting.NeededEntity && spot.DXStation == "JA1ABC"
+		})
+		for i, spot := range spots {
+			if got := f.Allow(spot); got != tc.exp[i] {
+				t.Errorf("%s: expected %v for %s, got %v", tc.name, tc.exp[i], spot.DXStation, got)
+			}
+		}
+	}
+}
+
+func TestFilterToggle(t *testing.T) {
+	f, err := spotting.NewFilter(spotting.FilterConfig{Rule: []spotting.FilterRule{
+		{Name: "no RBN", Action: "exclude", Sources: []string{"RBN"}},
+	}})
+	if err != nil {
+		t.Fatalf("error creating filter: %s", err)
+	}
+	spot := spotting.Spot{Source: "RBN", Frequency: 14025, DXStation: "JA1ABC"}
+	if !f.Allow(spot) {
+		t.Errorf("expected a disabled filter to allow all spots")
+	}
+	f.SetEnabled(true)
+	if f.Allow(spot) {
+		t.Errorf("expected the spot to be excluded")
+	}
+	f.SetRuleEnabled(0, false)
+	if !f.Allow(spot) || !f.Rules()[0].Disabled {
+		t.Errorf("expected the rule to be disabled")
+	}
+}
+
+func TestFilterModeCategory(t *testing.T) {
+	td := []struct {
+		modes []string
+		spot  spotting.Spot
+		exp   bool
+	}{
+		{[]string{"DATA"}, spotting.Spot{Frequency: 14074}, true},
+		{[]string{"data"}, spotting.Spot{Frequency: 14085, Comment: "rtty"}, true},
+		{[]string{"FT8"}, spotting.Spot{Frequency: 14100}, true},
+		{[]string{"FT8"}, spotting.Spot{Frequency: 14085, Comment: "rtty"}, false},
+		{[]string{"PHONE"}, spotting.Spot{Frequency: 14250}, true},
+		{[]string{"PHONE"}, spotting.Spot{Frequency: 14025}, false},
+		{[]string{"CW"}, spotting.Spot{Frequency: 14250, Mode: "cw"}, true},
+		{[]string{"DATA"}, spotting.Spot{Frequency: 144200}, false},
+	}
+	for _, tc := range td {
+		f, err := spotting.NewFilter(spotting.FilterConfig{Enabled: true, Rule: []spotting.FilterRule{{Modes: tc.modes}}})
+		if err != nil {
+			t.Fatalf("error creating filter: %s", err)
+		}
+		tc.spot.DXStation = "JA1ABC"
+		if got := f.Allow(tc.spot); got != tc.exp {
+			t.Errorf("%v for %v %q: expected %v, got %v", tc.modes, tc.spot.Frequency, tc.spot.Comment, tc.exp, got)
+		}
+	}
+}
+
+func TestFilterHomeDaylight(t *testing.T) {
+	f, err := spotting.NewFilter(spotting.FilterConfig{Enabled: true, Rule: []spotting.FilterRule{
+		{HomeDaylight: []string{"greyline"}},
+	}})
+	if err != nil {
+		t.Fatalf("error creating filter: %s", err)
+	}
+	home, err := geo.ParseGrid("FN31")
+	if err != nil {
+		t.Fatalf("error parsing grid: %s", err)
+	}
+	f.SetHome(home)
+	morning := time.Date(2020, 6, 1, 9, 0, 0, 0, time.UTC)
+	if !f.Allow(spotting.Spot{Frequency: 14025, DXStation: "JA1ABC", Time: morning}) {
+		t.Errorf("expected a spot at sunrise to be allowed")
+	}
+	if f.Allow(spotting.Spot{Frequency: 14025, DXStation: "JA1ABC", Time: morning.Add(3 * time.Hour)}) {
+		t.Errorf("expected a spot during the day to be filtered")
+	}
+}
+
+func TestFilterInvalid(t *testing.T) {
+	for _, r := range []spotting.FilterRule{
+		{Action: "drop"},
+		{Call: "[A-"},
+		{Needed: "zone"},
+		{DXDaylight: []string{"dusk"}},
+		{HomeDaylight: []string{"day", "evening"}},
+	} {
+		if _, err := spotting.NewFilter(spotting.FilterConfig{Rule: []spotting.FilterRule{r}}); err == nil {
+			t.Errorf("expected an error for %+v", r)
+		}
+	}
+}
@@ -0,0 +1,291 @@
+package spotting
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func init() {
+	RegisterSource("http", NewHTTPSource)
+}
+
+// httpMinDelay is the shortest time allowed between polls of a spot API
+const httpMinDelay = 30 * time.Second
+
+// httpSource polls a web API that returns spots as JSON, e.g. WWFF, GMA or a
+// club spot feed.  The JSON fields that make up a spot are given as options.
+type httpSource struct {
+	name       string
+	url        string
+	fields     httpFields
+	unit       float64 // multiplier to convert frequencies to kHz
+	timeFormat string
+	delay      time.Duration
+	done       chan struct{}
+	seen       map[Spot]bool
+}
+
+// httpFields are the paths in the JSON response to the spot fields.  Paths are
+// dot separated object keys and array indexes, e.g. data.spots or 0.call.
+type httpFields struct {
+	list      string // the array of spots, the response itself if empty
+	call      string
+	frequency string
+	mode      string
+	time      string
+	reference string
+	comment   string
+	spotter   string
+	location  string
+}
+
+// NewHTTPSource constructs a spot source that polls the Address for spots as
+// JSON.  The options are:
+//
+//	ListField       path to the array of spots, defaults to the whole response
+//	CallField       path to the spotted call, required
+//	FrequencyField  path to the frequency, required
+//	FrequencyUnit   Hz, kHz or MHz, defaults to kHz
+//	ModeField, ReferenceField, CommentField, SpotterField, LocationField
+//	TimeField       path to the time of the spot, defaults to when it's received
+//	TimeFormat      a Go time layout, unix or unixms, defaults to RFC 3339
+//	PollSeconds     the delay between polls, defaults to 60
+func NewHTTPSource(cfg SourceConfig) (Source, error) {
+	opt := func(key string) string {
+		return strings.TrimSpace(cfg.Options[key])
+	}
+	s := &httpSource{
+		name: cfg.name("HTTP"),
+		url:  cfg.Address,
+		fields: httpFields{
+			list:      opt("ListField"),
+			call:      opt("CallField"),
+			frequency: opt("FrequencyField"),
+			mode:      opt("ModeField"),
+			time:      opt("TimeField"),
+			reference: opt("ReferenceField"),
+			comment:   opt("CommentField"),
+			spotter:   opt("SpotterField"),
+			location:  opt("LocationField"),
+		},
+		timeFormat: opt("TimeFormat"),
+		done:       make(chan struct{}),
+		seen:       map[Spot]bool{},
+	}
+	if s.url == "" {
+		return nil, errors.New("an http source requires an address")
+	}
+	if s.fields.call == "" || s.fields.frequency == "" {
+		return nil, errors.New("an http source requires a CallField and FrequencyField")
+	}
+	switch strings.ToLower(opt("FrequencyUnit")) {
+	case "hz":
+		s.unit = 1e-3
+	case "", "khz":
+		s.unit = 1
+	case "mhz":
+		s.unit = 1e3
+	default:
+		return nil, fmt.Errorf("unknown frequency unit %q for http source", opt("FrequencyUnit"))
+	}
+	if s.timeFormat == "" {
+		s.timeFormat = time.RFC3339
+	}
+	poll, err := cfg.intOption("PollSeconds", 60)
+	if err != nil {
+		return nil, err
+	}
+	s.delay = time.Duration(poll) * time.Second
+	if s.delay < httpMinDelay {
+		s.delay = httpMinDelay
+	}
+	return s, nil
+}
+
+func (s *httpSource) Name() string {
+	return s.name
+}
+
+func (s *httpSource) Run(spots chan<- Spot) {
+	go func() {
+		for {
+			for _, spot := range s.poll(time.Now()) {
+				select {
+				case spots <- spot:
+				case <-s.done:
+					return
+				}
+			}
+			select {
+			case <-time.After(s.delay):
+			case <-s.done:
+				return
+			}
+		}
+	}()
+}
+
+func (s *httpSource) Close() error {
+	close(s.done)
+	return nil
+}
+
+// poll fetches the spots, returning those that weren't in the last response.
+func (s *httpSource) poll(now time.Time) []Spot {
+	req, err := http.NewRequest("GET", s.url, nil)
+	if err != nil {
+		log.Printf("error forming %s request: %s", s.name, err)
+		return nil
+	}
+	req.Header.Set("User-Agent", "ham-go")
+	rsp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		log.Printf("error fetching %s spots: %s", s.name, err)
+		return nil
+	}
+	defer rsp.Body.Close()
+	if rsp.StatusCode != http.StatusOK {
+		log.Printf("error fetching %s spots: %s", s.name, rsp.Status)
+		return nil
+	}
+
+	dec := json.NewDecoder(rsp.Body)
+	dec.UseNumber()
+	var body interface{}
+	if err := dec.Decode(&body); err != nil {
+		log.Printf("error parsing %s spots: %s", s.name, err)
+		return nil
+	}
+	list, ok := jsonPath(body, s.fields.list)
+	items, isList := list.([]interface{})
+	if !ok || !isList {
+		log.Printf("error parsing %s spots: no list of spots at %q", s.name, s.fields.list)
+		return nil
+	}
+
+	var ret []Spot
+	seen := map[Spot]bool{}
+	for _, item := range items {
+		spot, err := s.parseSpot(item, now)
+		if err != nil {
+			log.Printf("error parsing %s spot: %s", s.name, err)
+			continue
+		}
+		// without a time, every poll would give the spots a new one
+		key := spot
+		if s.fields.time == "" {
+			key.Time = time.Time{}
+		}
+		if !s.seen[key] && !seen[key] {
+			ret = append(ret, spot)
+		}
+		seen[key] = true
+	}
+	s.seen = seen
+	return ret
+}
+
+// parseSpot converts an item from the JSON response to a spot.
+func (s *httpSource) parseSpot(item interface{}, now time.Time) (Spot, error) {
+	field := func(path string) string {
+		if path == "" {
+			return ""
+		}
+		v, _ := jsonPath(item, path)
+		return strings.TrimSpace(jsonString(v))
+	}
+
+	spot := Spot{
+		Source:    s.name,
+		DXStation: strings.ToUpper(field(s.fields.call)),
+		Spotter:   strings.ToUpper(field(s.fields.spotter)),
+		Comment:   field(s.fields.comment),
+		Mode:      strings.ToUpper(field(s.fields.mode)),
+		Reference: field(s.fields.reference),
+		Location:  field(s.fields.location),
+		Time:      now.UTC().Truncate(time.Second),
+	}
+	if spot.DXStation == "" {
+		return spot, errors.New("missing call")
+	}
+	freq, err := strconv.ParseFloat(field(s.fields.frequency), 64)
+	if err != nil {
+		return spot, fmt.Errorf("invalid frequency for %s: %s", spot.DXStation, err)
+	}
+	spot.Frequency = freq * s.unit
+	if spot.Location == "" {
+		spot.Location = spot.Reference
+	}
+
+	if s.fields.time != "" {
+		if spot.Time, err = parseSpotTime(field(s.fields.time), s.timeFormat); err != nil {
+			return spot, fmt.Errorf("invalid time for %s: %s", spot.DXStation, err)
+		}
+	}
+	return spot, nil
+}
+
+// parseSpotTime parses a time in a Go time layout, or seconds or milliseconds
+// since the Unix epoch.
+func parseSpotTime(v string, format string) (time.Time, error) {
+	switch strings.ToLower(format) {
+	case "unix", "unixms":
+		n, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if strings.ToLower(format) == "unixms" {
+			n /= 1e3
+		}
+		return time.Unix(0, int64(n*1e9)).UTC(), nil
+	}
+	t, err := time.Parse(format, v)
+	return t.UTC(), err
+}
+
+// jsonPath returns the value at a dot separated path of object keys and array
+// indexes.
+func jsonPath(v interface{}, path string) (interface{}, bool) {
+	if path == "" {
+		return v, true
+	}
+	for _, key := range strings.Split(path, ".") {
+		switch c := v.(type) {
+		case map[string]interface{}:
+			var ok bool
+			if v, ok = c[key]; !ok {
+				return nil, false
+			}
+		case []interface{}:
+			idx, err := strconv.Atoi(key)
+			if err != nil || idx < 0 || idx >= len(c) {
+				return nil, false
+			}
+			v = c[idx]
+		default:
+			return nil, false
+		}
+	}
+	return v, true
+}
+
+// jsonString formats a JSON value as a string.
+func jsonString(v interface{}) string {
+	switch c := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return c
+	case json.Number:
+		return c.String()
+	case bool:
+		return strconv.FormatBool(c)
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,120 @@
+package spotting_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/tzneal/ham-go/spotting"
+)
+
+func TestHTTPSource(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status": "ok", "data": {"spots": [
+			{"activator": "on4abc", "qrg": 14244000, "mode": "ssb", "ref": "ONFF-0001", "ts": 1588249082,
+			 "by": {"call": "DL1XYZ"}, "text": "loud"},
+			{"activator": "on4abc", "qrg": 14244000, "mode": "ssb", "ref": "ONFF-0001", "ts": 1588249082,
+			 "by": {"call": "DL1XYZ"}, "text": "loud"},
+			{"activator": "", "qrg": 7100000},
+			{"activator": "G4ABC", "qrg": "7.1 MHz", "ts": 1588249082},
+			{"activator": "F5ABC", "qrg": 7032000, "ts": 1588249142, "mode": "CW"}
+		]}}`)
+	}))
+	defer srv.Close()
+
+	src, err := spotting.NewSource(spotting.SourceConfig{
+		Type:    "http",
+		Name:    "WWFF",
+		Address: srv.URL,
+		Options: map[string]string{
+			"ListField":      "data.spots",
+			"CallField":      "activator",
+			"FrequencyField": "qrg",
+			"FrequencyUnit":  "Hz",
+			"ModeField":      "mode",
+			"TimeField":      "ts",
+			"TimeFormat":     "unix",
+			"ReferenceField": "ref",
+			"CommentField":   "text",
+			"SpotterField":   "by.call",
+		},
+	})
+	if err != nil {
+		t.Fatalf("error constructing source: %s", err)
+	}
+	spots := make(chan spotting.Spot)
+	src.Run(spots)
+	defer src.Close()
+
+	spot := receiveSpot(t, spots)
+	exp := spotting.Spot{
+		Source:    "WWFF",
+		Frequency: 14244,
+		DXStation: "ON4ABC",
+		Spotter:   "DL1XYZ",
+		Comment:   "loud",
+		Mode:      "SSB",
+		Time:      time.Date(2020, 4, 30, 12, 18, 2, 0, time.UTC),
+		Reference: "ONFF-0001",
+		Location:  "ONFF-0001",
+	}
+	if spot != exp {
+		t.Errorf("expected %+v, got %+v", exp, spot)
+	}
+	// the duplicate and invalid spots are skipped
+	if spot = receiveSpot(t, spots); spot.DXStation != "F5ABC" || spot.Frequency != 7032 {
+		t.Errorf("expected F5ABC on 7032, got %+v", spot)
+	}
+	select {
+	case spot := <-spots:
+		t.Errorf("expected no more spots, got %+v", spot)
+	case <-time.After(200 * time.Millisecond):
+	}
+}
+
+func TestHTTPSourceList(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[["K1ABC", "14.062", "30/04/2020 12:18"]]`)
+	}))
+	defer srv.Close()
+
+	src, err := spotting.NewSource(spotting.SourceConfig{
+		Type:    "http",
+		Address: srv.URL,
+		Options: map[string]string{
+			"CallField":      "0",
+			"FrequencyField": "1",
+			"FrequencyUnit":  "MHz",
+			"TimeField":      "2",
+			"TimeFormat":     "02/01/2006 15:04",
+		},
+	})
+	if err != nil {
+		t.Fatalf("error constructing source: %s", err)
+	}
+	spots := make(chan spotting.Spot)
+	src.Run(spots)
+	defer src.Close()
+
+	spot := receiveSpot(t, spots)
+	if spot.Source != "HTTP" || spot.DXStation != "K1ABC" || spot.Frequency != 14062 ||
+		!spot.Time.Equal(time.Date(2020, 4, 30, 12, 18, 0, 0, time.UTC)) {
+		t.Errorf("unexpected spot %+v", spot)
+	}
+}
+
+func TestHTTPSourceConfig(t *testing.T) {
+	for _, opts := range []map[string]string{
+		{"FrequencyField": "freq"},
+		{"CallField": "call"},
+		{"CallField": "call", "FrequencyField": "freq", "FrequencyUnit": "GHz"},
+		{"CallField": "call", "FrequencyField": "freq", "PollSeconds": "soon"},
+	} {
+		_, err := spotting.NewSource(spotting.SourceConfig{Type: "http", Address: "http://localhost", Options: opts})
+		if err == nil {
+			t.Errorf("expected an error for %v", opts)
+		}
+	}
+}
@@ -2,8 +2,10 @@ package spotting
 
 import (
 	"encoding/json"
+	"fmt"
 	"log"
 	"net/http"
+	"strconv"
 	"time"
 )
 
@@ -98,7 +100,11 @@ func (c *POTAClient) run() {
 				log.Printf("err parsing POTA time: %s", err)
 				continue
 			}
-			c.Spots <- v
+			select {
+			case c.Spots <- v:
+			case <-c.shutdown:
+				return true
+			}
 		}
 		return true
 	}
@@ -117,3 +123,86 @@ func (c *POTAClient) run() {
 		}
 	}
 }
+
+func init() {
+	RegisterSource("pota", NewPOTASource)
+}
+
+// potaSource is a POTA spot source.
+type potaSource struct {
+	client *POTAClient
+	name   string
+	done   chan struct{}
+}
+
+// NewPOTASource constructs a POTA spot source, the Address is the spot API
+// URL and the PollSeconds option is the delay between checks for spots.
+func NewPOTASource(cfg SourceConfig) (Source, error) {
+	poll, err := cfg.intOption("PollSeconds", 0)
+	if err != nil {
+		return nil, err
+	}
+	return &potaSource{
+		client: NewPOTAClient(POTAConfig{
+			URL:   cfg.Address,
+			Delay: time.Duration(poll) * time.Second,
+		}),
+		name: cfg.name("POTA"),
+		done: make(chan struct{}),
+	}, nil
+}
+
+func (s *potaSource) Name() string {
+	return s.name
+}
+
+func (s *potaSource) Run(spots chan<- Spot) {
+	s.client.Run()
+	go func() {
+		for {
+			select {
+			case <-s.done:
+				return
+			case spot, ok := <-s.client.Spots:
+				if !ok {
+					return
+				}
+				freq, err := strconv.ParseFloat(spot.Frequency, 64)
+				if err != nil {
+					log.Printf("error parsing %s spot of %s: %s", s.name, spot.Activator, err)
+					continue
+				}
+				tm, err := spot.Time()
+				if err != nil {
+					log.Printf("error parsing %s spot of %s: %s", s.name, spot.Activator, err)
+					continue
+				}
+				location := spot.Reference + "/" + spot.ParkName
+				if spot.LocationDescription != "" {
+					location = fmt.Sprintf("%s/%s/%s", spot.Reference, spot.ParkName, spot.LocationDescription)
+				}
+				out := Spot{
+					Source:    s.name,
+					Frequency: freq,
+					DXStation: spot.Activator,
+					Spotter:   spot.Spotter,
+					Comment:   spot.Comments,
+					Mode:      spot.Mode,
+					Time:      tm,
+					Reference: spot.Reference,
+					Location:  location,
+				}
+				select {
+				case spots <- out:
+				case <-s.done:
+					return
+				}
+			}
+		}
+	}()
+}
+
+func (s *potaSource) Close() error {
+	close(s.done)
+	return s.client.Close()
+}
@@ -0,0 +1,316 @@
+package spotting
+
+import (
+	"math"
+	"time"
+)
+
+// RBNAddress is the Reverse Beacon Network CW and RTTY telnet server
+const RBNAddress = "telnet.reversebeacon.net:7000"
+
+// RBNConfig is the Reverse Beacon Network client config
+type RBNConfig struct {
+	Network  string
+	Address  string // e.g. telnet.reversebeacon.net:7000 for CW and RTTY, or :7001 for FT8
+	Callsign string
+	// Window is how long reports of a station from different skimmers are
+	// collected into one spot, defaults to 10 seconds
+	Window time.Duration
+	// Holdoff is how long after a station is spotted that it won't be spotted
+	// again near the same frequency, defaults to 10 minutes
+	Holdoff time.Duration
+	// MaxSpotsPerMinute limits the number of spots delivered, any more are
+	// dropped.  Defaults to 30.
+	MaxSpotsPerMinute int
+}
+
+// RBNClient is a Reverse Beacon Network or CW skimmer telnet client.  The
+// reports from all of the skimmers that hear a station are aggregated into a
+// single spot, and spots are rate limited as the network is very busy.
+type RBNClient struct {
+	Spots chan RBNSpot
+
+	config   RBNConfig
+	shutdown chan struct{}
+	conn     *telnetConn
+	agg      *rbnAggregator
+	limiter  *rateLimiter
+}
+
+// NewRBNClient constructs a new RBN client
+func NewRBNClient(cfg RBNConfig) *RBNClient {
+	if cfg.Network == "" {
+		cfg.Network = "tcp"
+	}
+	if cfg.Window == 0 {
+		cfg.Window = 10 * time.Second
+	}
+	if cfg.Holdoff == 0 {
+		cfg.Holdoff = 10 * time.Minute
+	}
+	if cfg.MaxSpotsPerMinute == 0 {
+		cfg.MaxSpotsPerMinute = 30
+	}
+	return &RBNClient{
+		config:   cfg,
+		Spots:    make(chan RBNSpot),
+		shutdown: make(chan struct{}),
+		agg:      newRBNAggregator(cfg.Window, cfg.Holdoff),
+		limiter:  newRateLimiter(cfg.MaxSpotsPerMinute, time.Minute),
+	}
+}
+
+// Close gracefully shuts down the client
+func (c *RBNClient) Close() error {
+	close(c.shutdown)
+	return nil
+}
+
+// Run is a non-blocking call that starts the client
+func (c *RBNClient) Run() {
+	go c.run()
+}
+
+func (c *RBNClient) run() {
+	defer func() {
+		if c.conn != nil {
+			c.conn.Close()
+			c.conn = nil
+		}
+	}()
+	for {
+		select {
+		case <-c.shutdown:
+			return
+		default:
+		}
+
+		// not connected yet, or we are reconnecting
+		if c.conn == nil {
+			conn, err := dialTelnet(c.config.Network, c.config.Address)
+			if err != nil {
+				// connect failed, so wait a while and try again
+				select {
+				case <-c.shutdown:
+					return
+				case <-time.After(30 * time.Second):
+				}
+				continue
+			}
+			c.conn = conn
+			c.conn.login(c.config.Callsign)
+		}
+
+		line, err := c.conn.readLine()
+		if err != nil {
+			// error on read, so just try to reconnect
+			c.conn.Close()
+			c.conn = nil
+			continue
+		}
+		now := time.Now()
+		if spot, err := RBNParse(line, now); spot != nil && err == nil {
+			c.agg.add(*spot, now)
+		}
+		for _, spot := range c.agg.expired(now) {
+			if !c.limiter.allow(now) {
+				continue
+			}
+			select {
+			case c.Spots <- spot:
+			case <-c.shutdown:
+				return
+			}
+		}
+	}
+}
+
+func init() {
+	RegisterSource("rbn", NewRBNSource)
+}
+
+// rbnSource is a Reverse Beacon Network or CW skimmer spot source.
+type rbnSource struct {
+	client *RBNClient
+	name   string
+	done   chan struct{}
+}
+
+// NewRBNSource constructs a Reverse Beacon Network spot source.  The
+// WindowSeconds, HoldoffMinutes and MaxSpotsPerMinute options are the same as
+// the RBNConfig fields.
+func NewRBNSource(cfg SourceConfig) (Source, error) {
+	window, err := cfg.intOption("WindowSeconds", 0)
+	if err != nil {
+		return nil, err
+	}
+	holdoff, err := cfg.intOption("HoldoffMinutes", 0)
+	if err != nil {
+		return nil, err
+	}
+	maxSpots, err := cfg.intOption("MaxSpotsPerMinute", 0)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Address == "" {
+		cfg.Address = RBNAddress
+	}
+	return &rbnSource{
+		client: NewRBNClient(RBNConfig{
+			Address:           cfg.Address,
+			Callsign:          cfg.Callsign,
+			Window:            time.Duration(window) * time.Second,
+			Holdoff:           time.Duration(holdoff) * time.Minute,
+			MaxSpotsPerMinute: maxSpots,
+		}),
+		name: cfg.name("RBN"),
+		done: make(chan struct{}),
+	}, nil
+}
+
+func (s *rbnSource) Name() string {
+	return s.name
+}
+
+func (s *rbnSource) Run(spots chan<- Spot) {
+	s.client.Run()
+	go func() {
+		for {
+			select {
+			case <-s.done:
+				return
+			case spot := <-s.client.Spots:
+				select {
+				case spots <- Spot{
+					Source:    s.name,
+					Frequency: spot.Frequency,
+					DXStation: spot.DXStation,
+					Spotter:   spot.Spotter,
+					Comment:   spot.Comment(),
+					Mode:      spot.Mode,
+					Time:      spot.Time,
+					Location:  spot.Spotter,
+				}:
+				case <-s.done:
+					return
+				}
+			}
+		}
+	}()
+}
+
+func (s *rbnSource) Close() error {
+	close(s.done)
+	return s.client.Close()
+}
+
+// rbnTolerance is how far apart in kHz reports of a station can be and still
+// be considered the same signal, as skimmers don't all agree on the frequency.
+const rbnTolerance = 0.5
+
+// rbnAggregator collects the reports of a station from different skimmers into
+// one spot.
+type rbnAggregator struct {
+	window  time.Duration
+	holdoff time.Duration
+	pending []*rbnPending
+	// recent are the spots that have been delivered, to hold off respotting
+	recent []rbnPending
+}
+
+type rbnPending struct {
+	spot     RBNSpot
+	received time.Time
+	spotters map[string]bool
+}
+
+func newRBNAggregator(window, holdoff time.Duration) *rbnAggregator {
+	return &rbnAggregator{window: window, holdoff: holdoff}
+}
+
+func sameSignal(a, b RBNSpot) bool {
+	return a.DXStation == b.DXStation && math.Abs(a.Frequency-b.Frequency) <= rbnTolerance
+}
+
+// add adds a skimmer report received at time now.
+func (a *rbnAggregator) add(spot RBNSpot, now time.Time) {
+	for _, r := range a.recent {
+		if sameSignal(r.spot, spot) && now.Sub(r.received) < a.holdoff {
+			return
+		}
+	}
+	for _, p := range a.pending {
+		if !sameSignal(p.spot, spot) {
+			continue
+		}
+		if !p.spotters[spot.Spotter] {
+			p.spotters[spot.Spotter] = true
+			p.spot.Skimmers++
+		}
+		if spot.SNR > p.spot.SNR {
+			p.spot.SNR = spot.SNR
+			p.spot.Spotter = spot.Spotter
+			p.spot.Frequency = spot.Frequency
+		}
+		return
+	}
+	spot.Skimmers = 1
+	a.pending = append(a.pending, &rbnPending{
+		spot:     spot,
+		received: now,
+		spotters: map[string]bool{spot.Spotter: true},
+	})
+}
+
+// expired returns the aggregated spots whose window has passed at time now.
+func (a *rbnAggregator) expired(now time.Time) []RBNSpot {
+	var ret []RBNSpot
+	pending := a.pending[:0]
+	for _, p := range a.pending {
+		if now.Sub(p.received) >= a.window {
+			ret = append(ret, p.spot)
+			a.recent = append(a.recent, rbnPending{spot: p.spot, received: now})
+		} else {
+			pending = append(pending, p)
+		}
+	}
+	a.pending = pending
+
+	recent := a.recent[:0]
+	for _, r := range a.recent {
+		if now.Sub(r.received) < a.holdoff {
+			recent = append(recent, r)
+		}
+	}
+	a.recent = recent
+	return ret
+}
+
+// rateLimiter is a token bucket that allows a number of events per period.
+type rateLimiter struct {
+	rate   float64 // tokens per second
+	max    float64
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(n int, per time.Duration) *rateLimiter {
+	return &rateLimiter{
+		rate:   float64(n) / per.Seconds(),
+		max:    float64(n),
+		tokens: float64(n),
+	}
+}
+
+// allow returns true if an event at time now is within the limit.
+func (r *rateLimiter) allow(now time.Time) bool {
+	if !r.last.IsZero() {
+		r.tokens = math.Min(r.max, r.tokens+now.Sub(r.last).Seconds()*r.rate)
+	}
+	r.last = now
+	if r.tokens < 1 {
+		return false
+	}
+	r.tokens--
+	return true
+}
@@ -0,0 +1,121 @@
+package spotting_test
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tzneal/ham-go/spotting"
+)
+
+// fakeTelnet is a local telnet server that sends lines to the client after it
+// logs in.
+type fakeTelnet struct {
+	ln    net.Listener
+	login chan string
+	lines chan string
+}
+
+func newFakeTelnet(t *testing.T) *fakeTelnet {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("error listening: %s", err)
+	}
+	f := &fakeTelnet{
+		ln:    ln,
+		login: make(chan string, 1),
+		lines: make(chan string, 100),
+	}
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		fmt.Fprint(conn, "Welcome to the fake skimmer\r\nPlease enter your call: ")
+		call, _ := bufio.NewReader(conn).ReadString('\n')
+		f.login <- strings.TrimSpace(call)
+		for line := range f.lines {
+			fmt.Fprint(conn, line+"\r\n")
+		}
+	}()
+	return f
+}
+
+func (f *fakeTelnet) Close() {
+	close(f.lines)
+	f.ln.Close()
+}
+
+func (f *fakeTelnet) spot(spotter string, freq float64, call string, snr int) {
+	f.lines <- fmt.Sprintf("DX de %-9s %10.1f  %-12s   CW   %3d dB  28 WPM  CQ      %s",
+		spotter+":", freq, call, snr, time.Now().UTC().Format("1504Z"))
+}
+
+// receive returns the spots received until there are none for a while.
+func receive(c *spotting.RBNClient, wait time.Duration) []spotting.RBNSpot {
+	var spots []spotting.RBNSpot
+	for {
+		select {
+		case spot := <-c.Spots:
+			spots = append(spots, spot)
+		case <-time.After(wait):
+			return spots
+		}
+	}
+}
+
+func TestRBNClient(t *testing.T) {
+	srv := newFakeTelnet(t)
+	defer srv.Close()
+
+	client := spotting.NewRBNClient(spotting.RBNConfig{
+		Address:           srv.ln.Addr().String(),
+		Callsign:          "KN4LHY",
+		Window:            200 * time.Millisecond,
+		MaxSpotsPerMinute: 3,
+	})
+	client.Run()
+	defer client.Close()
+
+	select {
+	case call := <-srv.login:
+		if call != "KN4LHY" {
+			t.Errorf("expected login as KN4LHY, got %s", call)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for login")
+	}
+
+	// three skimmers hear OH2BH, within the tolerance of each other
+	srv.spot("EA5WU-#", 14025.0, "OH2BH", 12)
+	srv.spot("DK9IP-#", 14025.1, "OH2BH", 31)
+	srv.spot("W3LPL-#", 14024.9, "OH2BH", 8)
+	srv.spot("W3LPL-#", 14024.9, "OH2BH", 9)
+	// a different station, and OH2BH on another band
+	srv.spot("EA5WU-#", 7012.0, "K1ABC", 15)
+	srv.spot("EA5WU-#", 7025.0, "OH2BH", 20)
+
+	spots := receive(client, time.Second)
+	if len(spots) != 3 {
+		t.Fatalf("expected 3 spots, got %d: %+v", len(spots), spots)
+	}
+	oh := spots[0]
+	if oh.DXStation != "OH2BH" || oh.Skimmers != 3 || oh.SNR != 31 || oh.Spotter != "DK9IP-#" || oh.Frequency != 14025.1 {
+		t.Errorf("expected OH2BH heard by 3 skimmers with a best SNR of 31 at DK9IP-#, got %+v", oh)
+	}
+	if spots[1].DXStation != "K1ABC" || spots[1].Skimmers != 1 {
+		t.Errorf("expected K1ABC heard by 1 skimmer, got %+v", spots[1])
+	}
+
+	// OH2BH was just spotted so isn't spotted again, and the rate limit
+	// has been reached
+	srv.spot("EA5WU-#", 14025.0, "OH2BH", 12)
+	srv.spot("EA5WU-#", 14030.0, "G4ABC", 12)
+	if spots := receive(client, time.Second); len(spots) != 0 {
+		t.Errorf("expected no more spots, got %+v", spots)
+	}
+}
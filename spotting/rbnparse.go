@@ -0,0 +1,101 @@
+package spotting
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RBN spot types
+const (
+	RBNTypeCQ     = "CQ"
+	RBNTypeBeacon = "BEACON"
+	RBNTypeNCDXF  = "NCDXF B"
+	RBNTypeDX     = "DX"
+)
+
+// RBNSpot is a spot from the Reverse Beacon Network or a CW skimmer.  When
+// reports from several skimmers are aggregated, Spotter is the skimmer that
+// heard the station best.
+type RBNSpot struct {
+	Spotter   string
+	Frequency float64 // in kHz
+	DXStation string
+	Mode      string // CW, RTTY, FT8, etc.
+	SNR       int    // in dB
+	Speed     int    // in WPM for CW, or BPS for RTTY
+	Type      string // CQ, BEACON, NCDXF B or DX
+	Time      time.Time
+	Skimmers  int // the number of skimmers that heard the station
+}
+
+// Comment describes the spot in the form of a DX cluster comment.
+func (s RBNSpot) Comment() string {
+	sb := strings.Builder{}
+	fmt.Fprintf(&sb, "%s %d dB", s.Mode, s.SNR)
+	if s.Speed != 0 {
+		unit := "WPM"
+		if s.Mode == "RTTY" {
+			unit = "BPS"
+		}
+		fmt.Fprintf(&sb, " %d %s", s.Speed, unit)
+	}
+	sb.WriteString(" " + s.Type)
+	if s.Skimmers > 1 {
+		fmt.Fprintf(&sb, " (%d skimmers)", s.Skimmers)
+	}
+	return sb.String()
+}
+
+// RBNParse parses a line of RBN or skimmer output returning a spot if one could
+// be found, e.g.
+//
+//	DX de EA5WU-#:    14025.0  OH2BH        CW    24 dB  28 WPM  CQ      1200Z
+//	DX de KM3T-2-#:   14074.0  JA1XYZ       FT8  -14 dB  CQ              2259Z
+//
+// The time of the spot is taken from the line, on the UTC date of now.
+func RBNParse(line string, now time.Time) (*RBNSpot, error) {
+	// no error, but not a spot
+	if !strings.HasPrefix(line, "DX de") {
+		return nil, nil
+	}
+	fields := strings.Fields(line[5:])
+	if len(fields) < 7 {
+		return nil, errors.New("line not long enough")
+	}
+
+	spot := &RBNSpot{Skimmers: 1}
+	spot.Spotter = strings.TrimSuffix(fields[0], ":")
+	freq, err := strconv.ParseFloat(fields[1], 64)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing frequency in %s: %s", line, err)
+	}
+	spot.Frequency = freq
+	spot.DXStation = fields[2]
+	spot.Mode = fields[3]
+	if spot.SNR, err = strconv.Atoi(fields[4]); err != nil || fields[5] != "dB" {
+		return nil, fmt.Errorf("error parsing SNR in %s", line)
+	}
+	rest := fields[6:]
+	if len(rest) >= 2 && (rest[1] == "WPM" || rest[1] == "BPS") {
+		if spot.Speed, err = strconv.Atoi(rest[0]); err != nil {
+			return nil, fmt.Errorf("error parsing speed in %s", line)
+		}
+		rest = rest[2:]
+	}
+
+	if len(rest) == 0 {
+		return nil, fmt.Errorf("missing time in %s", line)
+	}
+	if spot.Time, err = spotTime(rest[len(rest)-1], now); err != nil {
+		return nil, fmt.Errorf("error parsing time in %s: %s", line, err)
+	}
+
+	spot.Type = strings.Join(rest[:len(rest)-1], " ")
+	if spot.Type == "" {
+		spot.Type = RBNTypeCQ
+	}
+	return spot, nil
+}
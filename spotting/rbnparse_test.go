@@ -0,0 +1,67 @@
+package spotting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tzneal/ham-go/spotting"
+)
+
+func TestRBNParse(t *testing.T) {
+	now := time.Date(2020, 6, 21, 12, 5, 0, 0, time.UTC)
+	td := []struct {
+		line string
+		exp  spotting.RBNSpot
+	}{
+		{"DX de EA5WU-#:    14025.0  OH2BH          CW    24 dB  28 WPM  CQ      1204Z",
+			spotting.RBNSpot{Spotter: "EA5WU-#", Frequency: 14025.0, DXStation: "OH2BH", Mode: "CW", SNR: 24, Speed: 28, Type: "CQ"}},
+		{"DX de KM3T-2-#:   14074.0  JA1XYZ         FT8  -14 dB         CQ      1204Z",
+			spotting.RBNSpot{Spotter: "KM3T-2-#", Frequency: 14074.0, DXStation: "JA1XYZ", Mode: "FT8", SNR: -14, Type: "CQ"}},
+		{"DX de W3LPL-#:    14085.9  K1ABC          RTTY  18 dB  45 BPS  CQ      1204Z",
+			spotting.RBNSpot{Spotter: "W3LPL-#", Frequency: 14085.9, DXStation: "K1ABC", Mode: "RTTY", SNR: 18, Speed: 45, Type: "CQ"}},
+		{"DX de DK9IP-#:    14100.0  4X6TU          CW    12 dB  22 WPM  NCDXF B 1204Z",
+			spotting.RBNSpot{Spotter: "DK9IP-#", Frequency: 14100.0, DXStation: "4X6TU", Mode: "CW", SNR: 12, Speed: 22, Type: "NCDXF B"}},
+		{"DX de VE7CC-#:    28222.0  W6WX/B         CW     8 dB  18 WPM  BEACON  1204Z",
+			spotting.RBNSpot{Spotter: "VE7CC-#", Frequency: 28222.0, DXStation: "W6WX/B", Mode: "CW", SNR: 8, Speed: 18, Type: "BEACON"}},
+	}
+	for _, tc := range td {
+		spot, err := spotting.RBNParse(tc.line, now)
+		if err != nil {
+			t.Errorf("expected valid parse of %q, got %s", tc.line, err)
+			continue
+		}
+		tc.exp.Time = time.Date(2020, 6, 21, 12, 4, 0, 0, time.UTC)
+		tc.exp.Skimmers = 1
+		if *spot != tc.exp {
+			t.Errorf("expected %+v, got %+v", tc.exp, *spot)
+		}
+	}
+
+	// a spot from before midnight is from the previous day
+	spot, err := spotting.RBNParse("DX de EA5WU-#:    14025.0  OH2BH          CW    24 dB  28 WPM  CQ      2359Z",
+		time.Date(2020, 6, 21, 0, 0, 30, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("expected valid parse, got %s", err)
+	}
+	if exp := time.Date(2020, 6, 20, 23, 59, 0, 0, time.UTC); !spot.Time.Equal(exp) {
+		t.Errorf("expected %s, got %s", exp, spot.Time)
+	}
+
+	if spot, err := spotting.RBNParse("Local users = 5", now); spot != nil || err != nil {
+		t.Errorf("expected no spot and no error for a non-spot line")
+	}
+	if _, err := spotting.RBNParse("DX de EA5WU-#:    14025.0  OH2BH  CW  x dB  CQ  1204Z", now); err == nil {
+		t.Errorf("expected an error for a bad SNR")
+	}
+}
+
+func TestRBNSpotComment(t *testing.T) {
+	spot := spotting.RBNSpot{Mode: "CW", SNR: 24, Speed: 28, Type: "CQ", Skimmers: 3}
+	if got := spot.Comment(); got != "CW 24 dB 28 WPM CQ (3 skimmers)" {
+		t.Errorf("unexpected comment %q", got)
+	}
+	spot = spotting.RBNSpot{Mode: "FT8", SNR: -14, Type: "CQ", Skimmers: 1}
+	if got := spot.Comment(); got != "FT8 -14 dB CQ" {
+		t.Errorf("unexpected comment %q", got)
+	}
+}
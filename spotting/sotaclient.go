@@ -2,8 +2,10 @@ package spotting
 
 import (
 	"encoding/json"
+	"fmt"
 	"log"
 	"net/http"
+	"strconv"
 	"time"
 )
 
@@ -100,7 +102,11 @@ func (c *SOTAClient) run() {
 				log.Printf("err parsing SOTA time: %s", err)
 				continue
 			}
-			c.Spots <- v
+			select {
+			case c.Spots <- v:
+			case <-c.shutdown:
+				return true
+			}
 		}
 		return true
 	}
@@ -119,3 +125,87 @@ func (c *SOTAClient) run() {
 		}
 	}
 }
+
+func init() {
+	RegisterSource("sota", NewSOTASource)
+}
+
+// sotaSource is a SOTA spot source.
+type sotaSource struct {
+	client *SOTAClient
+	name   string
+	done   chan struct{}
+}
+
+// NewSOTASource constructs a SOTA spot source, the Address is the spot API
+// URL and the PollSeconds option is the delay between checks for spots.
+func NewSOTASource(cfg SourceConfig) (Source, error) {
+	poll, err := cfg.intOption("PollSeconds", 0)
+	if err != nil {
+		return nil, err
+	}
+	return &sotaSource{
+		client: NewSOTAClient(SOTAConfig{
+			URL:   cfg.Address,
+			Delay: time.Duration(poll) * time.Second,
+		}),
+		name: cfg.name("SOTA"),
+		done: make(chan struct{}),
+	}, nil
+}
+
+func (s *sotaSource) Name() string {
+	return s.name
+}
+
+func (s *sotaSource) Run(spots chan<- Spot) {
+	s.client.Run()
+	go func() {
+		for {
+			select {
+			case <-s.done:
+				return
+			case spot, ok := <-s.client.Spots:
+				if !ok {
+					return
+				}
+				// SOTA frequencies are in MHz
+				freq, err := strconv.ParseFloat(spot.Frequency, 64)
+				if err != nil {
+					log.Printf("error parsing %s spot of %s: %s", s.name, spot.ActivatorCallsign, err)
+					continue
+				}
+				tm, err := spot.Time()
+				if err != nil {
+					log.Printf("error parsing %s spot of %s: %s", s.name, spot.ActivatorCallsign, err)
+					continue
+				}
+				location := spot.SummitCode
+				if spot.SummitDetails != "" {
+					location = fmt.Sprintf("%s/%s", spot.SummitCode, spot.SummitDetails)
+				}
+				out := Spot{
+					Source:    s.name,
+					Frequency: freq * 1e3,
+					DXStation: spot.ActivatorCallsign,
+					Spotter:   spot.Callsign,
+					Comment:   spot.Comments,
+					Mode:      spot.Mode,
+					Time:      tm,
+					Reference: spot.SummitCode,
+					Location:  location,
+				}
+				select {
+				case spots <- out:
+				case <-s.done:
+					return
+				}
+			}
+		}
+	}()
+}
+
+func (s *sotaSource) Close() error {
+	close(s.done)
+	return s.client.Close()
+}
@@ -0,0 +1,110 @@
+package spotting
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Source is a source of spots, e.g. a DX cluster or the POTA spot API.
+type Source interface {
+	// Name is the name spots from the source are shown with, e.g. DX or POTA.
+	Name() string
+	// Run is a non-blocking call that starts the source, which then sends
+	// spots to the channel until it's closed.
+	Run(spots chan<- Spot)
+	// Close gracefully shuts down the source.
+	Close() error
+}
+
+// SourceConfig configures a spot source.  The Type selects the registered
+// source, and any settings that are specific to it go in Options.
+type SourceConfig struct {
+	Type     string // e.g. dxcluster, rbn, pota or sota
+	Name     string // the name spots are shown with, defaults to one for the type
+	Disabled bool
+	Address  string            // host:port for telnet sources, or a URL
+	Callsign string            // the call to log in to telnet sources as
+	Commands []string          // sent after logging in to a DX cluster
+	Options  map[string]string // source specific settings
+}
+
+// name returns the configured name, or def if there isn't one.
+func (c SourceConfig) name(def string) string {
+	if c.Name != "" {
+		return c.Name
+	}
+	return def
+}
+
+// intOption returns an integer option, or def if it isn't set.
+func (c SourceConfig) intOption(key string, def int) (int, error) {
+	v, ok := c.Options[key]
+	if !ok || v == "" {
+		return def, nil
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s for %s source: %s", key, c.Type, err)
+	}
+	return i, nil
+}
+
+// boolOption returns a boolean option, or def if it isn't set.
+func (c SourceConfig) boolOption(key string, def bool) (bool, error) {
+	v, ok := c.Options[key]
+	if !ok || v == "" {
+		return def, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s for %s source: %s", key, c.Type, err)
+	}
+	return b, nil
+}
+
+type sourceConstructor func(cfg SourceConfig) (Source, error)
+
+var registeredSources = map[string]sourceConstructor{}
+
+// RegisterSource registers a type of spot source.
+func RegisterSource(typ string, fn func(cfg SourceConfig) (Source, error)) {
+	registeredSources[strings.ToLower(typ)] = fn
+}
+
+// SourceTypes returns the registered types of spot sources.
+func SourceTypes() []string {
+	ret := []string{}
+	for typ := range registeredSources {
+		ret = append(ret, typ)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// NewSource constructs a spot source of a registered type.
+func NewSource(cfg SourceConfig) (Source, error) {
+	fn, ok := registeredSources[strings.ToLower(cfg.Type)]
+	if !ok {
+		return nil, fmt.Errorf("unknown spot source type %q", cfg.Type)
+	}
+	return fn(cfg)
+}
+
+// spotTime returns the time of a spot that only gives an HHMM UTC time, on the
+// UTC date of now.  Spots from just before midnight that arrive after it are
+// given the previous day.
+func spotTime(hhmm string, now time.Time) (time.Time, error) {
+	tm, err := time.Parse("1504", strings.TrimSuffix(strings.TrimSpace(hhmm), "Z"))
+	if err != nil {
+		return time.Time{}, err
+	}
+	now = now.UTC()
+	t := time.Date(now.Year(), now.Month(), now.Day(), tm.Hour(), tm.Minute(), 0, 0, time.UTC)
+	if t.Sub(now) > time.Hour {
+		t = t.AddDate(0, 0, -1)
+	}
+	return t, nil
+}
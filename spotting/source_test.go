@@ -0,0 +1,157 @@
+package spotting_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/tzneal/ham-go/spotting"
+)
+
+func TestSourceRegistry(t *testing.T) {
+	types := fmt.Sprint(spotting.SourceTypes())
+	if types != "[dxcluster http pota rbn sota]" {
+		t.Errorf("expected the built in sources, got %s", types)
+	}
+	if _, err := spotting.NewSource(spotting.SourceConfig{Type: "carrier-pigeon"}); err == nil {
+		t.Errorf("expected an error for an unknown source")
+	}
+	if _, err := spotting.NewSource(spotting.SourceConfig{Type: "RBN", Options: map[string]string{"WindowSeconds": "ten"}}); err == nil {
+		t.Errorf("expected an error for an invalid option")
+	}
+	src, err := spotting.NewSource(spotting.SourceConfig{Type: "pota", Name: "Parks"})
+	if err != nil {
+		t.Fatalf("error constructing source: %s", err)
+	}
+	if src.Name() != "Parks" {
+		t.Errorf("expected the configured name, got %s", src.Name())
+	}
+}
+
+func TestDXClusterSpotTime(t *testing.T) {
+	spot := spotting.DXClusterSpot{Time: "2359Z"}
+	now := time.Date(2020, 6, 2, 0, 1, 0, 0, time.UTC)
+	tm, err := spot.UTCTime(now)
+	if err != nil {
+		t.Fatalf("error parsing time: %s", err)
+	}
+	if exp := time.Date(2020, 6, 1, 23, 59, 0, 0, time.UTC); !tm.Equal(exp) {
+		t.Errorf("expected %s, got %s", exp, tm)
+	}
+
+	spot.Time = "0000Z"
+	if tm, _ = spot.UTCTime(now); !tm.Equal(time.Date(2020, 6, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected midnight on the 2nd, got %s", tm)
+	}
+}
+
+func receiveSpot(t *testing.T, spots chan spotting.Spot) spotting.Spot {
+	t.Helper()
+	select {
+	case spot := <-spots:
+		return spot
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for a spot")
+	}
+	return spotting.Spot{}
+}
+
+func TestDXClusterSource(t *testing.T) {
+	srv := newFakeTelnet(t)
+	defer srv.Close()
+
+	src, err := spotting.NewSource(spotting.SourceConfig{
+		Type:     "dxcluster",
+		Address:  srv.ln.Addr().String(),
+		Callsign: "KN4LHY",
+		Options:  map[string]string{"ZoneLookup": "false"},
+	})
+	if err != nil {
+		t.Fatalf("error constructing source: %s", err)
+	}
+	lines := make(chan string, 10)
+	src.(*spotting.DXClusterSource).OnLine(func(line string) { lines <- line })
+	spots := make(chan spotting.Spot)
+	src.Run(spots)
+	defer src.Close()
+
+	<-srv.login
+	now := time.Now().UTC()
+	srv.lines <- fmt.Sprintf("DX de N6DBF:     21075.8  YV5MBI       FT8, -17 in Placentia, CA      %s DM13", now.Format("1504Z"))
+	spot := receiveSpot(t, spots)
+	if spot.Source != "DX" || spot.DXStation != "YV5MBI" || spot.Spotter != "N6DBF" || spot.Frequency != 21075.8 {
+		t.Errorf("unexpected spot %+v", spot)
+	}
+	if exp := now.Truncate(time.Minute); !spot.Time.Equal(exp) {
+		t.Errorf("expected time %s, got %s", exp, spot.Time)
+	}
+	select {
+	case <-lines:
+	case <-time.After(5 * time.Second):
+		t.Errorf("expected the spot line to be passed on")
+	}
+}
+
+func TestSOTASource(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// malformed spots are skipped
+		fmt.Fprint(w, `[{"timeStamp": "2020-04-30T12:18:02.87", "activatorCallsign": "K1BAD/P", "frequency": "14.0x"},
+			{"timeStamp": "yesterday", "activatorCallsign": "K1BAD/P", "frequency": "14.062"},
+			{"timeStamp": "2020-04-30T12:18:02.87", "callsign": "W1AW",
+			"activatorCallsign": "K1ABC/P", "summitCode": "W1/HA-001", "summitDetails": "Mount Washington",
+			"frequency": "14.062", "mode": "cw", "comments": "QRV now"}]`)
+	}))
+	defer srv.Close()
+
+	src, err := spotting.NewSource(spotting.SourceConfig{Type: "sota", Address: srv.URL})
+	if err != nil {
+		t.Fatalf("error constructing source: %s", err)
+	}
+	spots := make(chan spotting.Spot)
+	src.Run(spots)
+	defer src.Close()
+
+	spot := receiveSpot(t, spots)
+	if spot.Source != "SOTA" || spot.DXStation != "K1ABC/P" || spot.Spotter != "W1AW" || spot.Frequency != 14062 {
+		t.Errorf("unexpected spot %+v", spot)
+	}
+	if spot.Reference != "W1/HA-001" || spot.Location != "W1/HA-001/Mount Washington" || spot.InferredMode() != "CW" {
+		t.Errorf("unexpected spot reference/location/mode %+v", spot)
+	}
+	if exp := time.Date(2020, 4, 30, 12, 18, 2, 870000000, time.UTC); !spot.Time.Equal(exp) {
+		t.Errorf("expected time %s, got %s", exp, spot.Time)
+	}
+}
+
+func TestPOTASource(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// malformed spots are skipped
+		fmt.Fprint(w, `[{"activator": "K1BAD", "frequency": "", "spotTime": "2020-04-30T12:18:02"},
+			{"activator": "K1BAD", "frequency": "14250", "spotTime": "2020-04-30"},
+			{"activator": "K1ABC", "frequency": "14250", "mode": "SSB", "reference": "K-0001",
+			"parkName": "Acadia", "locationDesc": "US-ME", "spotTime": "2020-04-30T12:18:02",
+			"spotter": "W1AW", "comments": "QRV now"}]`)
+	}))
+	defer srv.Close()
+
+	src, err := spotting.NewSource(spotting.SourceConfig{Type: "pota", Address: srv.URL})
+	if err != nil {
+		t.Fatalf("error constructing source: %s", err)
+	}
+	spots := make(chan spotting.Spot)
+	src.Run(spots)
+	defer src.Close()
+
+	spot := receiveSpot(t, spots)
+	if spot.Source != "POTA" || spot.DXStation != "K1ABC" || spot.Spotter != "W1AW" || spot.Frequency != 14250 {
+		t.Errorf("unexpected spot %+v", spot)
+	}
+	if spot.Reference != "K-0001" || spot.Location != "K-0001/Acadia/US-ME" || spot.InferredMode() != "SSB" {
+		t.Errorf("unexpected spot reference/location/mode %+v", spot)
+	}
+	if exp := time.Date(2020, 4, 30, 12, 18, 2, 0, time.UTC); !spot.Time.Equal(exp) {
+		t.Errorf("expected time %s, got %s", exp, spot.Time)
+	}
+}
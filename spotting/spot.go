@@ -0,0 +1,56 @@
+package spotting
+
+import (
+	"strings"
+	"time"
+
+	"github.com/tzneal/ham-go/adif"
+	"github.com/tzneal/ham-go/dxcc"
+)
+
+// Spot is a station spotted by a DX cluster, skimmer, POTA or SOTA.
+type Spot struct {
+	Source    string  // where the spot came from, e.g. DX, RBN, POTA or SOTA
+	Frequency float64 // in kHz
+	DXStation string
+	Spotter   string
+	Comment   string
+	Mode      string // if known, otherwise it's inferred from the frequency
+	Time      time.Time
+	Reference string // a POTA park, SOTA summit, etc.
+	Location  string
+}
+
+// Band returns the name of the band the spot is on, or the empty string if
+// it's outside of the amateur bands.
+func (s Spot) Band() string {
+	band, ok := adif.DetermineBand(s.Frequency / 1e3)
+	if !ok {
+		return ""
+	}
+	return band.Name
+}
+
+// InferredMode returns the mode of the spot, inferring it from the comment and
+// frequency if the source didn't provide it.
+func (s Spot) InferredMode() string {
+	if s.Mode != "" {
+		return strings.ToUpper(s.Mode)
+	}
+	return InferMode(s.Frequency, s.Comment)
+}
+
+// DXEntity returns the DXCC entity of the spotted station.
+func (s Spot) DXEntity() (dxcc.Entity, bool) {
+	return dxcc.LookupAt(s.DXStation, s.Time)
+}
+
+// SpotterEntity returns the DXCC entity of the spotter.  Skimmer and cluster
+// node suffixes (e.g. EA5WU-#, K1ABC-2) are ignored.
+func (s Spot) SpotterEntity() (dxcc.Entity, bool) {
+	call := s.Spotter
+	if idx := strings.IndexByte(call, '-'); idx != -1 {
+		call = call[:idx]
+	}
+	return dxcc.LookupAt(call, s.Time)
+}
@@ -0,0 +1,91 @@
+package spotting
+
+import (
+	"net"
+	"strings"
+	"time"
+)
+
+// telnetConn is a line oriented connection to a DX cluster style telnet
+// server.
+type telnetConn struct {
+	conn   net.Conn
+	curPos int
+	buf    []byte
+}
+
+func dialTelnet(network, address string) (*telnetConn, error) {
+	conn, err := net.Dial(network, address)
+	if err != nil {
+		return nil, err
+	}
+	return &telnetConn{conn: conn}, nil
+}
+
+func (c *telnetConn) isLoginPrompt(line string) bool {
+	line = strings.ToLower(line)
+	for _, p := range []string{"enter your call", "login:"} {
+		if strings.Contains(line, p) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *telnetConn) login(call string) {
+	try := 0
+	for {
+		try++
+		line, _ := c.readLine()
+		// prompts usually aren't followed by a newline
+		prompt := string(c.buf[c.curPos:])
+		if c.isLoginPrompt(line) || c.isLoginPrompt(prompt) || try > 20 {
+			c.curPos = len(c.buf)
+			c.conn.Write([]byte(call + "\n"))
+			return
+		}
+	}
+}
+
+func (c *telnetConn) readLine() (string, error) {
+	// try to return a line we've already got
+	for i := c.curPos; i < len(c.buf); i++ {
+		if c.buf[i] == '\n' {
+			ret := string(c.buf[c.curPos:i])
+			c.curPos = i + 1
+			return ret, nil
+		}
+	}
+
+	// need to read new
+	tmp := make([]byte, 8192)
+	remaining := len(c.buf) - c.curPos
+	for i := c.curPos; i < len(c.buf); i++ {
+		tmp[i-c.curPos] = c.buf[i]
+	}
+
+	// time out so we don't hang indefinitely
+	c.conn.SetReadDeadline(time.Now().Add(100 * time.Millisecond))
+	n, err := c.conn.Read(tmp[remaining:])
+	if err != nil {
+		// detect timeout and don't report it as an error
+		if err, ok := err.(net.Error); ok && err.Timeout() {
+			c.buf = tmp[0:remaining]
+			c.curPos = 0
+			return "", nil
+		}
+		return "", err
+	}
+
+	c.buf = tmp[0 : n+remaining]
+	c.curPos = 0
+	return c.readLine()
+}
+
+func (c *telnetConn) Write(b []byte) (int, error) {
+	return c.conn.Write(b)
+}
+
+func (c *telnetConn) Close() error {
+	return c.conn.Close()
+}
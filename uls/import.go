@@ -0,0 +1,263 @@
+package uls
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/tzneal/ham-go/geo"
+)
+
+// operatorClasses maps the AM.dat operator class codes to names
+var operatorClasses = map[string]string{
+	"E": "Extra",
+	"A": "Advanced",
+	"G": "General",
+	"P": "Technician Plus",
+	"T": "Technician",
+	"N": "Novice",
+}
+
+// importBatch is the number of licenses written per transaction
+const importBatch = 10000
+
+type point struct {
+	lat, lon float64
+}
+
+// Import builds a license database at filename from the EN.dat, HD.dat and
+// AM.dat files in dir, replacing any existing database.  Only active licenses
+// are imported.  If dir also contains a Census Gazetteer ZCTA file (a file
+// with "zcta" in its name), licensees are located at the centroid of their
+// ZIP code instead of their state.  It returns the number of licenses
+// imported.
+func Import(dir string, filename string) (int, error) {
+	// HD.dat has the license status
+	active := map[string]bool{}
+	err := readDat(filepath.Join(dir, "HD.dat"), func(f []string) {
+		if len(f) > 6 && f[5] == "A" {
+			active[f[1]] = true
+		}
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// AM.dat has the operator class
+	classes := map[string]string{}
+	err = readDat(filepath.Join(dir, "AM.dat"), func(f []string) {
+		if len(f) > 5 && active[f[1]] {
+			classes[f[1]] = operatorClasses[f[5]]
+		}
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	zips, err := readZCTA(dir)
+	if err != nil {
+		return 0, err
+	}
+
+	// build the new database beside the old one so a failed import doesn't
+	// leave us with nothing
+	tmp := filename + ".new"
+	os.Remove(tmp)
+	db, err := bolt.Open(tmp, 0600, nil)
+	if err != nil {
+		return 0, err
+	}
+	// the database is rebuilt on failure, so there's no need to sync each write
+	db.NoSync = true
+
+	n := 0
+	batch := map[string][]byte{}
+	flush := func() error {
+		err := db.Update(func(tx *bolt.Tx) error {
+			b, err := tx.CreateBucketIfNotExists(licenseBucket)
+			if err != nil {
+				return fmt.Errorf("create bucket: %s", err)
+			}
+			for k, v := range batch {
+				if err := b.Put([]byte(k), v); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		batch = map[string][]byte{}
+		return err
+	}
+
+	var writeErr error
+	err = readDat(filepath.Join(dir, "EN.dat"), func(f []string) {
+		if writeErr != nil || len(f) < 19 || !active[f[1]] {
+			return
+		}
+		lic := parseEntity(f)
+		lic.Class = classes[f[1]]
+		locate(&lic, zips)
+		v, err := json.Marshal(lic)
+		if err != nil {
+			writeErr = err
+			return
+		}
+		batch[lic.Call] = v
+		n++
+		if len(batch) >= importBatch {
+			writeErr = flush()
+		}
+	})
+	if err == nil {
+		err = writeErr
+	}
+	if err == nil {
+		err = flush()
+	}
+	if err == nil {
+		err = db.Sync()
+	}
+	if cerr := db.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return 0, err
+	}
+	return n, os.Rename(tmp, filename)
+}
+
+// parseEntity parses a license from an EN.dat record.
+func parseEntity(f []string) License {
+	lic := License{
+		Call:  strings.ToUpper(f[4]),
+		City:  f[16],
+		State: f[17],
+		Zip:   f[18],
+	}
+	name := []string{}
+	for _, n := range []string{f[8], f[9], f[10], f[11]} {
+		if n != "" {
+			name = append(name, n)
+		}
+	}
+	if len(name) > 0 {
+		lic.Name = strings.Join(name, " ")
+	} else {
+		// clubs and other entities have no individual name
+		lic.Name = f[7]
+	}
+	return lic
+}
+
+// locate sets the license location and grid from the ZIP code centroid,
+// falling back to the state centroid.
+func locate(lic *License, zips map[string]point) {
+	zip := lic.Zip
+	if len(zip) > 5 {
+		zip = zip[:5]
+	}
+	precision := 4
+	if pt, ok := zips[zip]; ok {
+		lic.Latitude, lic.Longitude = pt.lat, pt.lon
+		lic.ZipLocated = true
+		precision = 6
+	} else if pt, ok := stateCentroids[lic.State]; ok {
+		lic.Latitude, lic.Longitude = pt.lat, pt.lon
+	} else {
+		return
+	}
+	grid, err := geo.NewPoint(lic.Latitude, lic.Longitude).Grid(precision)
+	if err == nil {
+		lic.Grid = grid
+	}
+}
+
+// readDat calls fn with the fields of each record in a pipe delimited ULS
+// data file.
+func readDat(filename string, fn func(fields []string)) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+		fn(strings.Split(line, "|"))
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("reading %s: %s", filename, err)
+	}
+	return nil
+}
+
+// readZCTA reads the ZIP code centroids from a Census Gazetteer ZCTA file in
+// dir if there is one.
+func readZCTA(dir string) (map[string]point, error) {
+	zips := map[string]point{}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	filename := ""
+	for _, fi := range files {
+		if strings.Contains(strings.ToLower(fi.Name()), "zcta") && !fi.IsDir() {
+			filename = filepath.Join(dir, fi.Name())
+			break
+		}
+	}
+	if filename == "" {
+		return zips, nil
+	}
+
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	zipCol, latCol, lonCol := -1, -1, -1
+	for scanner.Scan() {
+		fields := strings.Split(strings.TrimRight(scanner.Text(), "\r"), "\t")
+		if zipCol == -1 {
+			for i, h := range fields {
+				switch strings.ToUpper(strings.TrimSpace(h)) {
+				case "GEOID":
+					zipCol = i
+				case "INTPTLAT":
+					latCol = i
+				case "INTPTLONG":
+					lonCol = i
+				}
+			}
+			if zipCol == -1 || latCol == -1 || lonCol == -1 {
+				return nil, fmt.Errorf("%s is not a Gazetteer ZCTA file", filename)
+			}
+			continue
+		}
+		if len(fields) <= zipCol || len(fields) <= latCol || len(fields) <= lonCol {
+			continue
+		}
+		lat, latErr := strconv.ParseFloat(strings.TrimSpace(fields[latCol]), 64)
+		lon, lonErr := strconv.ParseFloat(strings.TrimSpace(fields[lonCol]), 64)
+		if latErr == nil && lonErr == nil {
+			zips[strings.TrimSpace(fields[zipCol])] = point{lat, lon}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading %s: %s", filename, err)
+	}
+	return zips, nil
+}
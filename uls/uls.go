@@ -0,0 +1,90 @@
+// Package uls builds and searches an offline database of US amateur radio
+// licenses from the FCC Universal Licensing System (ULS) public data files.
+package uls
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// DefaultFile is the default location of the license database
+const DefaultFile = "~/.termlog-uls.db"
+
+// DataURL is where the weekly amateur license data can be downloaded from
+const DataURL = "https://data.fcc.gov/download/pub/uls/complete/l_amat.zip"
+
+var licenseBucket = []byte("licenses")
+
+// ErrNotImported is returned when the license database hasn't been imported.
+var ErrNotImported = errors.New("ULS license database has not been imported")
+
+// License is an amateur license from the ULS data.
+type License struct {
+	Call      string
+	Name      string
+	City      string
+	State     string
+	Zip       string
+	Class     string // operator class, e.g. "Extra", empty for club licenses
+	Latitude  float64
+	Longitude float64
+	Grid      string
+	// ZipLocated is true if the location is the centroid of the licensee's
+	// ZIP code, otherwise it is the centroid of their state
+	ZipLocated bool
+}
+
+// Database is a license database created by Import.
+type Database struct {
+	db *bolt.DB
+}
+
+// Open opens a license database for reading.
+func Open(filename string) (*Database, error) {
+	db, err := bolt.Open(filename, 0600, &bolt.Options{ReadOnly: true, Timeout: time.Second})
+	if err != nil {
+		return nil, err
+	}
+	return &Database{db: db}, nil
+}
+
+// Close closes the database.
+func (d *Database) Close() error {
+	return d.db.Close()
+}
+
+// Lookup looks up the license for a call sign.
+func (d *Database) Lookup(call string) (License, bool, error) {
+	call = strings.ToUpper(strings.TrimSpace(call))
+	lic := License{}
+	found := false
+	err := d.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(licenseBucket)
+		if b == nil {
+			return ErrNotImported
+		}
+		v := b.Get([]byte(call))
+		if v == nil {
+			return nil
+		}
+		found = true
+		return json.Unmarshal(v, &lic)
+	})
+	return lic, found, err
+}
+
+// Count returns the number of licenses in the database.
+func (d *Database) Count() int {
+	n := 0
+	d.db.View(func(tx *bolt.Tx) error {
+		if b := tx.Bucket(licenseBucket); b != nil {
+			n = b.Stats().KeyN
+		}
+		return nil
+	})
+	return n
+}
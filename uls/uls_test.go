@@ -0,0 +1,134 @@
+package uls_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/tzneal/ham-go/uls"
+)
+
+const testHD = `HD|100|0000000001||W1AW|A|HB|01/01/2020|01/01/2030||||||||||||||||||||||||||||||||||||||||||
+HD|101|0000000002||KN4LHY|A|HA|01/01/2020|01/01/2030||||||||||||||||||||||||||||||||||||||||||
+HD|102|0000000003||KD5ABC|E|HA|01/01/2010|01/01/2020||||||||||||||||||||||||||||||||||||||||||
+HD|103|0000000004||AC0XYZ|A|HV|01/01/2020|01/01/2030||||||||||||||||||||||||||||||||||||||||||
+`
+
+const testAM = `AM|101|0000000002||KN4LHY|E|D|4||||||||||
+AM|102|0000000003||KD5ABC|T|D|5||||||||||
+AM|103|0000000004||AC0XYZ|G|D|0||||||||||
+`
+
+const testEN = "EN|100|0000000000||W1AW|L|L00000|ARRL HQ OPERATORS CLUB||||||||225 MAIN ST|NEWINGTON|CT|06111||||0000||||||\r\n" +
+	"EN|101|0000000001||KN4LHY|L|L00001|Neal, Todd|Todd||Neal|||||1 Any St|Madison|AL|35756||||0001||||||\r\n" +
+	"EN|102|0000000002||KD5ABC|L|L00002|Doe, John|John||Doe|||||2 Any St|Houston|TX|77001||||0002||||||\r\n" +
+	"EN|103|0000000003||AC0XYZ|L|L00003|Smith, Jane Q|Jane|Q|Smith|Jr||||3 Any St|Boulder|CO|99999||||0003||||||\r\n"
+
+const testZCTA = "GEOID\tALAND\tAWATER\tALAND_SQMI\tAWATER_SQMI\tINTPTLAT\tINTPTLONG                                                                                                               \n" +
+	"06111\t34297024\t226735\t13.242\t0.088\t41.686734\t-72.730458\n" +
+	"35756\t67335046\t1193003\t25.998\t0.461\t34.629563\t-86.807891\n"
+
+func writeTestData(t *testing.T) string {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "uls")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %s", err)
+	}
+	for fn, data := range map[string]string{
+		"HD.dat":                     testHD,
+		"AM.dat":                     testAM,
+		"EN.dat":                     testEN,
+		"2020_Gaz_zcta_national.txt": testZCTA,
+	} {
+		if err := ioutil.WriteFile(filepath.Join(dir, fn), []byte(data), 0644); err != nil {
+			t.Fatalf("error writing %s: %s", fn, err)
+		}
+	}
+	return dir
+}
+
+func TestImport(t *testing.T) {
+	dir := writeTestData(t)
+	defer os.RemoveAll(dir)
+
+	fn := filepath.Join(dir, "uls.db")
+	n, err := uls.Import(dir, fn)
+	if err != nil {
+		t.Fatalf("error importing: %s", err)
+	}
+	if n != 3 {
+		t.Errorf("expected 3 active licenses, got %d", n)
+	}
+
+	// importing again replaces the database
+	if _, err := uls.Import(dir, fn); err != nil {
+		t.Fatalf("error re-importing: %s", err)
+	}
+
+	d, err := uls.Open(fn)
+	if err != nil {
+		t.Fatalf("error opening: %s", err)
+	}
+	defer d.Close()
+	if d.Count() != 3 {
+		t.Errorf("expected 3 licenses, got %d", d.Count())
+	}
+
+	td := []struct {
+		Call       string
+		Found      bool
+		Name       string
+		City       string
+		State      string
+		Class      string
+		Grid       string
+		ZipLocated bool
+	}{
+		{"W1AW", true, "ARRL HQ OPERATORS CLUB", "NEWINGTON", "CT", "", "FN31pq", true},
+		{"kn4lhy", true, "Todd Neal", "Madison", "AL", "Extra", "EM64op", true},
+		{"KD5ABC", false, "", "", "", "", "", false}, // expired
+		{"AC0XYZ", true, "Jane Q Smith Jr", "Boulder", "CO", "General", "DM79", false},
+		{"K1ABC", false, "", "", "", "", "", false},
+	}
+	for _, tc := range td {
+		t.Run(tc.Call, func(t *testing.T) {
+			lic, found, err := d.Lookup(tc.Call)
+			if err != nil {
+				t.Fatalf("error looking up %s: %s", tc.Call, err)
+			}
+			if found != tc.Found {
+				t.Fatalf("expected found = %v, got %v", tc.Found, found)
+			}
+			if !found {
+				return
+			}
+			if lic.Name != tc.Name {
+				t.Errorf("expected name %s, got %s", tc.Name, lic.Name)
+			}
+			if lic.City != tc.City || lic.State != tc.State {
+				t.Errorf("expected %s, %s, got %s, %s", tc.City, tc.State, lic.City, lic.State)
+			}
+			if lic.Class != tc.Class {
+				t.Errorf("expected class %s, got %s", tc.Class, lic.Class)
+			}
+			if lic.Grid != tc.Grid {
+				t.Errorf("expected grid %s, got %s", tc.Grid, lic.Grid)
+			}
+			if lic.ZipLocated != tc.ZipLocated {
+				t.Errorf("expected ZIP located = %v, got %v", tc.ZipLocated, lic.ZipLocated)
+			}
+		})
+	}
+}
+
+func TestImportMissingFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "uls")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	if _, err := uls.Import(dir, filepath.Join(dir, "uls.db")); err == nil {
+		t.Errorf("expected an error with no data files")
+	}
+}